@@ -1,12 +1,18 @@
 package incidentio
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
+	"strings"
+	"time"
 )
 
+// ActionStatusCompleted is the status of an action that has been done
+const ActionStatusCompleted = "completed"
+
 // ListActionsOptions represents options for listing actions
 type ListActionsOptions struct {
 	PageSize   int
@@ -15,6 +21,15 @@ type ListActionsOptions struct {
 	Status     []string
 }
 
+// UpdateActionRequest represents a request to update an action. Only
+// non-empty fields are sent.
+type UpdateActionRequest struct {
+	Description string     `json:"description,omitempty"`
+	Status      string     `json:"status,omitempty"`
+	AssigneeID  string     `json:"assignee_id,omitempty"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
 // ListActionsResponse represents the response from listing actions
 type ListActionsResponse struct {
 	Actions []Action `json:"actions"`
@@ -22,7 +37,7 @@ type ListActionsResponse struct {
 }
 
 // ListActions retrieves a list of actions with automatic pagination
-func (c *Client) ListActions(opts *ListActionsOptions) (*ListActionsResponse, error) {
+func (c *Client) ListActions(ctx context.Context, opts *ListActionsOptions) (*ListActionsResponse, error) {
 	allActions := []Action{}
 	pageSize := 250 // Use max page size
 	after := ""
@@ -30,16 +45,15 @@ func (c *Client) ListActions(opts *ListActionsOptions) (*ListActionsResponse, er
 	// Set up base parameters
 	baseParams := url.Values{}
 	if opts != nil {
-		if opts.IncidentID != "" {
-			baseParams.Set("incident_id", opts.IncidentID)
-		}
-		for _, status := range opts.Status {
-			baseParams.Add("status", status)
-		}
+		baseParams = buildQuery(map[string]interface{}{
+			"incident_id": opts.IncidentID,
+			"status":      opts.Status,
+		})
 	}
 
 	// Paginate through all results
 	maxPages := 10 // Safety limit
+	guard := NewPaginationGuard("actions", after)
 	for page := 0; page < maxPages; page++ {
 		params := url.Values{}
 		// Copy base parameters
@@ -52,7 +66,7 @@ func (c *Client) ListActions(opts *ListActionsOptions) (*ListActionsResponse, er
 			params.Set("after", after)
 		}
 
-		respBody, err := c.doRequest("GET", "/actions", params, nil)
+		respBody, err := c.doRequest(ctx, "GET", "/actions", params, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -68,9 +82,18 @@ func (c *Client) ListActions(opts *ListActionsOptions) (*ListActionsResponse, er
 		if response.PaginationMeta.After == "" || len(response.Actions) == 0 {
 			break
 		}
+		if err := guard.Next(response.PaginationMeta.After, len(response.Actions)); err != nil {
+			return nil, err
+		}
 		after = response.PaginationMeta.After
 	}
 
+	// Match the statuses here as well, so several statuses always match any
+	// of them (OR logic) whatever the API makes of a repeated status param
+	if opts != nil && len(opts.Status) > 0 {
+		allActions = filterActionsByStatus(allActions, opts.Status)
+	}
+
 	// Return combined results
 	return &ListActionsResponse{
 		Actions: allActions,
@@ -87,9 +110,23 @@ func (c *Client) ListActions(opts *ListActionsOptions) (*ListActionsResponse, er
 	}, nil
 }
 
+// filterActionsByStatus keeps the actions whose status is one of statuses
+func filterActionsByStatus(actions []Action, statuses []string) []Action {
+	filtered := []Action{}
+	for _, action := range actions {
+		for _, status := range statuses {
+			if strings.EqualFold(action.Status, status) {
+				filtered = append(filtered, action)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
 // GetAction retrieves a specific action by ID
-func (c *Client) GetAction(id string) (*Action, error) {
-	respBody, err := c.doRequest("GET", fmt.Sprintf("/actions/%s", id), nil, nil)
+func (c *Client) GetAction(ctx context.Context, id string) (*Action, error) {
+	respBody, err := c.doRequest(ctx, "GET", fmt.Sprintf("/actions/%s", id), nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -103,3 +140,30 @@ func (c *Client) GetAction(id string) (*Action, error) {
 
 	return &response.Action, nil
 }
+
+// UpdateAction updates an existing action
+func (c *Client) UpdateAction(ctx context.Context, id string, req *UpdateActionRequest) (*Action, error) {
+	respBody, err := c.doRequest(ctx, "PATCH", fmt.Sprintf("/actions/%s", id), nil, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Action Action `json:"action"`
+	}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &response.Action, nil
+}
+
+// CompleteAction marks an action as completed, recording the current time as
+// its completion time
+func (c *Client) CompleteAction(ctx context.Context, id string) (*Action, error) {
+	completedAt := time.Now().UTC()
+	return c.UpdateAction(ctx, id, &UpdateActionRequest{
+		Status:      ActionStatusCompleted,
+		CompletedAt: &completedAt,
+	})
+}
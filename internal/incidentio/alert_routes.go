@@ -1,6 +1,7 @@
 package incidentio
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -22,24 +23,16 @@ type ListAlertRoutesResponse struct {
 }
 
 // ListAlertRoutes returns all alert routes
-func (c *Client) ListAlertRoutes(params *ListAlertRoutesParams) (*ListAlertRoutesResponse, error) {
-	endpoint := "/alert_routes"
-
+func (c *Client) ListAlertRoutes(ctx context.Context, params *ListAlertRoutesParams) (*ListAlertRoutesResponse, error) {
 	v := url.Values{}
 	if params != nil {
-		if params.PageSize > 0 {
-			v.Set("page_size", fmt.Sprintf("%d", params.PageSize))
-		}
-		if params.After != "" {
-			v.Set("after", params.After)
-		}
-	}
-
-	if len(v) > 0 {
-		endpoint = endpoint + "?" + v.Encode()
+		v = buildQuery(map[string]interface{}{
+			"page_size": params.PageSize,
+			"after":     params.After,
+		})
 	}
 
-	respBody, err := c.doRequest("GET", endpoint, nil, nil)
+	respBody, err := c.doRequest(ctx, "GET", "/alert_routes", v, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -53,10 +46,10 @@ func (c *Client) ListAlertRoutes(params *ListAlertRoutesParams) (*ListAlertRoute
 }
 
 // GetAlertRoute returns a specific alert route by ID
-func (c *Client) GetAlertRoute(id string) (*AlertRoute, error) {
+func (c *Client) GetAlertRoute(ctx context.Context, id string) (*AlertRoute, error) {
 	endpoint := fmt.Sprintf("/alert_routes/%s", id)
 
-	respBody, err := c.doRequest("GET", endpoint, nil, nil)
+	respBody, err := c.doRequest(ctx, "GET", endpoint, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -82,10 +75,10 @@ type CreateAlertRouteRequest struct {
 }
 
 // CreateAlertRoute creates a new alert route
-func (c *Client) CreateAlertRoute(req *CreateAlertRouteRequest) (*AlertRoute, error) {
+func (c *Client) CreateAlertRoute(ctx context.Context, req *CreateAlertRouteRequest) (*AlertRoute, error) {
 	endpoint := "/alert_routes"
 
-	respBody, err := c.doRequest("POST", endpoint, nil, req)
+	respBody, err := c.doRequest(ctx, "POST", endpoint, nil, req)
 	if err != nil {
 		return nil, err
 	}
@@ -111,10 +104,10 @@ type UpdateAlertRouteRequest struct {
 }
 
 // UpdateAlertRoute updates an alert route
-func (c *Client) UpdateAlertRoute(id string, req *UpdateAlertRouteRequest) (*AlertRoute, error) {
+func (c *Client) UpdateAlertRoute(ctx context.Context, id string, req *UpdateAlertRouteRequest) (*AlertRoute, error) {
 	endpoint := fmt.Sprintf("/alert_routes/%s", id)
 
-	respBody, err := c.doRequest("PATCH", endpoint, nil, req)
+	respBody, err := c.doRequest(ctx, "PATCH", endpoint, nil, req)
 	if err != nil {
 		return nil, err
 	}
@@ -128,3 +121,11 @@ func (c *Client) UpdateAlertRoute(id string, req *UpdateAlertRouteRequest) (*Ale
 
 	return &result.AlertRoute, nil
 }
+
+// DeleteAlertRoute deletes an alert route
+func (c *Client) DeleteAlertRoute(ctx context.Context, id string) error {
+	endpoint := fmt.Sprintf("/alert_routes/%s", id)
+
+	_, err := c.doRequest(ctx, "DELETE", endpoint, nil, nil)
+	return err
+}
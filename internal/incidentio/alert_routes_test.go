@@ -1,6 +1,7 @@
 package incidentio
 
 import (
+	"context"
 	"net/http"
 	"testing"
 )
@@ -75,7 +76,7 @@ func TestListAlertRoutes(t *testing.T) {
 			}
 
 			client := NewTestClient(mockClient)
-			result, err := client.ListAlertRoutes(tt.params)
+			result, err := client.ListAlertRoutes(context.Background(), tt.params)
 
 			if tt.wantError {
 				assertError(t, err)
@@ -174,7 +175,7 @@ func TestGetAlertRoute(t *testing.T) {
 			}
 
 			client := NewTestClient(mockClient)
-			route, err := client.GetAlertRoute(tt.routeID)
+			route, err := client.GetAlertRoute(context.Background(), tt.routeID)
 
 			if tt.wantError {
 				assertError(t, err)
@@ -316,7 +317,7 @@ func TestCreateAlertRoute(t *testing.T) {
 			}
 
 			client := NewTestClient(mockClient)
-			route, err := client.CreateAlertRoute(tt.request)
+			route, err := client.CreateAlertRoute(context.Background(), tt.request)
 
 			if tt.wantError {
 				assertError(t, err)
@@ -427,7 +428,7 @@ func TestUpdateAlertRoute(t *testing.T) {
 			}
 
 			client := NewTestClient(mockClient)
-			route, err := client.UpdateAlertRoute(tt.routeID, tt.request)
+			route, err := client.UpdateAlertRoute(context.Background(), tt.routeID, tt.request)
 
 			if tt.wantError {
 				assertError(t, err)
@@ -449,3 +450,47 @@ func TestUpdateAlertRoute(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteAlertRoute(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockStatusCode int
+		mockResponse   string
+		wantNotFound   bool
+	}{
+		{
+			name:           "successful delete",
+			mockStatusCode: http.StatusNoContent,
+		},
+		{
+			name:           "route not found",
+			mockStatusCode: http.StatusNotFound,
+			mockResponse:   `{"type": "not_found", "status": 404, "errors": [{"code": "not_found", "message": "Alert route not found"}]}`,
+			wantNotFound:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					assertEqual(t, "DELETE", req.Method)
+					assertEqual(t, "/alert_routes/ar_123", req.URL.Path)
+					return mockResponse(tt.mockStatusCode, tt.mockResponse), nil
+				},
+			}
+
+			client := NewTestClient(mockClient)
+			err := client.DeleteAlertRoute(context.Background(), "ar_123")
+
+			if tt.wantNotFound {
+				assertError(t, err)
+				if !IsNotFound(err) {
+					t.Errorf("expected not found error, got: %v", err)
+				}
+				return
+			}
+			assertNoError(t, err)
+		})
+	}
+}
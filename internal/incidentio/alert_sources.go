@@ -1,6 +1,7 @@
 package incidentio
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -33,24 +34,16 @@ type ListAlertSourcesResponse struct {
 }
 
 // ListAlertSources returns all alert sources
-func (c *Client) ListAlertSources(params *ListAlertSourcesParams) (*ListAlertSourcesResponse, error) {
-	endpoint := "/alert_sources"
-
+func (c *Client) ListAlertSources(ctx context.Context, params *ListAlertSourcesParams) (*ListAlertSourcesResponse, error) {
 	v := url.Values{}
 	if params != nil {
-		if params.PageSize > 0 {
-			v.Set("page_size", fmt.Sprintf("%d", params.PageSize))
-		}
-		if params.After != "" {
-			v.Set("after", params.After)
-		}
-	}
-
-	if len(v) > 0 {
-		endpoint = endpoint + "?" + v.Encode()
+		v = buildQuery(map[string]interface{}{
+			"page_size": params.PageSize,
+			"after":     params.After,
+		})
 	}
 
-	respBody, err := c.doRequest("GET", endpoint, nil, nil)
+	respBody, err := c.doRequest(ctx, "GET", "/alert_sources", v, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -62,3 +55,20 @@ func (c *Client) ListAlertSources(params *ListAlertSourcesParams) (*ListAlertSou
 
 	return &result, nil
 }
+
+// GetAlertSource retrieves a single alert source by ID
+func (c *Client) GetAlertSource(ctx context.Context, id string) (*AlertSource, error) {
+	respBody, err := c.doRequest(ctx, "GET", fmt.Sprintf("/alert_sources/%s", url.PathEscape(id)), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		AlertSource AlertSource `json:"alert_source"`
+	}
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result.AlertSource, nil
+}
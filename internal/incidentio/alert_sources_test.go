@@ -1,6 +1,7 @@
 package incidentio
 
 import (
+	"context"
 	"net/http"
 	"testing"
 )
@@ -104,7 +105,7 @@ func TestListAlertSources(t *testing.T) {
 			}
 
 			client := NewTestClient(mockClient)
-			result, err := client.ListAlertSources(tt.params)
+			result, err := client.ListAlertSources(context.Background(), tt.params)
 
 			if tt.wantError {
 				assertError(t, err)
@@ -141,3 +142,23 @@ func TestListAlertSources(t *testing.T) {
 		})
 	}
 }
+
+func TestGetAlertSource(t *testing.T) {
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			assertEqual(t, "GET", req.Method)
+			assertEqual(t, "/alert_sources/as_123", req.URL.Path)
+
+			return mockResponse(http.StatusOK, `{
+				"alert_source": {"id": "as_123", "name": "Production Monitoring", "type": "http", "config_type": "webhook"}
+			}`), nil
+		},
+	}
+
+	client := NewTestClient(mockClient)
+	source, err := client.GetAlertSource(context.Background(), "as_123")
+	assertNoError(t, err)
+	assertEqual(t, "as_123", source.ID)
+	assertEqual(t, "Production Monitoring", source.Name)
+	assertEqual(t, "http", source.Type)
+}
@@ -1,6 +1,7 @@
 package incidentio
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -14,14 +15,35 @@ type ListAlertsOptions struct {
 	Status   []string
 }
 
+// ListAlertsForIncidentOptions represents options for listing the alerts of
+// an incident
+type ListAlertsForIncidentOptions struct {
+	PageSize int
+	After    string
+	Status   []string
+	// AutoPaginate follows pagination from After and returns every alert, up
+	// to maxIncidentAlertPages pages. PageSize is ignored.
+	AutoPaginate bool
+}
+
+const (
+	// maxAlertsPageSize is the largest page of alerts the API returns
+	maxAlertsPageSize = 50
+	// maxIncidentAlertPages bounds auto-pagination of an incident's alerts
+	maxIncidentAlertPages = 100
+)
+
 // ListAlertsResponse represents the response from listing alerts
 type ListAlertsResponse struct {
 	Alerts []Alert `json:"alerts"`
 	ListResponse
+	// Truncated is set when AutoPaginate stopped at the page limit before
+	// reaching the last page
+	Truncated bool `json:"truncated,omitempty"`
 }
 
 // ListAlerts retrieves a list of alerts with automatic pagination
-func (c *Client) ListAlerts(opts *ListAlertsOptions) (*ListAlertsResponse, error) {
+func (c *Client) ListAlerts(ctx context.Context, opts *ListAlertsOptions) (*ListAlertsResponse, error) {
 	allAlerts := []Alert{}
 	pageSize := 50 // Max page size for alerts is 50
 	after := ""
@@ -29,13 +51,14 @@ func (c *Client) ListAlerts(opts *ListAlertsOptions) (*ListAlertsResponse, error
 	// Set up base parameters
 	baseParams := url.Values{}
 	if opts != nil {
-		for _, status := range opts.Status {
-			baseParams.Add("status", status)
-		}
+		baseParams = buildQuery(map[string]interface{}{
+			"status": opts.Status,
+		})
 	}
 
 	// Paginate through all results
 	maxPages := 10 // Safety limit
+	guard := NewPaginationGuard("alerts", after)
 	for page := 0; page < maxPages; page++ {
 		params := url.Values{}
 		// Copy base parameters
@@ -48,7 +71,7 @@ func (c *Client) ListAlerts(opts *ListAlertsOptions) (*ListAlertsResponse, error
 			params.Set("after", after)
 		}
 
-		respBody, err := c.doRequest("GET", "/alerts", params, nil)
+		respBody, err := c.doRequest(ctx, "GET", "/alerts", params, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -64,6 +87,9 @@ func (c *Client) ListAlerts(opts *ListAlertsOptions) (*ListAlertsResponse, error
 		if response.PaginationMeta.After == "" || len(response.Alerts) == 0 {
 			break
 		}
+		if err := guard.Next(response.PaginationMeta.After, len(response.Alerts)); err != nil {
+			return nil, err
+		}
 		after = response.PaginationMeta.After
 	}
 
@@ -84,8 +110,8 @@ func (c *Client) ListAlerts(opts *ListAlertsOptions) (*ListAlertsResponse, error
 }
 
 // GetAlert retrieves a specific alert by ID
-func (c *Client) GetAlert(id string) (*Alert, error) {
-	respBody, err := c.doRequest("GET", fmt.Sprintf("/alerts/%s", id), nil, nil)
+func (c *Client) GetAlert(ctx context.Context, id string) (*Alert, error) {
+	respBody, err := c.doRequest(ctx, "GET", fmt.Sprintf("/alerts/%s", id), nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -100,66 +126,68 @@ func (c *Client) GetAlert(id string) (*Alert, error) {
 	return &response.Alert, nil
 }
 
-// ListAlertsForIncident retrieves alerts for a specific incident with automatic pagination
-func (c *Client) ListAlertsForIncident(incidentID string, opts *ListAlertsOptions) (*ListAlertsResponse, error) {
-	allAlerts := []Alert{}
-	pageSize := 50 // Max page size for alerts is 50
-	after := ""
-
-	// Set up base parameters
-	baseParams := url.Values{}
-	if opts != nil {
-		for _, status := range opts.Status {
-			baseParams.Add("status", status)
-		}
+// ListAlertsForIncident retrieves a page of the alerts for an incident, or
+// every alert when opts.AutoPaginate is set
+func (c *Client) ListAlertsForIncident(ctx context.Context, incidentID string, opts *ListAlertsForIncidentOptions) (*ListAlertsResponse, error) {
+	if opts == nil {
+		opts = &ListAlertsForIncidentOptions{}
 	}
 
-	// Paginate through all results
-	maxPages := 10 // Safety limit
-	for page := 0; page < maxPages; page++ {
-		params := url.Values{}
-		// Copy base parameters
-		for k, v := range baseParams {
-			params[k] = v
-		}
-
-		params.Set("incident_id", incidentID) // Filter by incident
-		params.Set("page_size", strconv.Itoa(pageSize))
-		if after != "" {
-			params.Set("after", after)
+	if !opts.AutoPaginate {
+		pageSize := opts.PageSize
+		if pageSize <= 0 || pageSize > maxAlertsPageSize {
+			pageSize = maxAlertsPageSize
 		}
+		return c.listIncidentAlertsPage(ctx, incidentID, opts.Status, pageSize, opts.After)
+	}
 
-		respBody, err := c.doRequest("GET", "/alerts", params, nil)
+	allAlerts := []Alert{}
+	after := opts.After
+	guard := NewPaginationGuard("alerts", after)
+	for page := 0; page < maxIncidentAlertPages; page++ {
+		response, err := c.listIncidentAlertsPage(ctx, incidentID, opts.Status, maxAlertsPageSize, after)
 		if err != nil {
 			return nil, err
 		}
 
-		var response ListAlertsResponse
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-		}
-
 		allAlerts = append(allAlerts, response.Alerts...)
 
 		// Check if there are more pages
 		if response.PaginationMeta.After == "" || len(response.Alerts) == 0 {
+			after = ""
 			break
 		}
+		if err := guard.Next(response.PaginationMeta.After, len(response.Alerts)); err != nil {
+			return nil, err
+		}
 		after = response.PaginationMeta.After
 	}
 
-	// Return combined results
-	return &ListAlertsResponse{
-		Alerts: allAlerts,
-		ListResponse: ListResponse{
-			PaginationMeta: struct {
-				After      string `json:"after,omitempty"`
-				PageSize   int    `json:"page_size"`
-				TotalRecordCount int    `json:"total_record_count,omitempty"`
-			}{
-				PageSize:   pageSize,
-				TotalRecordCount: 0,
-			},
-		},
-	}, nil
+	combined := &ListAlertsResponse{Alerts: allAlerts, Truncated: after != ""}
+	combined.PaginationMeta.After = after
+	combined.PaginationMeta.PageSize = maxAlertsPageSize
+	return combined, nil
+}
+
+func (c *Client) listIncidentAlertsPage(ctx context.Context, incidentID string, status []string, pageSize int, after string) (*ListAlertsResponse, error) {
+	params := buildQuery(map[string]interface{}{
+		"status": status,
+	})
+	params.Set("incident_id", incidentID)
+	params.Set("page_size", strconv.Itoa(pageSize))
+	if after != "" {
+		params.Set("after", after)
+	}
+
+	respBody, err := c.doRequest(ctx, "GET", "/alerts", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ListAlertsResponse
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &response, nil
 }
@@ -0,0 +1,85 @@
+package incidentio
+
+import (
+	"sync"
+	"time"
+)
+
+const defaultMetadataCacheTTL = 60 * time.Second
+
+// Cache keys for organisation metadata that rarely changes
+const (
+	cacheKeySeverities         = "severities"
+	cacheKeyIncidentStatuses   = "incident_statuses"
+	cacheKeyIncidentTypes      = "incident_types"
+	cacheKeyIncidentTimestamps = "incident_timestamps"
+)
+
+// metadataCache is a small in-memory TTL cache for list responses that change
+// rarely, such as severities and incident statuses. A nil cache never caches.
+type metadataCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	now     func() time.Time
+	entries map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	value     interface{}
+	expiresAt time.Time
+}
+
+func newMetadataCache(ttl time.Duration) *metadataCache {
+	return &metadataCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]cacheEntry),
+	}
+}
+
+// get returns the cached value for key if it has not expired
+func (m *metadataCache) get(key string) (interface{}, bool) {
+	if m == nil || m.ttl <= 0 {
+		return nil, false
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry, ok := m.entries[key]
+	if !ok || !m.now().Before(entry.expiresAt) {
+		delete(m.entries, key)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+// set stores value under key until the TTL elapses
+func (m *metadataCache) set(key string, value interface{}) {
+	if m == nil || m.ttl <= 0 {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.entries[key] = cacheEntry{value: value, expiresAt: m.now().Add(m.ttl)}
+}
+
+// clear removes all cached entries
+func (m *metadataCache) clear() {
+	if m == nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.entries = make(map[string]cacheEntry)
+}
+
+// InvalidateMetadataCache drops cached severities, incident statuses and
+// incident types so the next call fetches fresh data from the API
+func (c *Client) InvalidateMetadataCache() {
+	c.cache.clear()
+}
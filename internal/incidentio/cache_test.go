@@ -0,0 +1,156 @@
+package incidentio
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newCachingTestClient returns a test client with a metadata cache and counts
+// the requests made per path
+func newCachingTestClient(requests map[string]int) *Client {
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			// Severities and statuses are served from the v1 API
+			path := strings.TrimPrefix(req.URL.Path, "/v1")
+			requests[path]++
+
+			switch path {
+			case "/severities":
+				return mockResponse(http.StatusOK, `{"severities": [{"id": "sev_1", "name": "Critical", "rank": 1}]}`), nil
+			case "/incident_statuses":
+				return mockResponse(http.StatusOK, `{"incident_statuses": [{"id": "st_1", "name": "Investigating", "category": "live"}]}`), nil
+			case "/incident_types":
+				return mockResponse(http.StatusOK, `{"incident_types": [{"id": "type_1", "name": "Default"}]}`), nil
+			}
+			return mockResponse(http.StatusNotFound, `{"error": {"message": "not found"}}`), nil
+		},
+	}
+
+	client := NewTestClient(mockClient)
+	client.cache = newMetadataCache(time.Minute)
+	return client
+}
+
+func TestMetadataCache_ServesRepeatCallsFromCache(t *testing.T) {
+	requests := make(map[string]int)
+	client := newCachingTestClient(requests)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		severities, err := client.ListSeverities(ctx)
+		assertNoError(t, err)
+		if len(severities.Severities) != 1 {
+			t.Errorf("expected %v, got %v", 1, len(severities.Severities))
+		}
+
+		statuses, err := client.ListIncidentStatuses(ctx)
+		assertNoError(t, err)
+		if len(statuses.IncidentStatuses) != 1 {
+			t.Errorf("expected %v, got %v", 1, len(statuses.IncidentStatuses))
+		}
+
+		types, err := client.ListIncidentTypes(ctx)
+		assertNoError(t, err)
+		if len(types.IncidentTypes) != 1 {
+			t.Errorf("expected %v, got %v", 1, len(types.IncidentTypes))
+		}
+	}
+
+	for _, path := range []string{"/severities", "/incident_statuses", "/incident_types"} {
+		if requests[path] != 1 {
+			t.Errorf("expected 1 request to %s, got %d", path, requests[path])
+		}
+	}
+}
+
+func TestMetadataCache_Expiry(t *testing.T) {
+	requests := make(map[string]int)
+	client := newCachingTestClient(requests)
+	ctx := context.Background()
+
+	now := time.Date(2024, 12, 1, 12, 0, 0, 0, time.UTC)
+	client.cache.now = func() time.Time { return now }
+
+	_, err := client.ListSeverities(ctx)
+	assertNoError(t, err)
+
+	now = now.Add(59 * time.Second)
+	_, err = client.ListSeverities(ctx)
+	assertNoError(t, err)
+	if requests["/severities"] != 1 {
+		t.Errorf("expected %v, got %v", 1, requests["/severities"])
+	}
+
+	now = now.Add(2 * time.Second)
+	_, err = client.ListSeverities(ctx)
+	assertNoError(t, err)
+	if requests["/severities"] != 2 {
+		t.Errorf("expected %v, got %v", 2, requests["/severities"])
+	}
+}
+
+func TestMetadataCache_Invalidate(t *testing.T) {
+	requests := make(map[string]int)
+	client := newCachingTestClient(requests)
+	ctx := context.Background()
+
+	_, err := client.ListIncidentStatuses(ctx)
+	assertNoError(t, err)
+
+	client.InvalidateMetadataCache()
+
+	_, err = client.ListIncidentStatuses(ctx)
+	assertNoError(t, err)
+	if requests["/incident_statuses"] != 2 {
+		t.Errorf("expected %v, got %v", 2, requests["/incident_statuses"])
+	}
+}
+
+func TestMetadataCache_DoesNotCacheErrors(t *testing.T) {
+	attempts := 0
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			attempts++
+			if attempts == 1 {
+				return mockResponse(http.StatusInternalServerError, `{"error": {"message": "boom"}}`), nil
+			}
+			return mockResponse(http.StatusOK, `{"severities": []}`), nil
+		},
+	}
+
+	client := NewTestClient(mockClient)
+	client.cache = newMetadataCache(time.Minute)
+
+	_, err := client.ListSeverities(context.Background())
+	assertError(t, err)
+
+	_, err = client.ListSeverities(context.Background())
+	assertNoError(t, err)
+	if attempts != 2 {
+		t.Errorf("expected %v, got %v", 2, attempts)
+	}
+}
+
+func TestNewClientMetadataCacheTTL(t *testing.T) {
+	t.Setenv("INCIDENT_IO_API_KEY", "test-key")
+
+	client, err := NewClient()
+	assertNoError(t, err)
+	if client.cache.ttl != defaultMetadataCacheTTL {
+		t.Errorf("expected %v, got %v", defaultMetadataCacheTTL, client.cache.ttl)
+	}
+
+	t.Setenv("INCIDENT_IO_METADATA_CACHE_TTL_SECONDS", "0")
+	client, err = NewClient()
+	assertNoError(t, err)
+	if client.cache.ttl != time.Duration(0) {
+		t.Errorf("expected %v, got %v", time.Duration(0), client.cache.ttl)
+	}
+
+	t.Setenv("INCIDENT_IO_METADATA_CACHE_TTL_SECONDS", "-5")
+	_, err = NewClient()
+	assertError(t, err)
+}
@@ -1,19 +1,32 @@
 package incidentio
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/url"
 )
 
-// ListCatalogTypes returns all catalog types
-func (c *Client) ListCatalogTypes() (*ListCatalogTypesResponse, error) {
-	// Catalog API uses V3, need to temporarily change the base URL
-	originalBaseURL := c.BaseURL()
-	c.SetBaseURL("https://api.incident.io/v3")
-	defer func() { c.SetBaseURL(originalBaseURL) }()
+// catalogURL returns the full URL of a catalog API path. The catalog API is
+// under V3, unlike the rest of the client, so it is addressed by URL rather
+// than through the client's base URL.
+func (c *Client) catalogURL(path string) string {
+	return c.apiRoot() + "/v3" + path
+}
+
+// ListCatalogTypesOptions represents options for listing catalog types
+type ListCatalogTypesOptions struct {
+	PageSize int
+	After    string
+}
+
+// ListCatalogTypes returns a page of catalog types
+func (c *Client) ListCatalogTypes(ctx context.Context, opts ListCatalogTypesOptions) (*ListCatalogTypesResponse, error) {
+	params := buildQuery(map[string]interface{}{
+		"page_size": opts.PageSize,
+		"after":     opts.After,
+	})
 
-	respBody, err := c.doRequest("GET", "/catalog_types", nil, nil)
+	respBody, err := c.doRequestURL(ctx, "GET", c.catalogURL("/catalog_types"), params, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -35,47 +48,47 @@ type ListCatalogEntriesOptions struct {
 }
 
 // ListCatalogEntries returns catalog entries for a given type
-func (c *Client) ListCatalogEntries(opts ListCatalogEntriesOptions) (*ListCatalogEntriesResponse, error) {
-	// Catalog API uses V3, need to temporarily change the base URL
-	originalBaseURL := c.BaseURL()
-	c.SetBaseURL("https://api.incident.io/v3")
-	defer func() { c.SetBaseURL(originalBaseURL) }()
-
-	params := url.Values{}
-	if opts.CatalogTypeID != "" {
-		params.Set("catalog_type_id", opts.CatalogTypeID)
-	}
-	if opts.PageSize > 0 {
-		params.Set("page_size", fmt.Sprintf("%d", opts.PageSize))
-	}
-	if opts.After != "" {
-		params.Set("after", opts.After)
+func (c *Client) ListCatalogEntries(ctx context.Context, opts ListCatalogEntriesOptions) (*ListCatalogEntriesResponse, error) {
+	params := buildQuery(map[string]interface{}{
+		"catalog_type_id": opts.CatalogTypeID,
+		"page_size":       opts.PageSize,
+		"after":           opts.After,
+		"identifier":      opts.Identifier,
+	})
+
+	respBody, err := c.doRequestURL(ctx, "GET", c.catalogURL("/catalog_entries"), params, nil)
+	if err != nil {
+		return nil, err
 	}
-	if opts.Identifier != "" {
-		params.Set("identifier", opts.Identifier)
+
+	var response ListCatalogEntriesResponse
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
-	respBody, err := c.doRequest("GET", "/catalog_entries", params, nil)
+	return &response, nil
+}
+
+// CreateCatalogEntry creates a new catalog entry
+func (c *Client) CreateCatalogEntry(ctx context.Context, req CreateCatalogEntryRequest) (*CatalogEntry, error) {
+	respBody, err := c.doRequestURL(ctx, "POST", c.catalogURL("/catalog_entries"), nil, req)
 	if err != nil {
 		return nil, err
 	}
 
-	var response ListCatalogEntriesResponse
+	var response struct {
+		CatalogEntry CatalogEntry `json:"catalog_entry"`
+	}
 	if err := json.Unmarshal(respBody, &response); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
-	return &response, nil
+	return &response.CatalogEntry, nil
 }
 
 // UpdateCatalogEntry updates a catalog entry by ID
-func (c *Client) UpdateCatalogEntry(id string, req UpdateCatalogEntryRequest) (*CatalogEntry, error) {
-	// Catalog API uses V3, need to temporarily change the base URL
-	originalBaseURL := c.BaseURL()
-	c.SetBaseURL("https://api.incident.io/v3")
-	defer func() { c.SetBaseURL(originalBaseURL) }()
-
-	respBody, err := c.doRequest("PUT", fmt.Sprintf("/catalog_entries/%s", id), nil, req)
+func (c *Client) UpdateCatalogEntry(ctx context.Context, id string, req UpdateCatalogEntryRequest) (*CatalogEntry, error) {
+	respBody, err := c.doRequestURL(ctx, "PUT", c.catalogURL(fmt.Sprintf("/catalog_entries/%s", id)), nil, req)
 	if err != nil {
 		return nil, err
 	}
@@ -91,13 +104,8 @@ func (c *Client) UpdateCatalogEntry(id string, req UpdateCatalogEntryRequest) (*
 }
 
 // GetCatalogEntry retrieves a specific catalog entry by ID
-func (c *Client) GetCatalogEntry(id string) (*CatalogEntry, error) {
-	// Catalog API uses V3, need to temporarily change the base URL
-	originalBaseURL := c.BaseURL()
-	c.SetBaseURL("https://api.incident.io/v3")
-	defer func() { c.SetBaseURL(originalBaseURL) }()
-
-	respBody, err := c.doRequest("GET", fmt.Sprintf("/catalog_entries/%s", id), nil, nil)
+func (c *Client) GetCatalogEntry(ctx context.Context, id string) (*CatalogEntry, error) {
+	respBody, err := c.doRequestURL(ctx, "GET", c.catalogURL(fmt.Sprintf("/catalog_entries/%s", id)), nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -111,3 +119,62 @@ func (c *Client) GetCatalogEntry(id string) (*CatalogEntry, error) {
 
 	return &response.CatalogEntry, nil
 }
+
+// DeleteCatalogEntry deletes a catalog entry by ID
+func (c *Client) DeleteCatalogEntry(ctx context.Context, id string) error {
+	_, err := c.doRequestURL(ctx, "DELETE", c.catalogURL(fmt.Sprintf("/catalog_entries/%s", id)), nil, nil)
+	return err
+}
+
+// GetCatalogType retrieves a specific catalog type by ID
+func (c *Client) GetCatalogType(ctx context.Context, id string) (*CatalogType, error) {
+	respBody, err := c.doRequestURL(ctx, "GET", c.catalogURL(fmt.Sprintf("/catalog_types/%s", id)), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalCatalogType(respBody)
+}
+
+// CreateCatalogType creates a new catalog type. Its attributes are set
+// separately with UpdateCatalogTypeSchema.
+func (c *Client) CreateCatalogType(ctx context.Context, req CreateCatalogTypeRequest) (*CatalogType, error) {
+	respBody, err := c.doRequestURL(ctx, "POST", c.catalogURL("/catalog_types"), nil, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalCatalogType(respBody)
+}
+
+// UpdateCatalogType replaces the details of a catalog type by ID
+func (c *Client) UpdateCatalogType(ctx context.Context, id string, req UpdateCatalogTypeRequest) (*CatalogType, error) {
+	respBody, err := c.doRequestURL(ctx, "PUT", c.catalogURL(fmt.Sprintf("/catalog_types/%s", id)), nil, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalCatalogType(respBody)
+}
+
+// UpdateCatalogTypeSchema replaces the attributes of a catalog type by ID.
+// Existing attributes keep their values only if they are sent with their ID.
+func (c *Client) UpdateCatalogTypeSchema(ctx context.Context, id string, req UpdateCatalogTypeSchemaRequest) (*CatalogType, error) {
+	respBody, err := c.doRequestURL(ctx, "POST", c.catalogURL(fmt.Sprintf("/catalog_types/%s/actions/update_schema", id)), nil, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalCatalogType(respBody)
+}
+
+func unmarshalCatalogType(respBody []byte) (*CatalogType, error) {
+	var response struct {
+		CatalogType CatalogType `json:"catalog_type"`
+	}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &response.CatalogType, nil
+}
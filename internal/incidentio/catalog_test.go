@@ -0,0 +1,314 @@
+package incidentio
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+func TestCreateCatalogEntry(t *testing.T) {
+	tests := []struct {
+		name         string
+		request      CreateCatalogEntryRequest
+		expectedBody string
+	}{
+		{
+			name: "literal attribute value",
+			request: CreateCatalogEntryRequest{
+				CatalogTypeID: "type_123",
+				Name:          "payments-api",
+				AttributeValues: map[string]CatalogEntryAttributeValue{
+					"attr_tier": {Value: &CatalogEntryAttributeValueItem{Literal: "tier-1"}},
+				},
+			},
+			expectedBody: `{"catalog_type_id":"type_123","name":"payments-api","attribute_values":{"attr_tier":{"value":{"literal":"tier-1"}}}}`,
+		},
+		{
+			name: "array attribute value",
+			request: CreateCatalogEntryRequest{
+				CatalogTypeID: "type_123",
+				Name:          "payments-api",
+				Aliases:       []string{"payments"},
+				ExternalID:    "svc-42",
+				AttributeValues: map[string]CatalogEntryAttributeValue{
+					"attr_owners": {ArrayValue: []CatalogEntryAttributeValueItem{{ID: "team_payments"}, {ID: "team_platform"}}},
+				},
+			},
+			expectedBody: `{"catalog_type_id":"type_123","name":"payments-api","aliases":["payments"],"attribute_values":{"attr_owners":{"array_value":[{"id":"team_payments"},{"id":"team_platform"}]}},"external_id":"svc-42"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					assertEqual(t, "POST", req.Method)
+					assertEqual(t, "https://api.test.incident.io/v3/catalog_entries", req.URL.String())
+
+					body, err := io.ReadAll(req.Body)
+					assertNoError(t, err)
+					assertEqual(t, tt.expectedBody, string(body))
+
+					var created map[string]interface{}
+					assertNoError(t, json.Unmarshal(body, &created))
+					created["id"] = "entry_new"
+					response, err := json.Marshal(map[string]interface{}{"catalog_entry": created})
+					assertNoError(t, err)
+
+					return mockResponse(http.StatusCreated, string(response)), nil
+				},
+			}
+
+			client := NewTestClient(mockClient)
+			entry, err := client.CreateCatalogEntry(context.Background(), tt.request)
+			assertNoError(t, err)
+
+			assertEqual(t, "entry_new", entry.ID)
+			assertEqual(t, tt.request.Name, entry.Name)
+			if len(entry.AttributeValues) != len(tt.request.AttributeValues) {
+				t.Errorf("expected %d attribute values, got %d", len(tt.request.AttributeValues), len(entry.AttributeValues))
+			}
+
+			// The base URL is restored after the V3 request
+			assertEqual(t, "https://api.test.incident.io", client.BaseURL())
+		})
+	}
+}
+
+func TestDeleteCatalogEntry(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockStatusCode int
+		mockResponse   string
+		wantNotFound   bool
+	}{
+		{
+			name:           "successful delete",
+			mockStatusCode: http.StatusNoContent,
+		},
+		{
+			name:           "entry not found",
+			mockStatusCode: http.StatusNotFound,
+			mockResponse:   `{"type": "not_found", "status": 404, "errors": [{"code": "not_found", "message": "Catalog entry not found"}]}`,
+			wantNotFound:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					assertEqual(t, "DELETE", req.Method)
+					assertEqual(t, "https://api.test.incident.io/v3/catalog_entries/entry_123", req.URL.String())
+					return mockResponse(tt.mockStatusCode, tt.mockResponse), nil
+				},
+			}
+
+			client := NewTestClient(mockClient)
+			err := client.DeleteCatalogEntry(context.Background(), "entry_123")
+
+			if tt.wantNotFound {
+				assertError(t, err)
+				if !IsNotFound(err) {
+					t.Errorf("expected not found error, got: %v", err)
+				}
+				return
+			}
+			assertNoError(t, err)
+		})
+	}
+}
+
+func TestListCatalogTypesPagination(t *testing.T) {
+	pages := map[string]string{
+		"": `{
+			"catalog_types": [
+				{"id": "type_1", "name": "Service", "type_name": "Custom[\"Service\"]"},
+				{"id": "type_2", "name": "GitHub repository", "type_name": "GitHubRepository"}
+			],
+			"pagination_meta": {"after": "type_2", "page_size": 2}
+		}`,
+		"type_2": `{
+			"catalog_types": [
+				{"id": "type_3", "name": "Team", "type_name": "Custom[\"Team\"]"}
+			],
+			"pagination_meta": {"page_size": 2}
+		}`,
+	}
+
+	var afters []string
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			assertEqual(t, "/v3/catalog_types", req.URL.Path)
+			assertEqual(t, "2", req.URL.Query().Get("page_size"))
+
+			after := req.URL.Query().Get("after")
+			afters = append(afters, after)
+			return mockResponse(http.StatusOK, pages[after]), nil
+		},
+	}
+
+	client := NewTestClient(mockClient)
+	opts := ListCatalogTypesOptions{PageSize: 2}
+
+	var ids []string
+	for {
+		resp, err := client.ListCatalogTypes(context.Background(), opts)
+		assertNoError(t, err)
+		for _, catalogType := range resp.CatalogTypes {
+			ids = append(ids, catalogType.ID)
+		}
+		if resp.PaginationMeta.After == "" {
+			break
+		}
+		opts.After = resp.PaginationMeta.After
+	}
+
+	if len(afters) != 2 || afters[0] != "" || afters[1] != "type_2" {
+		t.Errorf("expected requests with after \"\" then \"type_2\", got %q", afters)
+	}
+	if len(ids) != 3 {
+		t.Errorf("expected 3 catalog types across both pages, got %v", ids)
+	}
+}
+
+func TestGetCatalogEntry(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockStatusCode int
+		mockResponse   string
+		wantNotFound   bool
+	}{
+		{
+			name:           "entry with array attributes",
+			mockStatusCode: http.StatusOK,
+			mockResponse: `{"catalog_entry": {
+				"id": "entry_123",
+				"name": "payments-api",
+				"catalog_type_id": "type_123",
+				"attribute_values": {
+					"attr_tier": {"value": {"literal": "tier-1"}},
+					"attr_owners": {"array_value": [{"id": "team_payments"}, {"id": "team_platform"}]}
+				}
+			}}`,
+		},
+		{
+			name:           "entry not found",
+			mockStatusCode: http.StatusNotFound,
+			mockResponse:   `{"type": "not_found", "status": 404, "errors": [{"code": "not_found", "message": "Catalog entry not found"}]}`,
+			wantNotFound:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					assertEqual(t, "GET", req.Method)
+					assertEqual(t, "https://api.test.incident.io/v3/catalog_entries/entry_123", req.URL.String())
+					return mockResponse(tt.mockStatusCode, tt.mockResponse), nil
+				},
+			}
+
+			client := NewTestClient(mockClient)
+			entry, err := client.GetCatalogEntry(context.Background(), "entry_123")
+
+			if tt.wantNotFound {
+				if !IsNotFound(err) {
+					t.Errorf("expected not found error, got: %v", err)
+				}
+				return
+			}
+
+			assertNoError(t, err)
+			assertEqual(t, "entry_123", entry.ID)
+			owners := entry.AttributeValues["attr_owners"].ArrayValue
+			if len(owners) != 2 || owners[0].ID != "team_payments" || owners[1].ID != "team_platform" {
+				t.Errorf("expected two owner IDs, got %+v", owners)
+			}
+			if tier := entry.AttributeValues["attr_tier"].Value; tier == nil || tier.Literal != "tier-1" {
+				t.Errorf("expected tier literal, got %+v", tier)
+			}
+		})
+	}
+}
+
+func TestUpdateCatalogTypeSchema(t *testing.T) {
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			assertEqual(t, "POST", req.Method)
+			assertEqual(t, "https://api.test.incident.io/v3/catalog_types/type_123/actions/update_schema", req.URL.String())
+
+			body, err := io.ReadAll(req.Body)
+			assertNoError(t, err)
+			assertEqual(t, `{"version":3,"attributes":[{"id":"attr_tier","name":"Tier","type":"Number","array":false},{"name":"Owners","type":"Custom[\"Team\"]","array":true}]}`, string(body))
+
+			return mockResponse(http.StatusOK, `{"catalog_type": {"id": "type_123", "schema": {"version": 4, "attributes": [
+				{"id": "attr_tier", "name": "Tier", "type": "Number"},
+				{"id": "attr_owners", "name": "Owners", "type": "Custom[\"Team\"]", "array": true}
+			]}}}`), nil
+		},
+	}
+
+	client := NewTestClient(mockClient)
+	catalogType, err := client.UpdateCatalogTypeSchema(context.Background(), "type_123", UpdateCatalogTypeSchemaRequest{
+		Version: 3,
+		Attributes: []CatalogAttribute{
+			{ID: "attr_tier", Name: "Tier", Type: "Number"},
+			{Name: "Owners", Type: `Custom["Team"]`, Array: true},
+		},
+	})
+	assertNoError(t, err)
+
+	if catalogType.Schema == nil || catalogType.Schema.Version != 4 || len(catalogType.Schema.Attributes) != 2 {
+		t.Fatalf("expected schema version 4 with 2 attributes, got %+v", catalogType.Schema)
+	}
+	assertEqual(t, "attr_owners", catalogType.Schema.Attributes[1].ID)
+	assertEqual(t, "https://api.test.incident.io", client.BaseURL())
+}
+
+func TestCatalogRequestsRunConcurrentlyWithOtherVersions(t *testing.T) {
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			switch req.URL.Path {
+			case "/v3/catalog_entries":
+				return mockResponse(http.StatusOK, `{"catalog_entries": []}`), nil
+			case "/v1/severities/sev_1":
+				return mockResponse(http.StatusOK, `{"severity": {"id": "sev_1"}}`), nil
+			case "/v2/incidents":
+				return mockResponse(http.StatusOK, `{"incidents": []}`), nil
+			}
+			t.Errorf("unexpected request to %s", req.URL.Path)
+			return mockResponse(http.StatusNotFound, `{}`), nil
+		},
+	}
+	client := NewTestClient(mockClient)
+	client.SetBaseURL("https://api.test.incident.io/v2")
+
+	// Each request must reach its own API version, however they interleave
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			_, err := client.ListCatalogEntries(context.Background(), ListCatalogEntriesOptions{CatalogTypeID: "type_123"})
+			assertNoError(t, err)
+		}()
+		go func() {
+			defer wg.Done()
+			_, err := client.GetSeverity(context.Background(), "sev_1")
+			assertNoError(t, err)
+		}()
+		go func() {
+			defer wg.Done()
+			_, err := client.ListIncidents(context.Background(), &ListIncidentsOptions{PageSize: 1})
+			assertNoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assertEqual(t, "https://api.test.incident.io/v2", client.BaseURL())
+}
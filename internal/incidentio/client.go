@@ -2,52 +2,221 @@ package incidentio
 
 import (
 	"bytes"
-	"crypto/tls"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math/rand"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
 	"time"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/logging"
 )
 
 const (
 	defaultBaseURL = "https://api.incident.io/v2"
 	userAgent      = "incidentio-mcp-server/0.1.0"
+
+	defaultMaxRetries     = 3
+	defaultRetryBaseDelay = 500 * time.Millisecond
+	maxRetryDelay         = 60 * time.Second
+
+	// defaultHTTPTimeout bounds each HTTP request, including reading the body
+	defaultHTTPTimeout = 30 * time.Second
 )
 
 type Client struct {
 	httpClient *http.Client
 	baseURL    string
 	apiKey     string
+
+	// Retry configuration for rate limited (429) responses, and for transient
+	// gateway (502/503/504) responses and network errors on idempotent requests
+	maxRetries     int
+	retryBaseDelay time.Duration
+	// sleep replaces the backoff wait in tests. It is nil otherwise, so the
+	// wait ends early when the request's context does.
+	sleep func(time.Duration)
+
+	// Short-lived cache for severities, incident statuses and incident types
+	cache *metadataCache
+
+	// dryRun stops mutating requests from being sent; see DryRunError
+	dryRun bool
+
+	// metrics counts requests, errors and retries; nil means no-op
+	metrics Metrics
 }
 
 func NewClient() (*Client, error) {
-	apiKey := os.Getenv("INCIDENT_IO_API_KEY")
+	apiKey, err := loadAPIKey()
+	if err != nil {
+		return nil, err
+	}
+	return NewClientWithAPIKey(apiKey)
+}
+
+// NewClientWithAPIKey creates a client that authenticates with apiKey rather
+// than INCIDENT_IO_API_KEY, e.g. for a session that supplied its own key. The
+// rest of the configuration still comes from the environment.
+func NewClientWithAPIKey(apiKey string) (*Client, error) {
 	if apiKey == "" {
-		return nil, fmt.Errorf("INCIDENT_IO_API_KEY environment variable is required")
+		return nil, fmt.Errorf("API key must not be empty")
+	}
+
+	baseURL, err := resolveBaseURL(os.Getenv("INCIDENT_IO_REGION"), os.Getenv("INCIDENT_IO_BASE_URL"))
+	if err != nil {
+		return nil, err
+	}
+
+	maxRetries := defaultMaxRetries
+	if value := os.Getenv("INCIDENT_IO_MAX_RETRIES"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 0 {
+			return nil, fmt.Errorf("INCIDENT_IO_MAX_RETRIES must be a non-negative integer, got %q", value)
+		}
+		maxRetries = parsed
+	}
+
+	retryBaseDelay := defaultRetryBaseDelay
+	if value := os.Getenv("INCIDENT_IO_RETRY_BASE_MS"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 0 {
+			return nil, fmt.Errorf("INCIDENT_IO_RETRY_BASE_MS must be a non-negative integer, got %q", value)
+		}
+		retryBaseDelay = time.Duration(parsed) * time.Millisecond
+	}
+
+	cacheTTL := defaultMetadataCacheTTL
+	if value := os.Getenv("INCIDENT_IO_METADATA_CACHE_TTL_SECONDS"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 0 {
+			return nil, fmt.Errorf("INCIDENT_IO_METADATA_CACHE_TTL_SECONDS must be a non-negative integer, got %q", value)
+		}
+		cacheTTL = time.Duration(parsed) * time.Second
+	}
+
+	httpTimeout, err := parseHTTPTimeout(os.Getenv("INCIDENT_IO_HTTP_TIMEOUT"))
+	if err != nil {
+		return nil, err
+	}
+
+	dryRun, err := parseDryRun(os.Getenv("DRY_RUN"))
+	if err != nil {
+		return nil, err
 	}
 
-	baseURL := os.Getenv("INCIDENT_IO_BASE_URL")
-	if baseURL == "" {
-		baseURL = defaultBaseURL
+	transport, err := transportConfigFromEnv()
+	if err != nil {
+		return nil, err
 	}
 
 	return &Client{
 		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
-			Transport: &http.Transport{
-				TLSClientConfig: &tls.Config{
-					MinVersion: tls.VersionTLS12,
-				},
-			},
+			// The timeout applies to each request, so paginated and retried
+			// calls get a fresh deadline per page and per attempt
+			Timeout:   httpTimeout,
+			Transport: sharedTransport(transport),
 		},
-		baseURL: baseURL,
-		apiKey:  apiKey,
+		baseURL:        baseURL,
+		apiKey:         apiKey,
+		maxRetries:     maxRetries,
+		retryBaseDelay: retryBaseDelay,
+		cache:          newMetadataCache(cacheTTL),
+		dryRun:         dryRun,
 	}, nil
 }
 
+// loadAPIKey returns INCIDENT_IO_API_KEY or, when that is unset, the trimmed
+// contents of the file named by INCIDENT_IO_API_KEY_FILE, for setups that
+// mount secrets as files
+func loadAPIKey() (string, error) {
+	if apiKey := os.Getenv("INCIDENT_IO_API_KEY"); apiKey != "" {
+		return apiKey, nil
+	}
+
+	path := os.Getenv("INCIDENT_IO_API_KEY_FILE")
+	if path == "" {
+		return "", fmt.Errorf("INCIDENT_IO_API_KEY environment variable is required (or INCIDENT_IO_API_KEY_FILE with the path to a file containing the key)")
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read API key from INCIDENT_IO_API_KEY_FILE: %w", err)
+	}
+	apiKey := strings.TrimSpace(string(data))
+	if apiKey == "" {
+		return "", fmt.Errorf("INCIDENT_IO_API_KEY_FILE %s is empty", path)
+	}
+	return apiKey, nil
+}
+
+// regionBaseURLs are the API base URLs for each INCIDENT_IO_REGION
+var regionBaseURLs = map[string]string{
+	"us": defaultBaseURL,
+	"eu": "https://api.eu.incident.io/v2",
+}
+
+// resolveBaseURL picks the API base URL: INCIDENT_IO_BASE_URL when set,
+// otherwise the URL for INCIDENT_IO_REGION, which defaults to us. The region
+// is validated even when overridden so a typo is not silently ignored.
+func resolveBaseURL(region, override string) (string, error) {
+	baseURL := defaultBaseURL
+	if region = strings.ToLower(strings.TrimSpace(region)); region != "" {
+		regionURL, ok := regionBaseURLs[region]
+		if !ok {
+			regions := make([]string, 0, len(regionBaseURLs))
+			for name := range regionBaseURLs {
+				regions = append(regions, name)
+			}
+			sort.Strings(regions)
+			return "", fmt.Errorf("INCIDENT_IO_REGION must be one of %s, got %q", strings.Join(regions, ", "), region)
+		}
+		baseURL = regionURL
+	}
+
+	if override != "" {
+		return override, nil
+	}
+	return baseURL, nil
+}
+
+// parseHTTPTimeout parses INCIDENT_IO_HTTP_TIMEOUT
+func parseHTTPTimeout(value string) (time.Duration, error) {
+	return parseDurationSetting("INCIDENT_IO_HTTP_TIMEOUT", value, defaultHTTPTimeout)
+}
+
+// parseDurationSetting parses the environment variable name, given either as
+// a Go duration ("45s", "1m") or as a whole number of seconds
+func parseDurationSetting(name, value string, fallback time.Duration) (time.Duration, error) {
+	if value == "" {
+		return fallback, nil
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second, nil
+	}
+	if duration, err := time.ParseDuration(value); err == nil && duration > 0 {
+		return duration, nil
+	}
+	return 0, fmt.Errorf("%s must be a positive duration such as \"30s\" or a number of seconds, got %q", name, value)
+}
+
+// Ping makes a cheap authenticated request, listing a single user, to check
+// that the API is reachable and accepts the API key. It bypasses the metadata
+// cache so a stale cache entry cannot hide a broken key.
+func (c *Client) Ping(ctx context.Context) error {
+	_, err := c.doRequest(ctx, "GET", "/users", url.Values{"page_size": {"1"}}, nil)
+	return err
+}
+
 // BaseURL returns the current base URL
 func (c *Client) BaseURL() string {
 	return c.baseURL
@@ -59,64 +228,215 @@ func (c *Client) SetBaseURL(baseURL string) {
 }
 
 // DoRequest exposes the internal doRequest method
-func (c *Client) DoRequest(method, path string, params url.Values, body interface{}) ([]byte, error) {
-	return c.doRequest(method, path, params, body)
+func (c *Client) DoRequest(ctx context.Context, method, path string, params url.Values, body interface{}) ([]byte, error) {
+	return c.doRequest(ctx, method, path, params, body)
 }
 
-func (c *Client) doRequest(method, path string, params url.Values, body interface{}) ([]byte, error) {
-	endpoint := c.baseURL + path
+func (c *Client) doRequest(ctx context.Context, method, path string, params url.Values, body interface{}) ([]byte, error) {
+	return c.doRequestURL(ctx, method, c.baseURL+path, params, body)
+}
 
+// doRequestURL sends a request to endpoint, a full URL, retrying rate limits
+// and transient failures, and returns the response body
+func (c *Client) doRequestURL(ctx context.Context, method, endpoint string, params url.Values, body interface{}) ([]byte, error) {
 	if len(params) > 0 {
 		endpoint += "?" + params.Encode()
 	}
 
-	var reqBody io.Reader
+	var jsonBody []byte
 	if body != nil {
-		jsonBody, err := json.Marshal(body)
+		var err error
+		jsonBody, err = json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
+	}
+
+	if !isReadOnlyMethod(method) && c.isDryRun(ctx) {
+		return nil, &DryRunError{Method: method, URL: endpoint, Body: jsonBody}
+	}
+
+	metrics := c.metricsCollector()
+	label := endpointLabel(method, endpoint)
+
+	for attempt := 0; ; attempt++ {
+		metrics.IncRequest(label)
+		resp, respBody, err := c.sendRequest(ctx, method, endpoint, jsonBody)
+		if err != nil {
+			metrics.IncError(label, 0)
+			// Connection resets, refused connections and DNS hiccups are only
+			// retried when repeating the request is safe and the caller is still waiting
+			if ctx.Err() == nil && attempt < c.maxRetries && isIdempotentMethod(method) && isTransientNetworkError(err) {
+				metrics.IncRetry(label)
+				if err := c.wait(ctx, c.retryDelay(attempt, "")); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			return nil, err
+		}
+
+		if resp.StatusCode >= 400 {
+			metrics.IncError(label, resp.StatusCode)
+		}
+
+		// Retry rate limits and transient gateway errors with exponential backoff
+		if attempt < c.maxRetries && isRetryableStatus(resp.StatusCode) && canRetryStatus(method, resp.StatusCode, jsonBody) {
+			metrics.IncRetry(label)
+			if err := c.wait(ctx, c.retryDelay(attempt, resp.Header.Get("Retry-After"))); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if resp.StatusCode >= 400 {
+			return nil, c.redactError(parseErrorResponse(resp.StatusCode, resp.Header, respBody))
+		}
+
+		return respBody, nil
+	}
+}
+
+// sendRequest performs a single HTTP request and reads the full response body
+func (c *Client) sendRequest(ctx context.Context, method, endpoint string, jsonBody []byte) (*http.Response, []byte, error) {
+	var reqBody io.Reader
+	if jsonBody != nil {
 		reqBody = bytes.NewReader(jsonBody)
 	}
 
-	req, err := http.NewRequest(method, endpoint, reqBody)
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reqBody)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("Authorization", "Bearer "+c.apiKey)
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("User-Agent", userAgent)
 
+	if logging.Default().Enabled(logging.LevelDebug) {
+		logging.Debugf("API request: %s %s headers=%v", method, logging.Redact(endpoint, c.apiKey), logging.RedactHeaders(req.Header))
+	}
+
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		return nil, nil, c.redactError(fmt.Errorf("request failed: %w", err))
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, nil, fmt.Errorf("failed to read response body: %w", err)
 	}
 
-	if resp.StatusCode >= 400 {
-		var errorResp ErrorResponse
-		if err := json.Unmarshal(respBody, &errorResp); err != nil {
-			return nil, fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(respBody))
-		}
-		// If error message is empty, show the full response
-		if errorResp.Error.Message == "" {
-			return nil, fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(respBody))
-		}
-		return nil, fmt.Errorf("API error: %s (HTTP %d)", errorResp.Error.Message, resp.StatusCode)
+	return resp, respBody, nil
+}
+
+// isRetryableStatus reports whether a response status should be retried
+func isRetryableStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// canRetryStatus reports whether a retryable status can be retried for a
+// request. A rate limited request was never handled, but a gateway error may
+// follow a write the API already applied, so writes are only repeated when
+// they are idempotent or carry an idempotency key.
+func canRetryStatus(method string, statusCode int, jsonBody []byte) bool {
+	if statusCode == http.StatusTooManyRequests || isIdempotentMethod(method) {
+		return true
+	}
+
+	var body struct {
+		IdempotencyKey string `json:"idempotency_key"`
+	}
+	return json.Unmarshal(jsonBody, &body) == nil && body.IdempotencyKey != ""
+}
+
+// isReadOnlyMethod reports whether requests with method never change data
+func isReadOnlyMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
 	}
+	return false
+}
 
-	return respBody, nil
+// isIdempotentMethod reports whether a request can safely be sent more than once
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
 }
 
-type ErrorResponse struct {
-	Error struct {
-		Message string `json:"message"`
-		Code    string `json:"code"`
-	} `json:"error"`
+// isTransientNetworkError reports whether a request error is a transient
+// network failure worth retrying. Context cancellation is never retried.
+func isTransientNetworkError(err error) bool {
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	if errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.IsTemporary || dnsErr.IsTimeout
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return netErr.Timeout()
+	}
+
+	return false
+}
+
+// retryDelay returns how long to wait before the next attempt. A Retry-After
+// header (seconds or HTTP date) takes precedence over the computed exponential
+// backoff with jitter. Delays are capped at maxRetryDelay.
+func (c *Client) retryDelay(attempt int, retryAfter string) time.Duration {
+	if delay, ok := parseRetryAfter(retryAfter); ok {
+		return minDuration(delay, maxRetryDelay)
+	}
+
+	backoff := c.retryBaseDelay << attempt
+	if c.retryBaseDelay > 0 {
+		backoff += time.Duration(rand.Int63n(int64(c.retryBaseDelay)))
+	}
+	return minDuration(backoff, maxRetryDelay)
+}
+
+// wait sleeps for the given duration, returning early with the context's error
+// if it is cancelled first. Tests can replace the sleep function.
+func (c *Client) wait(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	if c.sleep != nil {
+		c.sleep(d)
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func minDuration(a, b time.Duration) time.Duration {
+	if a < b {
+		return a
+	}
+	return b
 }
@@ -2,9 +2,18 @@ package incidentio
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"io"
+	"net"
 	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
 	"testing"
+	"time"
 )
 
 // MockHTTPClient is a mock implementation of http.Client for testing
@@ -64,3 +73,538 @@ func assertEqual(t *testing.T, expected, actual string) {
 		t.Errorf("expected %q, got %q", expected, actual)
 	}
 }
+
+func TestDoRequestRetries(t *testing.T) {
+	tests := []struct {
+		name             string
+		maxRetries       int
+		statuses         []int
+		retryAfter       string
+		wantError        bool
+		expectedAttempts int
+		expectedDelay    time.Duration
+	}{
+		{
+			name:             "succeeds after two rate limited responses",
+			maxRetries:       3,
+			statuses:         []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusOK},
+			expectedAttempts: 3,
+		},
+		{
+			name:             "honors Retry-After header",
+			maxRetries:       3,
+			statuses:         []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusOK},
+			retryAfter:       "7",
+			expectedAttempts: 3,
+			expectedDelay:    7 * time.Second,
+		},
+		{
+			name:             "retries gateway errors",
+			maxRetries:       3,
+			statuses:         []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout, http.StatusOK},
+			expectedAttempts: 4,
+		},
+		{
+			name:             "gives up after max retries",
+			maxRetries:       2,
+			statuses:         []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusOK},
+			wantError:        true,
+			expectedAttempts: 3,
+		},
+		{
+			name:             "does not retry client errors",
+			maxRetries:       3,
+			statuses:         []int{http.StatusNotFound, http.StatusOK},
+			wantError:        true,
+			expectedAttempts: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			mockClient := &MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					status := tt.statuses[attempts]
+					attempts++
+
+					if status == http.StatusOK {
+						return mockResponse(status, `{"ok": true}`), nil
+					}
+					resp := mockResponse(status, `{"error": {"message": "try again"}}`)
+					if tt.retryAfter != "" {
+						resp.Header.Set("Retry-After", tt.retryAfter)
+					}
+					return resp, nil
+				},
+			}
+
+			var delays []time.Duration
+			client := NewTestClient(mockClient)
+			client.maxRetries = tt.maxRetries
+			client.retryBaseDelay = 100 * time.Millisecond
+			client.sleep = func(d time.Duration) { delays = append(delays, d) }
+
+			_, err := client.doRequest(context.Background(), "GET", "/incidents", nil, nil)
+
+			if tt.wantError {
+				assertError(t, err)
+			} else {
+				assertNoError(t, err)
+			}
+
+			if attempts != tt.expectedAttempts {
+				t.Errorf("expected %d attempts, got %d", tt.expectedAttempts, attempts)
+			}
+			if len(delays) != tt.expectedAttempts-1 {
+				t.Errorf("expected %d backoff waits, got %d", tt.expectedAttempts-1, len(delays))
+			}
+			for _, delay := range delays {
+				if tt.expectedDelay > 0 && delay != tt.expectedDelay {
+					t.Errorf("expected Retry-After delay %v, got %v", tt.expectedDelay, delay)
+				}
+			}
+		})
+	}
+}
+
+func TestDoRequestRetriesWrites(t *testing.T) {
+	tests := []struct {
+		name             string
+		status           int
+		body             interface{}
+		wantError        bool
+		expectedAttempts int
+	}{
+		{
+			name:             "retries rate limited writes",
+			status:           http.StatusTooManyRequests,
+			body:             map[string]string{"name": "Database outage"},
+			expectedAttempts: 2,
+		},
+		{
+			name:             "does not retry gateway errors on writes",
+			status:           http.StatusBadGateway,
+			body:             map[string]string{"name": "Database outage"},
+			wantError:        true,
+			expectedAttempts: 1,
+		},
+		{
+			name:             "retries gateway errors on writes with an idempotency key",
+			status:           http.StatusGatewayTimeout,
+			body:             map[string]string{"name": "Database outage", "idempotency_key": "key-1"},
+			expectedAttempts: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			mockClient := &MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					attempts++
+					if attempts == 1 {
+						return mockResponse(tt.status, `{"error": {"message": "try again"}}`), nil
+					}
+					return mockResponse(http.StatusOK, `{"ok": true}`), nil
+				},
+			}
+
+			client := NewTestClient(mockClient)
+			client.maxRetries = 3
+			client.sleep = func(time.Duration) {}
+
+			_, err := client.doRequest(context.Background(), "POST", "/incidents", nil, tt.body)
+
+			if tt.wantError {
+				assertError(t, err)
+			} else {
+				assertNoError(t, err)
+			}
+			if attempts != tt.expectedAttempts {
+				t.Errorf("expected %d attempts, got %d", tt.expectedAttempts, attempts)
+			}
+		})
+	}
+}
+
+func TestDoRequestRetriesNetworkErrors(t *testing.T) {
+	tests := []struct {
+		name             string
+		method           string
+		err              error
+		wantError        bool
+		expectedAttempts int
+	}{
+		{
+			name:             "retries connection refused",
+			method:           "GET",
+			err:              &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED},
+			expectedAttempts: 2,
+		},
+		{
+			name:             "retries connection reset",
+			method:           "DELETE",
+			err:              &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET},
+			expectedAttempts: 2,
+		},
+		{
+			name:             "retries temporary DNS failures",
+			method:           "GET",
+			err:              &net.DNSError{Err: "server misbehaving", Name: "api.test.incident.io", IsTemporary: true},
+			expectedAttempts: 2,
+		},
+		{
+			name:             "does not retry non-idempotent requests",
+			method:           "POST",
+			err:              &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET},
+			wantError:        true,
+			expectedAttempts: 1,
+		},
+		{
+			name:             "does not retry context cancellation",
+			method:           "GET",
+			err:              context.Canceled,
+			wantError:        true,
+			expectedAttempts: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			mockClient := &MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					attempts++
+					if attempts == 1 {
+						return nil, tt.err
+					}
+					return mockResponse(http.StatusOK, `{"ok": true}`), nil
+				},
+			}
+
+			waits := 0
+			client := NewTestClient(mockClient)
+			client.maxRetries = 3
+			client.retryBaseDelay = 100 * time.Millisecond
+			client.sleep = func(time.Duration) { waits++ }
+
+			_, err := client.doRequest(context.Background(), tt.method, "/incidents", nil, nil)
+
+			if tt.wantError {
+				assertError(t, err)
+			} else {
+				assertNoError(t, err)
+			}
+
+			if attempts != tt.expectedAttempts {
+				t.Errorf("expected %d attempts, got %d", tt.expectedAttempts, attempts)
+			}
+			if waits != tt.expectedAttempts-1 {
+				t.Errorf("expected %d backoff waits, got %d", tt.expectedAttempts-1, waits)
+			}
+		})
+	}
+}
+
+func TestDoRequestContextCancellation(t *testing.T) {
+	t.Run("cancels an in-flight request", func(t *testing.T) {
+		started := make(chan struct{})
+		mockClient := &MockHTTPClient{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				close(started)
+				<-req.Context().Done()
+				return nil, req.Context().Err()
+			},
+		}
+
+		client := NewTestClient(mockClient)
+		client.maxRetries = 3
+
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			<-started
+			cancel()
+		}()
+
+		_, err := client.ListIncidents(ctx, &ListIncidentsOptions{PageSize: 10})
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", err)
+		}
+	})
+
+	t.Run("stops waiting between retries", func(t *testing.T) {
+		mockClient := &MockHTTPClient{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				resp := mockResponse(http.StatusTooManyRequests, `{"error": {"message": "slow down"}}`)
+				resp.Header.Set("Retry-After", "30")
+				return resp, nil
+			},
+		}
+
+		client := NewTestClient(mockClient)
+		client.maxRetries = 3
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		_, err := client.GetIncident(ctx, "01FDAG4SAP5TYPT98WGR2N7")
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+		}
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Errorf("expected backoff to stop when the context ended, waited %v", elapsed)
+		}
+	})
+
+	t.Run("stops waiting between retries with a configured client", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Retry-After", "30")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer server.Close()
+		t.Setenv("INCIDENT_IO_BASE_URL", server.URL)
+
+		client, err := NewClientWithAPIKey("test-key")
+		assertNoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(50*time.Millisecond, cancel)
+
+		start := time.Now()
+		_, err = client.GetIncident(ctx, "01FDAG4SAP5TYPT98WGR2N7")
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", err)
+		}
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Errorf("expected backoff to stop when the context was cancelled, waited %v", elapsed)
+		}
+	})
+}
+
+func TestRetryDelayBackoff(t *testing.T) {
+	client := &Client{retryBaseDelay: 100 * time.Millisecond}
+
+	for attempt := 0; attempt < 4; attempt++ {
+		minDelay := client.retryBaseDelay << attempt
+		maxDelay := minDelay + client.retryBaseDelay
+
+		delay := client.retryDelay(attempt, "")
+		if delay < minDelay || delay >= maxDelay {
+			t.Errorf("attempt %d: expected delay in [%v, %v), got %v", attempt, minDelay, maxDelay, delay)
+		}
+	}
+}
+
+func TestNewClientRetryConfig(t *testing.T) {
+	t.Setenv("INCIDENT_IO_API_KEY", "test-key")
+	t.Setenv("INCIDENT_IO_MAX_RETRIES", "5")
+	t.Setenv("INCIDENT_IO_RETRY_BASE_MS", "250")
+
+	client, err := NewClient()
+	assertNoError(t, err)
+
+	if client.maxRetries != 5 {
+		t.Errorf("expected 5 max retries, got %d", client.maxRetries)
+	}
+	if client.retryBaseDelay != 250*time.Millisecond {
+		t.Errorf("expected 250ms base delay, got %v", client.retryBaseDelay)
+	}
+
+	t.Setenv("INCIDENT_IO_MAX_RETRIES", "lots")
+	_, err = NewClient()
+	assertError(t, err)
+}
+
+func TestNewClientHTTPTimeout(t *testing.T) {
+	t.Setenv("INCIDENT_IO_API_KEY", "test-key")
+
+	tests := []struct {
+		value     string
+		expected  time.Duration
+		wantError bool
+	}{
+		{value: "", expected: 30 * time.Second},
+		{value: "45", expected: 45 * time.Second},
+		{value: "1m30s", expected: 90 * time.Second},
+		{value: "250ms", expected: 250 * time.Millisecond},
+		{value: "0", wantError: true},
+		{value: "soon", wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			t.Setenv("INCIDENT_IO_HTTP_TIMEOUT", tt.value)
+
+			client, err := NewClient()
+			if tt.wantError {
+				assertError(t, err)
+				return
+			}
+			assertNoError(t, err)
+
+			if client.httpClient.Timeout != tt.expected {
+				t.Errorf("expected timeout %v, got %v", tt.expected, client.httpClient.Timeout)
+			}
+		})
+	}
+}
+
+func TestNewClientRegion(t *testing.T) {
+	t.Setenv("INCIDENT_IO_API_KEY", "test-key")
+
+	tests := []struct {
+		name      string
+		region    string
+		baseURL   string
+		expected  string
+		wantError bool
+	}{
+		{name: "default", expected: "https://api.incident.io/v2"},
+		{name: "us", region: "us", expected: "https://api.incident.io/v2"},
+		{name: "eu", region: "EU", expected: "https://api.eu.incident.io/v2"},
+		{name: "base URL overrides region", region: "eu", baseURL: "http://localhost:8080/v2", expected: "http://localhost:8080/v2"},
+		{name: "unknown region", region: "apac", wantError: true},
+		{name: "unknown region with base URL", region: "mars", baseURL: "http://localhost:8080/v2", wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("INCIDENT_IO_REGION", tt.region)
+			t.Setenv("INCIDENT_IO_BASE_URL", tt.baseURL)
+
+			client, err := NewClient()
+			if tt.wantError {
+				assertError(t, err)
+				return
+			}
+			assertNoError(t, err)
+			assertEqual(t, tt.expected, client.BaseURL())
+		})
+	}
+}
+
+func TestVersionedEndpointsFollowBaseURL(t *testing.T) {
+	var gotURL string
+	client := NewTestClient(&MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			gotURL = req.URL.String()
+			return mockResponse(http.StatusOK, `{"severities": []}`), nil
+		},
+	})
+	client.SetBaseURL("https://api.eu.incident.io/v2")
+
+	_, err := client.ListSeverities(context.Background())
+	assertNoError(t, err)
+	assertEqual(t, "https://api.eu.incident.io/v1/severities", gotURL)
+	assertEqual(t, "https://api.eu.incident.io/v2", client.BaseURL())
+}
+
+func TestNewClientAPIKeyFile(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "api-key")
+	if err := os.WriteFile(keyFile, []byte("  file-api-key\n"), 0o600); err != nil {
+		t.Fatalf("failed to write key file: %v", err)
+	}
+	emptyFile := filepath.Join(t.TempDir(), "empty")
+	if err := os.WriteFile(emptyFile, []byte("\n"), 0o600); err != nil {
+		t.Fatalf("failed to write key file: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		envKey  string
+		keyFile string
+		want    string
+		wantErr string
+	}{
+		{name: "key from file", keyFile: keyFile, want: "file-api-key"},
+		{name: "environment variable takes precedence", envKey: "env-api-key", keyFile: keyFile, want: "env-api-key"},
+		{name: "missing file", keyFile: filepath.Join(t.TempDir(), "missing"), wantErr: "failed to read API key from INCIDENT_IO_API_KEY_FILE"},
+		{name: "empty file", keyFile: emptyFile, wantErr: "is empty"},
+		{name: "neither set", wantErr: "INCIDENT_IO_API_KEY environment variable is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("INCIDENT_IO_API_KEY", tt.envKey)
+			t.Setenv("INCIDENT_IO_API_KEY_FILE", tt.keyFile)
+
+			client, err := NewClient()
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got: %v", tt.wantErr, err)
+				}
+				return
+			}
+			assertNoError(t, err)
+			assertEqual(t, tt.want, client.apiKey)
+		})
+	}
+}
+
+func TestHTTPTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Hang until the client gives up
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer server.Close()
+
+	t.Setenv("INCIDENT_IO_API_KEY", "test-key")
+	t.Setenv("INCIDENT_IO_BASE_URL", server.URL)
+	t.Setenv("INCIDENT_IO_HTTP_TIMEOUT", "50ms")
+	t.Setenv("INCIDENT_IO_MAX_RETRIES", "0")
+
+	client, err := NewClient()
+	assertNoError(t, err)
+
+	start := time.Now()
+	_, err = client.GetIncident(context.Background(), "inc_1")
+	elapsed := time.Since(start)
+
+	var netErr net.Error
+	if !errors.As(err, &netErr) || !netErr.Timeout() {
+		t.Fatalf("expected a timeout error, got %v", err)
+	}
+	if elapsed > time.Second {
+		t.Errorf("expected the request to time out promptly, took %v", elapsed)
+	}
+}
+
+func TestDryRun(t *testing.T) {
+	var methods []string
+	client := NewTestClient(&MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			methods = append(methods, req.Method)
+			return mockResponse(http.StatusOK, `{"severities": []}`), nil
+		},
+	})
+	ctx := WithDryRun(context.Background())
+
+	// Reads are still sent so arguments can be validated
+	if _, err := client.ListSeverities(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err := client.CreateIncident(ctx, &CreateIncidentRequest{Name: "Database outage"})
+	dryRun, ok := AsDryRun(err)
+	if !ok {
+		t.Fatalf("expected dry run error, got: %v", err)
+	}
+	assertEqual(t, "POST", dryRun.Method)
+	assertEqual(t, "https://api.test.incident.io/incidents", dryRun.URL)
+	if !strings.Contains(string(dryRun.Body), `"name":"Database outage"`) {
+		t.Errorf("expected request body in dry run error, got %s", dryRun.Body)
+	}
+
+	if err := client.DeleteAlertRoute(ctx, "ar_123"); err == nil {
+		t.Error("expected delete to be stopped by dry run")
+	}
+
+	if len(methods) != 1 || methods[0] != "GET" {
+		t.Errorf("expected only the GET request to be sent, got %v", methods)
+	}
+}
@@ -0,0 +1,145 @@
+package incidentio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// CustomField represents a custom field definition in incident.io
+type CustomField struct {
+	ID                 string              `json:"id"`
+	Name               string              `json:"name"`
+	Description        string              `json:"description"`
+	FieldType          string              `json:"field_type"`
+	Required           string              `json:"required"` // "never", "before_closure" or "always"
+	ShowBeforeCreation bool                `json:"show_before_creation"`
+	ShowBeforeClosure  bool                `json:"show_before_closure"`
+	ShowBeforeUpdate   bool                `json:"show_before_update"`
+	Options            []CustomFieldOption `json:"options,omitempty"`
+	CreatedAt          time.Time           `json:"created_at"`
+	UpdatedAt          time.Time           `json:"updated_at"`
+}
+
+// CustomFieldOption represents a selectable option for a custom field
+type CustomFieldOption struct {
+	ID            string `json:"id"`
+	CustomFieldID string `json:"custom_field_id"`
+	Value         string `json:"value"`
+	SortKey       int    `json:"sort_key"`
+}
+
+// RequiredBeforeClosure reports whether the field must be set before an incident can be closed
+func (f CustomField) RequiredBeforeClosure() bool {
+	return f.Required == "before_closure" || f.Required == "always"
+}
+
+// ListCustomFieldsResponse represents the response from listing custom fields
+type ListCustomFieldsResponse struct {
+	CustomFields []CustomField `json:"custom_fields"`
+}
+
+// ListCustomFields returns all custom field definitions
+func (c *Client) ListCustomFields(ctx context.Context) (*ListCustomFieldsResponse, error) {
+	respBody, err := c.doRequest(ctx, "GET", "/custom_fields", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ListCustomFieldsResponse
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetCustomField retrieves a single custom field definition, including its
+// options
+func (c *Client) GetCustomField(ctx context.Context, id string) (*CustomField, error) {
+	respBody, err := c.doRequest(ctx, "GET", "/custom_fields/"+url.PathEscape(id), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		CustomField CustomField `json:"custom_field"`
+	}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &response.CustomField, nil
+}
+
+// CreateCustomFieldOptionRequest represents a request to add an option to a
+// select custom field
+type CreateCustomFieldOptionRequest struct {
+	CustomFieldID string `json:"custom_field_id"`
+	Value         string `json:"value"`
+	SortKey       int    `json:"sort_key"`
+}
+
+// CreateCustomFieldOption adds an option to a single_select or multi_select
+// custom field
+func (c *Client) CreateCustomFieldOption(ctx context.Context, req *CreateCustomFieldOptionRequest) (*CustomFieldOption, error) {
+	// Note: Custom field options are under the V1 API, not V2
+	respBody, err := c.doRequestURL(ctx, "POST", c.apiRoot()+"/v1/custom_field_options", nil, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalCustomFieldOption(respBody)
+}
+
+// UpdateCustomFieldOptionRequest represents a request to rename or reorder a
+// custom field option. The API replaces both fields, so callers updating only
+// one must send the current value of the other.
+type UpdateCustomFieldOptionRequest struct {
+	Value   string `json:"value"`
+	SortKey int    `json:"sort_key"`
+}
+
+// GetCustomFieldOption retrieves a single custom field option by ID
+func (c *Client) GetCustomFieldOption(ctx context.Context, id string) (*CustomFieldOption, error) {
+	respBody, err := c.doRequestURL(ctx, "GET", c.customFieldOptionURL(id), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalCustomFieldOption(respBody)
+}
+
+// UpdateCustomFieldOption renames or reorders a custom field option
+func (c *Client) UpdateCustomFieldOption(ctx context.Context, id string, req *UpdateCustomFieldOptionRequest) (*CustomFieldOption, error) {
+	respBody, err := c.doRequestURL(ctx, "PUT", c.customFieldOptionURL(id), nil, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalCustomFieldOption(respBody)
+}
+
+// DeleteCustomFieldOption removes an option from a select custom field
+func (c *Client) DeleteCustomFieldOption(ctx context.Context, id string) error {
+	_, err := c.doRequestURL(ctx, "DELETE", c.customFieldOptionURL(id), nil, nil)
+	return err
+}
+
+// customFieldOptionURL is the V1 API URL of a single custom field option
+func (c *Client) customFieldOptionURL(id string) string {
+	return c.apiRoot() + "/v1/custom_field_options/" + url.PathEscape(id)
+}
+
+func unmarshalCustomFieldOption(respBody []byte) (*CustomFieldOption, error) {
+	var response struct {
+		CustomFieldOption CustomFieldOption `json:"custom_field_option"`
+	}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &response.CustomFieldOption, nil
+}
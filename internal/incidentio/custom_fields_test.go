@@ -0,0 +1,193 @@
+package incidentio
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestListCustomFields(t *testing.T) {
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			assertEqual(t, "GET", req.Method)
+			assertEqual(t, "/custom_fields", req.URL.Path)
+			return mockResponse(http.StatusOK, `{
+				"custom_fields": [
+					{
+						"id": "cf_root_cause",
+						"name": "Root Cause",
+						"field_type": "text",
+						"required": "before_closure",
+						"show_before_closure": true
+					},
+					{
+						"id": "cf_team",
+						"name": "Team",
+						"field_type": "single_select",
+						"required": "never",
+						"options": [
+							{"id": "opt_payments", "custom_field_id": "cf_team", "value": "Payments", "sort_key": 10}
+						]
+					}
+				]
+			}`), nil
+		},
+	}
+
+	client := NewTestClient(mockClient)
+	result, err := client.ListCustomFields(context.Background())
+	assertNoError(t, err)
+
+	if len(result.CustomFields) != 2 {
+		t.Fatalf("expected 2 custom fields, got %d", len(result.CustomFields))
+	}
+
+	rootCause := result.CustomFields[0]
+	assertEqual(t, "Root Cause", rootCause.Name)
+	if !rootCause.RequiredBeforeClosure() {
+		t.Error("expected Root Cause to be required before closure")
+	}
+
+	team := result.CustomFields[1]
+	if team.RequiredBeforeClosure() {
+		t.Error("expected Team not to be required before closure")
+	}
+	if len(team.Options) != 1 {
+		t.Fatalf("expected 1 option, got %d", len(team.Options))
+	}
+	assertEqual(t, "Payments", team.Options[0].Value)
+}
+
+func TestCreateCustomFieldOption(t *testing.T) {
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			assertEqual(t, "POST", req.Method)
+			assertEqual(t, "/v1/custom_field_options", req.URL.Path)
+
+			var body CreateCustomFieldOptionRequest
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode request body: %v", err)
+			}
+			assertEqual(t, "cf_team", body.CustomFieldID)
+			assertEqual(t, "Search", body.Value)
+			if body.SortKey != 20 {
+				t.Errorf("expected sort_key 20, got %d", body.SortKey)
+			}
+
+			return mockResponse(http.StatusCreated, `{
+				"custom_field_option": {"id": "opt_search", "custom_field_id": "cf_team", "value": "Search", "sort_key": 20}
+			}`), nil
+		},
+	}
+
+	client := NewTestClient(mockClient)
+	option, err := client.CreateCustomFieldOption(context.Background(), &CreateCustomFieldOptionRequest{
+		CustomFieldID: "cf_team",
+		Value:         "Search",
+		SortKey:       20,
+	})
+	assertNoError(t, err)
+	assertEqual(t, "opt_search", option.ID)
+	assertEqual(t, "Search", option.Value)
+}
+
+func TestDeleteCustomFieldOption(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockStatusCode int
+		mockResponse   string
+		wantNotFound   bool
+	}{
+		{
+			name:           "successful delete",
+			mockStatusCode: http.StatusNoContent,
+		},
+		{
+			name:           "option not found",
+			mockStatusCode: http.StatusNotFound,
+			mockResponse:   `{"type": "not_found", "status": 404, "errors": [{"code": "not_found", "message": "Custom field option not found"}]}`,
+			wantNotFound:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					assertEqual(t, "DELETE", req.Method)
+					assertEqual(t, "/v1/custom_field_options/opt_typo", req.URL.Path)
+					return mockResponse(tt.mockStatusCode, tt.mockResponse), nil
+				},
+			}
+
+			client := NewTestClient(mockClient)
+			err := client.DeleteCustomFieldOption(context.Background(), "opt_typo")
+			if tt.wantNotFound {
+				if !IsNotFound(err) {
+					t.Errorf("expected a not found error, got: %v", err)
+				}
+				return
+			}
+			assertNoError(t, err)
+		})
+	}
+}
+
+func TestUpdateCustomFieldOption(t *testing.T) {
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			assertEqual(t, "PUT", req.Method)
+			assertEqual(t, "/v1/custom_field_options/opt_search", req.URL.Path)
+
+			var body UpdateCustomFieldOptionRequest
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode request body: %v", err)
+			}
+			assertEqual(t, "Search & Discovery", body.Value)
+
+			return mockResponse(http.StatusOK, `{
+				"custom_field_option": {"id": "opt_search", "custom_field_id": "cf_team", "value": "Search & Discovery", "sort_key": 5}
+			}`), nil
+		},
+	}
+
+	client := NewTestClient(mockClient)
+	option, err := client.UpdateCustomFieldOption(context.Background(), "opt_search", &UpdateCustomFieldOptionRequest{
+		Value:   "Search & Discovery",
+		SortKey: 5,
+	})
+	assertNoError(t, err)
+	assertEqual(t, "Search & Discovery", option.Value)
+	if option.SortKey != 5 {
+		t.Errorf("expected sort_key 5, got %d", option.SortKey)
+	}
+}
+
+func TestGetCustomField(t *testing.T) {
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			assertEqual(t, "GET", req.Method)
+			assertEqual(t, "/custom_fields/cf_team", req.URL.Path)
+			return mockResponse(http.StatusOK, `{
+				"custom_field": {
+					"id": "cf_team",
+					"name": "Team",
+					"field_type": "single_select",
+					"options": [
+						{"id": "opt_payments", "custom_field_id": "cf_team", "value": "Payments", "sort_key": 10}
+					]
+				}
+			}`), nil
+		},
+	}
+
+	client := NewTestClient(mockClient)
+	field, err := client.GetCustomField(context.Background(), "cf_team")
+	assertNoError(t, err)
+	assertEqual(t, "Team", field.Name)
+	if len(field.Options) != 1 {
+		t.Fatalf("expected 1 option, got %d", len(field.Options))
+	}
+	assertEqual(t, "opt_payments", field.Options[0].ID)
+}
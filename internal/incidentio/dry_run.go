@@ -0,0 +1,58 @@
+package incidentio
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+type dryRunContextKey struct{}
+
+// DryRunError is returned instead of sending a mutating request (anything
+// other than GET, HEAD or OPTIONS) while dry-run mode is on. It carries the
+// request that would have been sent, so callers can show it as a preview.
+type DryRunError struct {
+	Method string
+	URL    string
+	// Body is the JSON request body, or nil for requests without one
+	Body json.RawMessage
+}
+
+func (e *DryRunError) Error() string {
+	return fmt.Sprintf("dry run: %s %s was not sent", e.Method, e.URL)
+}
+
+// AsDryRun reports whether err is a DryRunError, returning it if so
+func AsDryRun(err error) (*DryRunError, bool) {
+	var dryRun *DryRunError
+	if errors.As(err, &dryRun) {
+		return dryRun, true
+	}
+	return nil, false
+}
+
+// WithDryRun returns a context in which the client does not send mutating
+// requests, even if DRY_RUN is not set
+func WithDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dryRunContextKey{}, true)
+}
+
+// isDryRun reports whether mutating requests made with ctx must not be sent
+func (c *Client) isDryRun(ctx context.Context) bool {
+	dryRun, _ := ctx.Value(dryRunContextKey{}).(bool)
+	return c.dryRun || dryRun
+}
+
+// parseDryRun parses the DRY_RUN environment variable. Unset means off.
+func parseDryRun(value string) (bool, error) {
+	if value == "" {
+		return false, nil
+	}
+	enabled, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("DRY_RUN must be true or false, got %q", value)
+	}
+	return enabled, nil
+}
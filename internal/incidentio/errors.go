@@ -0,0 +1,214 @@
+package incidentio
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/logging"
+)
+
+// APIError is an error response from the incident.io API. Callers can use
+// errors.As to inspect the status code and any field-level validation errors.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response
+	StatusCode int
+	// Type is the error type reported by the API, e.g. "validation_error"
+	Type string
+	// Message is the top-level error message, if the API sent one
+	Message string
+	// Code is the error code from legacy {"error": {...}} responses
+	Code string
+	// RequestID identifies the request for incident.io support
+	RequestID string
+	// Errors lists individual problems, usually one per invalid field
+	Errors []APIFieldError
+	// Body is the raw response body, used when it could not be parsed
+	Body string
+
+	// RetryAfter is how long the API asked callers to wait before retrying,
+	// from the Retry-After header. Zero when the header was absent.
+	RetryAfter time.Duration
+	// RateLimitLimit and RateLimitRemaining come from the X-RateLimit-Limit
+	// and X-RateLimit-Remaining headers. Nil when the headers were absent.
+	RateLimitLimit     *int
+	RateLimitRemaining *int
+}
+
+// APIFieldError is a single problem reported in an API error response
+type APIFieldError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	// Field is the request field the error refers to, if any
+	Field string `json:"field,omitempty"`
+}
+
+func (e *APIError) Error() string {
+	message := e.Message
+	if message == "" && len(e.Errors) > 0 {
+		parts := make([]string, len(e.Errors))
+		for i, fieldErr := range e.Errors {
+			parts[i] = fieldErr.String()
+		}
+		message = strings.Join(parts, "; ")
+	}
+
+	var text string
+	if message == "" {
+		text = fmt.Sprintf("HTTP %d: %s", e.StatusCode, e.Body)
+	} else {
+		text = fmt.Sprintf("API error: %s (HTTP %d)", message, e.StatusCode)
+	}
+
+	if e.StatusCode == http.StatusTooManyRequests {
+		text += " - rate limited by incident.io"
+		if e.RetryAfter > 0 {
+			text += fmt.Sprintf(", retry after %s", e.RetryAfter)
+		}
+		if e.RateLimitRemaining != nil && e.RateLimitLimit != nil {
+			text += fmt.Sprintf(", %d of %d requests remaining", *e.RateLimitRemaining, *e.RateLimitLimit)
+		} else if e.RateLimitRemaining != nil {
+			text += fmt.Sprintf(", %d requests remaining", *e.RateLimitRemaining)
+		}
+	}
+	return text
+}
+
+// String formats the error as "field: message", or just the message when it
+// does not refer to a field
+func (e APIFieldError) String() string {
+	if e.Field == "" {
+		return e.Message
+	}
+	return e.Field + ": " + e.Message
+}
+
+// IsNotFound reports whether err is an API error for a missing resource (HTTP 404)
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// IsForbidden reports whether err is an API error for a request the API key
+// is not allowed to make (HTTP 403)
+func IsForbidden(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusForbidden
+}
+
+// IsRateLimited reports whether err is an API error for a rate limited
+// request (HTTP 429), returning how long the API asked callers to wait
+func IsRateLimited(err error) (time.Duration, bool) {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+	return apiErr.RetryAfter, true
+}
+
+// parseErrorResponse builds an APIError from an incident.io error response.
+// Both the current {"type", "status", "errors": [...]} body shape and the
+// legacy {"error": {"message", "code"}} shape are understood, and any
+// Retry-After and X-RateLimit-* headers are recorded.
+func parseErrorResponse(statusCode int, header http.Header, respBody []byte) error {
+	apiErr := &APIError{StatusCode: statusCode, Body: string(respBody)}
+
+	if retryAfter, ok := parseRetryAfter(header.Get("Retry-After")); ok {
+		apiErr.RetryAfter = retryAfter
+	}
+	apiErr.RateLimitLimit = parseIntHeader(header, "X-RateLimit-Limit")
+	apiErr.RateLimitRemaining = parseIntHeader(header, "X-RateLimit-Remaining")
+
+	var body struct {
+		Type      string          `json:"type"`
+		Message   string          `json:"message"`
+		RequestID string          `json:"request_id"`
+		Error     json.RawMessage `json:"error"`
+		Errors    []struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+			Source  struct {
+				Field string `json:"field"`
+			} `json:"source"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(respBody, &body); err != nil {
+		return apiErr
+	}
+
+	apiErr.Type = body.Type
+	apiErr.Message = body.Message
+	apiErr.RequestID = body.RequestID
+	for _, fieldErr := range body.Errors {
+		apiErr.Errors = append(apiErr.Errors, APIFieldError{
+			Code:    fieldErr.Code,
+			Message: fieldErr.Message,
+			Field:   fieldErr.Source.Field,
+		})
+	}
+
+	// Legacy responses nest the message under "error"
+	var legacy struct {
+		Message string `json:"message"`
+		Code    string `json:"code"`
+	}
+	if len(body.Error) > 0 && json.Unmarshal(body.Error, &legacy) == nil {
+		if apiErr.Message == "" {
+			apiErr.Message = legacy.Message
+		}
+		apiErr.Code = legacy.Code
+	}
+
+	return apiErr
+}
+
+// parseRetryAfter parses a Retry-After header given either as seconds or as
+// an HTTP date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date), true
+	}
+	return 0, false
+}
+
+// parseIntHeader returns the header's value as an int, or nil if it is
+// missing or not a number
+func parseIntHeader(header http.Header, key string) *int {
+	value, err := strconv.Atoi(header.Get(key))
+	if err != nil {
+		return nil
+	}
+	return &value
+}
+
+// redactedError hides credentials in the message of a wrapped error while
+// keeping it available to errors.Is and errors.As
+type redactedError struct {
+	err     error
+	message string
+}
+
+func (e *redactedError) Error() string { return e.message }
+func (e *redactedError) Unwrap() error { return e.err }
+
+// redactError strips the API key and any bearer tokens from err's message
+func (c *Client) redactError(err error) error {
+	if err == nil {
+		return nil
+	}
+	message := logging.Redact(err.Error(), c.apiKey)
+	if message == err.Error() {
+		return err
+	}
+	return &redactedError{err: err, message: message}
+}
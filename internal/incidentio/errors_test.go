@@ -0,0 +1,149 @@
+package incidentio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseErrorResponse(t *testing.T) {
+	t.Run("validation error with field errors", func(t *testing.T) {
+		err := parseErrorResponse(http.StatusUnprocessableEntity, nil, []byte(`{
+			"type": "validation_error",
+			"status": 422,
+			"request_id": "req_123",
+			"errors": [
+				{"code": "is_required", "message": "Name is required", "source": {"field": "name"}},
+				{"code": "invalid_value", "message": "Severity not found", "source": {"field": "severity_id"}}
+			]
+		}`))
+
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("expected *APIError, got %T", err)
+		}
+		if apiErr.StatusCode != http.StatusUnprocessableEntity {
+			t.Errorf("expected status 422, got %d", apiErr.StatusCode)
+		}
+		assertEqual(t, "validation_error", apiErr.Type)
+		assertEqual(t, "req_123", apiErr.RequestID)
+
+		if len(apiErr.Errors) != 2 {
+			t.Fatalf("expected 2 field errors, got %+v", apiErr.Errors)
+		}
+		assertEqual(t, "name", apiErr.Errors[0].Field)
+		assertEqual(t, "is_required", apiErr.Errors[0].Code)
+		assertEqual(t, "Name is required", apiErr.Errors[0].Message)
+		assertEqual(t, "severity_id", apiErr.Errors[1].Field)
+
+		assertEqual(t, "API error: name: Name is required; severity_id: Severity not found (HTTP 422)", err.Error())
+	})
+
+	t.Run("legacy error body", func(t *testing.T) {
+		err := parseErrorResponse(http.StatusNotFound, nil, []byte(`{"error": {"message": "Incident not found", "code": "not_found"}}`))
+
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("expected *APIError, got %T", err)
+		}
+		assertEqual(t, "Incident not found", apiErr.Message)
+		assertEqual(t, "not_found", apiErr.Code)
+		assertEqual(t, "API error: Incident not found (HTTP 404)", err.Error())
+	})
+
+	t.Run("unparseable body", func(t *testing.T) {
+		err := parseErrorResponse(http.StatusBadGateway, nil, []byte(`<html>Bad Gateway</html>`))
+		assertEqual(t, "HTTP 502: <html>Bad Gateway</html>", err.Error())
+	})
+}
+
+func TestRateLimitError(t *testing.T) {
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			resp := mockResponse(http.StatusTooManyRequests, `{"type": "rate_limited", "status": 429, "errors": [{"code": "rate_limited", "message": "Too many requests"}]}`)
+			resp.Header.Set("Retry-After", "30")
+			resp.Header.Set("X-RateLimit-Limit", "1200")
+			resp.Header.Set("X-RateLimit-Remaining", "0")
+			return resp, nil
+		},
+	}
+
+	// NewTestClient does not retry, so the first 429 is returned
+	_, err := NewTestClient(mockClient).GetIncident(context.Background(), "inc_1")
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %T: %v", err, err)
+	}
+	if apiErr.RetryAfter != 30*time.Second {
+		t.Errorf("expected RetryAfter 30s, got %v", apiErr.RetryAfter)
+	}
+	if apiErr.RateLimitLimit == nil || *apiErr.RateLimitLimit != 1200 {
+		t.Errorf("expected RateLimitLimit 1200, got %v", apiErr.RateLimitLimit)
+	}
+	if apiErr.RateLimitRemaining == nil || *apiErr.RateLimitRemaining != 0 {
+		t.Errorf("expected RateLimitRemaining 0, got %v", apiErr.RateLimitRemaining)
+	}
+
+	assertEqual(t, "API error: Too many requests (HTTP 429) - rate limited by incident.io, retry after 30s, 0 of 1200 requests remaining", err.Error())
+
+	retryAfter, ok := IsRateLimited(err)
+	if !ok || retryAfter != 30*time.Second {
+		t.Errorf("expected IsRateLimited to return 30s, got %v, %t", retryAfter, ok)
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusNotFound, `{"type": "not_found", "status": 404, "errors": [{"code": "not_found", "message": "Incident not found"}]}`), nil
+		},
+	}
+
+	_, err := NewTestClient(mockClient).GetIncident(context.Background(), "inc_missing")
+	if !IsNotFound(err) {
+		t.Errorf("expected IsNotFound to be true for %v", err)
+	}
+	if !IsNotFound(fmt.Errorf("failed to get incident: %w", err)) {
+		t.Error("expected IsNotFound to see through wrapped errors")
+	}
+	if IsNotFound(errors.New("HTTP 404 mentioned in a plain error")) {
+		t.Error("expected IsNotFound to be false for non-API errors")
+	}
+}
+
+func TestRedactError(t *testing.T) {
+	client := NewTestClient(&MockHTTPClient{})
+	cause := errors.New("connection reset")
+
+	err := client.redactError(fmt.Errorf("request failed with Authorization: Bearer test-api-key (key test-api-key): %w", cause))
+
+	assertEqual(t, "request failed with Authorization: Bearer *** (key ***): connection reset", err.Error())
+	if !errors.Is(err, cause) {
+		t.Errorf("expected redacted error to wrap the original cause")
+	}
+
+	// Errors without credentials are returned unchanged
+	plain := errors.New("request failed")
+	if client.redactError(plain) != plain {
+		t.Errorf("expected error without credentials to be returned as is")
+	}
+}
+
+func TestRequestErrorRedactsAPIKey(t *testing.T) {
+	client := NewTestClient(&MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return nil, fmt.Errorf("proxy rejected %s", req.Header.Get("Authorization"))
+		},
+	})
+
+	_, err := client.doRequest(context.Background(), "POST", "/incidents", nil, nil)
+	assertError(t, err)
+	if strings.Contains(err.Error(), "test-api-key") {
+		t.Errorf("expected API key to be redacted, got: %v", err)
+	}
+}
@@ -0,0 +1,56 @@
+package incidentio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// EscalationPath represents an escalation path that alert routes can escalate to
+type EscalationPath struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ListEscalationsParams contains optional parameters for listing escalation paths
+type ListEscalationsParams struct {
+	PageSize int
+	After    string
+}
+
+// ListEscalationsResponse represents the response from listing escalation paths
+type ListEscalationsResponse struct {
+	EscalationPaths []EscalationPath `json:"escalation_paths"`
+	Pagination      struct {
+		After    string `json:"after,omitempty"`
+		PageSize int    `json:"page_size"`
+	} `json:"pagination_info"`
+}
+
+// ListEscalations returns escalation paths, whose IDs are used in alert route
+// escalation bindings
+func (c *Client) ListEscalations(ctx context.Context, params *ListEscalationsParams) (*ListEscalationsResponse, error) {
+	v := url.Values{}
+	if params != nil {
+		v = buildQuery(map[string]interface{}{
+			"page_size": params.PageSize,
+			"after":     params.After,
+		})
+	}
+
+	respBody, err := c.doRequest(ctx, "GET", "/escalation_paths", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ListEscalationsResponse
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,72 @@
+package incidentio
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestListEscalations(t *testing.T) {
+	tests := []struct {
+		name          string
+		params        *ListEscalationsParams
+		mockResponse  string
+		expectedQuery map[string]string
+		expectedIDs   []string
+		expectedAfter string
+	}{
+		{
+			name:   "basic list",
+			params: nil,
+			mockResponse: `{
+				"escalation_paths": [
+					{"id": "esc_primary", "name": "Primary on-call", "created_at": "2024-01-01T00:00:00Z", "updated_at": "2024-01-01T00:00:00Z"},
+					{"id": "esc_platform", "name": "Platform team", "created_at": "2024-01-02T00:00:00Z", "updated_at": "2024-01-02T00:00:00Z"}
+				],
+				"pagination_info": {"page_size": 25}
+			}`,
+			expectedQuery: map[string]string{"page_size": "", "after": ""},
+			expectedIDs:   []string{"esc_primary", "esc_platform"},
+		},
+		{
+			name:   "with pagination",
+			params: &ListEscalationsParams{PageSize: 1, After: "esc_primary"},
+			mockResponse: `{
+				"escalation_paths": [
+					{"id": "esc_platform", "name": "Platform team"}
+				],
+				"pagination_info": {"page_size": 1, "after": "esc_platform"}
+			}`,
+			expectedQuery: map[string]string{"page_size": "1", "after": "esc_primary"},
+			expectedIDs:   []string{"esc_platform"},
+			expectedAfter: "esc_platform",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					assertEqual(t, "GET", req.Method)
+					assertEqual(t, "/escalation_paths", req.URL.Path)
+					for key, value := range tt.expectedQuery {
+						assertEqual(t, value, req.URL.Query().Get(key))
+					}
+					return mockResponse(http.StatusOK, tt.mockResponse), nil
+				},
+			}
+
+			client := NewTestClient(mockClient)
+			result, err := client.ListEscalations(context.Background(), tt.params)
+
+			assertNoError(t, err)
+			if len(result.EscalationPaths) != len(tt.expectedIDs) {
+				t.Fatalf("expected %d escalation paths, got %d", len(tt.expectedIDs), len(result.EscalationPaths))
+			}
+			for i, id := range tt.expectedIDs {
+				assertEqual(t, id, result.EscalationPaths[i].ID)
+			}
+			assertEqual(t, tt.expectedAfter, result.Pagination.After)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package incidentio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// FollowUpStatusCompleted is the status of a follow-up that has been done
+const FollowUpStatusCompleted = "completed"
+
+// ListFollowUpsOptions represents options for listing follow-ups
+type ListFollowUpsOptions struct {
+	PageSize   int
+	After      string
+	IncidentID string
+	Status     []string
+}
+
+// ListFollowUpsResponse represents the response from listing follow-ups
+type ListFollowUpsResponse struct {
+	FollowUps []FollowUp `json:"follow_ups"`
+	ListResponse
+}
+
+// CreateFollowUpRequest represents a request to create a follow-up
+type CreateFollowUpRequest struct {
+	IncidentID  string `json:"incident_id,omitempty"`
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	AssigneeID  string `json:"assignee_id,omitempty"`
+	PriorityID  string `json:"priority_id,omitempty"`
+}
+
+// UpdateFollowUpRequest represents a request to update a follow-up. Only
+// non-empty fields are sent.
+type UpdateFollowUpRequest struct {
+	Title       string     `json:"title,omitempty"`
+	Description string     `json:"description,omitempty"`
+	Status      string     `json:"status,omitempty"`
+	AssigneeID  string     `json:"assignee_id,omitempty"`
+	PriorityID  string     `json:"priority_id,omitempty"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// ListFollowUps retrieves a page of follow-ups with optional filtering
+func (c *Client) ListFollowUps(ctx context.Context, opts *ListFollowUpsOptions) (*ListFollowUpsResponse, error) {
+	params := url.Values{}
+	if opts != nil {
+		params = buildQuery(map[string]interface{}{
+			"incident_id": opts.IncidentID,
+			"status":      opts.Status,
+			"page_size":   opts.PageSize,
+			"after":       opts.After,
+		})
+	}
+
+	respBody, err := c.doRequest(ctx, "GET", "/follow_ups", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ListFollowUpsResponse
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// CreateFollowUp creates a new follow-up
+func (c *Client) CreateFollowUp(ctx context.Context, req *CreateFollowUpRequest) (*FollowUp, error) {
+	if req.Title == "" {
+		return nil, fmt.Errorf("title is required")
+	}
+
+	respBody, err := c.doRequest(ctx, "POST", "/follow_ups", nil, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		FollowUp FollowUp `json:"follow_up"`
+	}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &response.FollowUp, nil
+}
+
+// UpdateFollowUp updates an existing follow-up
+func (c *Client) UpdateFollowUp(ctx context.Context, id string, req *UpdateFollowUpRequest) (*FollowUp, error) {
+	respBody, err := c.doRequest(ctx, "PATCH", fmt.Sprintf("/follow_ups/%s", id), nil, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		FollowUp FollowUp `json:"follow_up"`
+	}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &response.FollowUp, nil
+}
+
+// CompleteFollowUp marks a follow-up as completed, recording the current time
+// as its completion time
+func (c *Client) CompleteFollowUp(ctx context.Context, id string) (*FollowUp, error) {
+	completedAt := time.Now().UTC()
+	return c.UpdateFollowUp(ctx, id, &UpdateFollowUpRequest{
+		Status:      FollowUpStatusCompleted,
+		CompletedAt: &completedAt,
+	})
+}
@@ -1,6 +1,7 @@
 package incidentio
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -12,15 +13,15 @@ type ListIncidentStatusesResponse struct {
 	IncidentStatuses []IncidentStatus `json:"incident_statuses"`
 }
 
-// ListIncidentStatuses returns all incident statuses
-func (c *Client) ListIncidentStatuses() (*ListIncidentStatusesResponse, error) {
-	// Note: Incident statuses are under V1 API, not V2
-	// We need to temporarily change the base URL for this request
-	originalBaseURL := c.BaseURL()
-	c.SetBaseURL("https://api.incident.io/v1")
-	defer func() { c.SetBaseURL(originalBaseURL) }()
+// ListIncidentStatuses returns all incident statuses. Results are cached for the
+// metadata cache TTL; callers must not modify the returned response.
+func (c *Client) ListIncidentStatuses(ctx context.Context) (*ListIncidentStatusesResponse, error) {
+	if cached, ok := c.cache.get(cacheKeyIncidentStatuses); ok {
+		return cached.(*ListIncidentStatusesResponse), nil
+	}
 
-	respBody, err := c.doRequest("GET", "/incident_statuses", nil, nil)
+	// Note: Incident statuses are under V1 API, not V2
+	respBody, err := c.doRequestURL(ctx, "GET", c.apiRoot()+"/v1/incident_statuses", nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -30,5 +31,6 @@ func (c *Client) ListIncidentStatuses() (*ListIncidentStatusesResponse, error) {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
+	c.cache.set(cacheKeyIncidentStatuses, &response)
 	return &response, nil
 }
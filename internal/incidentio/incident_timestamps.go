@@ -0,0 +1,34 @@
+package incidentio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// ListIncidentTimestampsResponse represents the response from listing incident timestamps
+type ListIncidentTimestampsResponse struct {
+	IncidentTimestamps []IncidentTimestamp `json:"incident_timestamps"`
+}
+
+// ListIncidentTimestamps returns all incident timestamp definitions. Results
+// are cached for the metadata cache TTL; callers must not modify the returned
+// response.
+func (c *Client) ListIncidentTimestamps(ctx context.Context) (*ListIncidentTimestampsResponse, error) {
+	if cached, ok := c.cache.get(cacheKeyIncidentTimestamps); ok {
+		return cached.(*ListIncidentTimestampsResponse), nil
+	}
+
+	respBody, err := c.doRequest(ctx, "GET", "/incident_timestamps", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ListIncidentTimestampsResponse
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	c.cache.set(cacheKeyIncidentTimestamps, &response)
+	return &response, nil
+}
@@ -1,6 +1,7 @@
 package incidentio
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -12,9 +13,14 @@ type ListIncidentTypesResponse struct {
 	IncidentTypes []IncidentType `json:"incident_types"`
 }
 
-// ListIncidentTypes returns all incident types
-func (c *Client) ListIncidentTypes() (*ListIncidentTypesResponse, error) {
-	respBody, err := c.doRequest("GET", "/incident_types", nil, nil)
+// ListIncidentTypes returns all incident types. Results are cached for the
+// metadata cache TTL; callers must not modify the returned response.
+func (c *Client) ListIncidentTypes(ctx context.Context) (*ListIncidentTypesResponse, error) {
+	if cached, ok := c.cache.get(cacheKeyIncidentTypes); ok {
+		return cached.(*ListIncidentTypesResponse), nil
+	}
+
+	respBody, err := c.doRequest(ctx, "GET", "/incident_types", nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -24,5 +30,6 @@ func (c *Client) ListIncidentTypes() (*ListIncidentTypesResponse, error) {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
+	c.cache.set(cacheKeyIncidentTypes, &response)
 	return &response, nil
 }
@@ -1,29 +1,24 @@
 package incidentio
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
-	"strconv"
 )
 
 // ListIncidentUpdates retrieves incident updates with optional filtering
-func (c *Client) ListIncidentUpdates(opts *ListIncidentUpdatesOptions) (*ListIncidentUpdatesResponse, error) {
+func (c *Client) ListIncidentUpdates(ctx context.Context, opts *ListIncidentUpdatesOptions) (*ListIncidentUpdatesResponse, error) {
 	params := url.Values{}
-
 	if opts != nil {
-		if opts.IncidentID != "" {
-			params.Set("incident_id", opts.IncidentID)
-		}
-		if opts.PageSize > 0 {
-			params.Set("page_size", strconv.Itoa(opts.PageSize))
-		}
-		if opts.After != "" {
-			params.Set("after", opts.After)
-		}
+		params = buildQuery(map[string]interface{}{
+			"incident_id": opts.IncidentID,
+			"page_size":   opts.PageSize,
+			"after":       opts.After,
+		})
 	}
 
-	respBody, err := c.doRequest("GET", "/incident_updates", params, nil)
+	respBody, err := c.doRequest(ctx, "GET", "/incident_updates", params, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -37,8 +32,8 @@ func (c *Client) ListIncidentUpdates(opts *ListIncidentUpdatesOptions) (*ListInc
 }
 
 // GetIncidentUpdate retrieves a specific incident update by ID
-func (c *Client) GetIncidentUpdate(id string) (*IncidentUpdate, error) {
-	respBody, err := c.doRequest("GET", fmt.Sprintf("/incident_updates/%s", id), nil, nil)
+func (c *Client) GetIncidentUpdate(ctx context.Context, id string) (*IncidentUpdate, error) {
+	respBody, err := c.doRequest(ctx, "GET", fmt.Sprintf("/incident_updates/%s", id), nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -54,7 +49,7 @@ func (c *Client) GetIncidentUpdate(id string) (*IncidentUpdate, error) {
 }
 
 // CreateIncidentUpdate creates a new incident update
-func (c *Client) CreateIncidentUpdate(req *CreateIncidentUpdateRequest) (*IncidentUpdate, error) {
+func (c *Client) CreateIncidentUpdate(ctx context.Context, req *CreateIncidentUpdateRequest) (*IncidentUpdate, error) {
 	// Validate required fields
 	if req.IncidentID == "" {
 		return nil, fmt.Errorf("incident_id is required")
@@ -63,7 +58,7 @@ func (c *Client) CreateIncidentUpdate(req *CreateIncidentUpdateRequest) (*Incide
 		return nil, fmt.Errorf("message is required")
 	}
 
-	respBody, err := c.doRequest("POST", "/incident_updates", nil, req)
+	respBody, err := c.doRequest(ctx, "POST", "/incident_updates", nil, req)
 	if err != nil {
 		return nil, err
 	}
@@ -79,7 +74,7 @@ func (c *Client) CreateIncidentUpdate(req *CreateIncidentUpdateRequest) (*Incide
 }
 
 // DeleteIncidentUpdate deletes an incident update
-func (c *Client) DeleteIncidentUpdate(id string) error {
-	_, err := c.doRequest("DELETE", fmt.Sprintf("/incident_updates/%s", id), nil, nil)
+func (c *Client) DeleteIncidentUpdate(ctx context.Context, id string) error {
+	_, err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/incident_updates/%s", id), nil, nil)
 	return err
 }
@@ -1,6 +1,7 @@
 package incidentio
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -9,16 +10,21 @@ import (
 
 // ListIncidentsOptions represents options for listing incidents
 type ListIncidentsOptions struct {
-	PageSize        int
-	After           string
-	Status          []string
-	Severity        []string
-	CreatedAtGTE    string // Greater than or equal to date filter (ISO 8601 format)
-	CreatedAtLTE    string // Less than or equal to date filter (ISO 8601 format)
-	CreatedAtRange  string // Date range filter (format: "2024-12-02~2024-12-08")
-	UpdatedAtGTE    string // Greater than or equal to date filter (ISO 8601 format)
-	UpdatedAtLTE    string // Less than or equal to date filter (ISO 8601 format)
-	UpdatedAtRange  string // Date range filter (format: "2024-12-02~2024-12-08")
+	PageSize       int
+	After          string
+	Status         []string
+	Severity       []string
+	SeverityGTE    *Severity           // Only incidents at least as severe as this (by rank)
+	SeverityLTE    *Severity           // Only incidents at most as severe as this (by rank)
+	Mode           []string            // Incident modes (standard, retrospective, tutorial)
+	CustomFields   map[string][]string // Custom field ID to the option IDs to match (any of them)
+	CreatedAtGTE   string              // Greater than or equal to date filter (ISO 8601 format)
+	CreatedAtLTE   string              // Less than or equal to date filter (ISO 8601 format)
+	CreatedAtRange string              // Date range filter (format: "2024-12-02~2024-12-08")
+	UpdatedAtGTE   string              // Greater than or equal to date filter (ISO 8601 format)
+	UpdatedAtLTE   string              // Less than or equal to date filter (ISO 8601 format)
+	UpdatedAtRange string              // Date range filter (format: "2024-12-02~2024-12-08")
+	SortBy         string              // API sort order (created_at_newest_first or created_at_oldest_first)
 }
 
 // ListIncidentsResponse represents the response from listing incidents
@@ -27,51 +33,54 @@ type ListIncidentsResponse struct {
 	ListResponse
 }
 
+// queryFields returns the filter and sort parameters shared by single-page and
+// auto-paginated incident listing
+func (opts *ListIncidentsOptions) queryFields() map[string]interface{} {
+	return map[string]interface{}{
+		"status_category": map[string]interface{}{"one_of": opts.Status},
+		"severity": map[string]interface{}{
+			"one_of": opts.Severity,
+			"gte":    severityID(opts.SeverityGTE),
+			"lte":    severityID(opts.SeverityLTE),
+		},
+		"mode": map[string]interface{}{"one_of": opts.Mode},
+		"created_at": map[string]interface{}{
+			"gte":        opts.CreatedAtGTE,
+			"lte":        opts.CreatedAtLTE,
+			"date_range": opts.CreatedAtRange,
+		},
+		"updated_at": map[string]interface{}{
+			"gte":        opts.UpdatedAtGTE,
+			"lte":        opts.UpdatedAtLTE,
+			"date_range": opts.UpdatedAtRange,
+		},
+		"custom_field": customFieldQuery(opts.CustomFields),
+		"sort_by":      opts.SortBy,
+	}
+}
+
+// customFieldQuery builds custom_field[<field ID>][one_of] filters
+func customFieldQuery(customFields map[string][]string) map[string]interface{} {
+	query := make(map[string]interface{}, len(customFields))
+	for fieldID, optionIDs := range customFields {
+		query[fieldID] = map[string]interface{}{"one_of": optionIDs}
+	}
+	return query
+}
+
 // ListIncidents retrieves a list of incidents with automatic pagination
-func (c *Client) ListIncidents(opts *ListIncidentsOptions) (*ListIncidentsResponse, error) {
+func (c *Client) ListIncidents(ctx context.Context, opts *ListIncidentsOptions) (*ListIncidentsResponse, error) {
 	allIncidents := []Incident{}
 	pageSize := 250 // Default max page size
 	after := ""
 
 	// If a specific page size is requested, respect it and don't paginate
 	if opts != nil && opts.PageSize > 0 {
-		params := url.Values{}
-		params.Set("page_size", strconv.Itoa(opts.PageSize))
+		fields := opts.queryFields()
+		fields["page_size"] = opts.PageSize
+		fields["after"] = opts.After
 
-		if opts.After != "" {
-			params.Set("after", opts.After)
-		}
-
-		for _, status := range opts.Status {
-			params.Add("status_category[one_of]", status)
-		}
-		for _, severity := range opts.Severity {
-			params.Add("severity[one_of]", severity)
-		}
-
-		// Add date filters for created_at
-		if opts.CreatedAtGTE != "" {
-			params.Set("created_at[gte]", opts.CreatedAtGTE)
-		}
-		if opts.CreatedAtLTE != "" {
-			params.Set("created_at[lte]", opts.CreatedAtLTE)
-		}
-		if opts.CreatedAtRange != "" {
-			params.Set("created_at[date_range]", opts.CreatedAtRange)
-		}
-
-		// Add date filters for updated_at
-		if opts.UpdatedAtGTE != "" {
-			params.Set("updated_at[gte]", opts.UpdatedAtGTE)
-		}
-		if opts.UpdatedAtLTE != "" {
-			params.Set("updated_at[lte]", opts.UpdatedAtLTE)
-		}
-		if opts.UpdatedAtRange != "" {
-			params.Set("updated_at[date_range]", opts.UpdatedAtRange)
-		}
-
-		respBody, err := c.doRequest("GET", "/incidents", params, nil)
+		respBody, err := c.doRequest(ctx, "GET", "/incidents", buildQuery(fields), nil)
 		if err != nil {
 			return nil, err
 		}
@@ -81,6 +90,9 @@ func (c *Client) ListIncidents(opts *ListIncidentsOptions) (*ListIncidentsRespon
 			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 		}
 
+		response.Incidents = filterIncidentsByMode(response.Incidents, opts.Mode)
+		response.Incidents = filterIncidentsBySeverityRank(response.Incidents, opts.SeverityGTE, opts.SeverityLTE)
+
 		// API returns total_record_count for single page requests
 		return &response, nil
 	}
@@ -88,38 +100,12 @@ func (c *Client) ListIncidents(opts *ListIncidentsOptions) (*ListIncidentsRespon
 	// Set up base parameters for auto-pagination
 	baseParams := url.Values{}
 	if opts != nil {
-		for _, status := range opts.Status {
-			baseParams.Add("status_category[one_of]", status)
-		}
-		for _, severity := range opts.Severity {
-			baseParams.Add("severity[one_of]", severity)
-		}
-
-		// Add date filters for created_at
-		if opts.CreatedAtGTE != "" {
-			baseParams.Set("created_at[gte]", opts.CreatedAtGTE)
-		}
-		if opts.CreatedAtLTE != "" {
-			baseParams.Set("created_at[lte]", opts.CreatedAtLTE)
-		}
-		if opts.CreatedAtRange != "" {
-			baseParams.Set("created_at[date_range]", opts.CreatedAtRange)
-		}
-
-		// Add date filters for updated_at
-		if opts.UpdatedAtGTE != "" {
-			baseParams.Set("updated_at[gte]", opts.UpdatedAtGTE)
-		}
-		if opts.UpdatedAtLTE != "" {
-			baseParams.Set("updated_at[lte]", opts.UpdatedAtLTE)
-		}
-		if opts.UpdatedAtRange != "" {
-			baseParams.Set("updated_at[date_range]", opts.UpdatedAtRange)
-		}
+		baseParams = buildQuery(opts.queryFields())
 	}
 
 	// Paginate through all results
 	maxPages := 10 // Safety limit
+	guard := NewPaginationGuard("incidents", after)
 	for page := 0; page < maxPages; page++ {
 		params := url.Values{}
 		// Copy base parameters
@@ -132,7 +118,7 @@ func (c *Client) ListIncidents(opts *ListIncidentsOptions) (*ListIncidentsRespon
 			params.Set("after", after)
 		}
 
-		respBody, err := c.doRequest("GET", "/incidents", params, nil)
+		respBody, err := c.doRequest(ctx, "GET", "/incidents", params, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -142,12 +128,20 @@ func (c *Client) ListIncidents(opts *ListIncidentsOptions) (*ListIncidentsRespon
 			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 		}
 
-		allIncidents = append(allIncidents, response.Incidents...)
+		if opts != nil {
+			incidents := filterIncidentsByMode(response.Incidents, opts.Mode)
+			allIncidents = append(allIncidents, filterIncidentsBySeverityRank(incidents, opts.SeverityGTE, opts.SeverityLTE)...)
+		} else {
+			allIncidents = append(allIncidents, response.Incidents...)
+		}
 
 		// Check if there are more pages
 		if response.PaginationMeta.After == "" || len(response.Incidents) == 0 {
 			break
 		}
+		if err := guard.Next(response.PaginationMeta.After, len(response.Incidents)); err != nil {
+			return nil, err
+		}
 		after = response.PaginationMeta.After
 	}
 
@@ -168,9 +162,68 @@ func (c *Client) ListIncidents(opts *ListIncidentsOptions) (*ListIncidentsRespon
 	}, nil
 }
 
+// filterIncidentsByMode drops incidents whose mode is not one of modes, in case
+// the API returns incidents outside the requested mode filter. Incidents
+// without a mode are kept.
+func filterIncidentsByMode(incidents []Incident, modes []string) []Incident {
+	if len(modes) == 0 {
+		return incidents
+	}
+
+	filtered := make([]Incident, 0, len(incidents))
+	for _, incident := range incidents {
+		if incident.Mode == "" || containsString(modes, incident.Mode) {
+			filtered = append(filtered, incident)
+		}
+	}
+	return filtered
+}
+
+// filterIncidentsBySeverityRank drops incidents less severe than gte or more
+// severe than lte, comparing severity ranks (higher is more severe), in case
+// the API returns incidents outside the requested range. Incidents without a
+// severity are dropped when either bound is set.
+func filterIncidentsBySeverityRank(incidents []Incident, gte, lte *Severity) []Incident {
+	if gte == nil && lte == nil {
+		return incidents
+	}
+
+	filtered := make([]Incident, 0, len(incidents))
+	for _, incident := range incidents {
+		if incident.Severity.ID == "" {
+			continue
+		}
+		if gte != nil && incident.Severity.Rank < gte.Rank {
+			continue
+		}
+		if lte != nil && incident.Severity.Rank > lte.Rank {
+			continue
+		}
+		filtered = append(filtered, incident)
+	}
+	return filtered
+}
+
+// severityID returns the ID of severity, or "" when it is nil
+func severityID(severity *Severity) string {
+	if severity == nil {
+		return ""
+	}
+	return severity.ID
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
 // GetIncident retrieves a specific incident by ID
-func (c *Client) GetIncident(id string) (*Incident, error) {
-	respBody, err := c.doRequest("GET", fmt.Sprintf("/incidents/%s", id), nil, nil)
+func (c *Client) GetIncident(ctx context.Context, id string) (*Incident, error) {
+	respBody, err := c.doRequest(ctx, "GET", fmt.Sprintf("/incidents/%s", id), nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -186,8 +239,8 @@ func (c *Client) GetIncident(id string) (*Incident, error) {
 }
 
 // CreateIncident creates a new incident
-func (c *Client) CreateIncident(req *CreateIncidentRequest) (*Incident, error) {
-	respBody, err := c.doRequest("POST", "/incidents", nil, req)
+func (c *Client) CreateIncident(ctx context.Context, req *CreateIncidentRequest) (*Incident, error) {
+	respBody, err := c.doRequest(ctx, "POST", "/incidents", nil, req)
 	if err != nil {
 		return nil, err
 	}
@@ -203,7 +256,7 @@ func (c *Client) CreateIncident(req *CreateIncidentRequest) (*Incident, error) {
 }
 
 // UpdateIncident updates an existing incident using V2 actions/edit API
-func (c *Client) UpdateIncident(id string, req *UpdateIncidentRequest) (*Incident, error) {
+func (c *Client) UpdateIncident(ctx context.Context, id string, req *UpdateIncidentRequest) (*Incident, error) {
 	// Use the correct V2 actions/edit endpoint
 	editRequest := map[string]interface{}{
 		"notify_incident_channel": true,
@@ -239,6 +292,9 @@ func (c *Client) UpdateIncident(id string, req *UpdateIncidentRequest) (*Inciden
 	if len(req.IncidentTimestampValues) > 0 {
 		incident["incident_timestamp_values"] = req.IncidentTimestampValues
 	}
+	if req.RetrospectiveIncidentOptions != nil {
+		incident["retrospective_incident_options"] = req.RetrospectiveIncidentOptions
+	}
 
 	// Only include incident object if there are fields to update
 	if len(incident) > 0 {
@@ -247,7 +303,66 @@ func (c *Client) UpdateIncident(id string, req *UpdateIncidentRequest) (*Inciden
 		return nil, fmt.Errorf("no fields to update")
 	}
 
-	respBody, err := c.doRequest("POST", fmt.Sprintf("/incidents/%s/actions/edit", id), nil, editRequest)
+	respBody, err := c.doRequest(ctx, "POST", fmt.Sprintf("/incidents/%s/actions/edit", id), nil, editRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Incident Incident `json:"incident"`
+	}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &response.Incident, nil
+}
+
+// UnassignIncidentRole clears the assignee of a role on an incident using the
+// V2 actions/edit API. The assignment is sent without a user so the role
+// becomes vacant.
+func (c *Client) UnassignIncidentRole(ctx context.Context, incidentID, roleID string) (*Incident, error) {
+	if incidentID == "" || roleID == "" {
+		return nil, fmt.Errorf("both incident ID and incident role ID are required")
+	}
+
+	editRequest := map[string]interface{}{
+		"notify_incident_channel": true,
+		"incident": map[string]interface{}{
+			"incident_role_assignments": []map[string]interface{}{
+				{"incident_role_id": roleID},
+			},
+		},
+	}
+
+	respBody, err := c.doRequest(ctx, "POST", fmt.Sprintf("/incidents/%s/actions/edit", incidentID), nil, editRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Incident Incident `json:"incident"`
+	}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &response.Incident, nil
+}
+
+// MergeIncidents merges the source incident into the target incident using the
+// V2 actions/merge API and returns the resulting target incident
+func (c *Client) MergeIncidents(ctx context.Context, sourceID, targetID string) (*Incident, error) {
+	if sourceID == "" || targetID == "" {
+		return nil, fmt.Errorf("both source and target incident IDs are required")
+	}
+	if sourceID == targetID {
+		return nil, fmt.Errorf("cannot merge incident %s into itself", sourceID)
+	}
+
+	respBody, err := c.doRequest(ctx, "POST", fmt.Sprintf("/incidents/%s/actions/merge", sourceID), nil, map[string]interface{}{
+		"target_incident_id": targetID,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -269,8 +384,8 @@ type AssignIncidentRoleRequest struct {
 }
 
 // AssignIncidentRole assigns a specific role to a user for an incident
-func (c *Client) AssignIncidentRole(incidentID string, req *AssignIncidentRoleRequest) (*Incident, error) {
-	respBody, err := c.doRequest("PATCH", fmt.Sprintf("/incidents/%s", incidentID), nil, map[string]interface{}{
+func (c *Client) AssignIncidentRole(ctx context.Context, incidentID string, req *AssignIncidentRoleRequest) (*Incident, error) {
+	respBody, err := c.doRequest(ctx, "PATCH", fmt.Sprintf("/incidents/%s", incidentID), nil, map[string]interface{}{
 		"incident_role_assignments": []map[string]interface{}{
 			{
 				"incident_role_id": req.IncidentRoleID,
@@ -301,8 +416,8 @@ func (c *Client) AssignIncidentRole(incidentID string, req *AssignIncidentRoleRe
 //
 // NOTE: If has_debrief is true but no URL is available, the incident still has a debrief
 // but it may be accessible through other means (UI, separate API endpoint, etc.)
-func (c *Client) GetIncidentDebrief(id string) (*Incident, error) {
-	incident, err := c.GetIncident(id)
+func (c *Client) GetIncidentDebrief(ctx context.Context, id string) (*Incident, error) {
+	incident, err := c.GetIncident(ctx, id)
 	if err != nil {
 		return nil, err
 	}
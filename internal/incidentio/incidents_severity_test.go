@@ -1,7 +1,9 @@
 package incidentio
 
 import (
+	"context"
 	"net/http"
+	"reflect"
 	"testing"
 )
 
@@ -195,7 +197,7 @@ func TestListIncidentsSeverityFilter(t *testing.T) {
 			}
 
 			client := NewTestClient(mockClient)
-			result, err := client.ListIncidents(tt.params)
+			result, err := client.ListIncidents(context.Background(), tt.params)
 
 			if tt.wantError {
 				assertError(t, err)
@@ -303,7 +305,7 @@ func TestListIncidentsSeverityFilterErrors(t *testing.T) {
 			}
 
 			client := NewTestClient(mockClient)
-			_, err := client.ListIncidents(tt.params)
+			_, err := client.ListIncidents(context.Background(), tt.params)
 
 			if tt.wantError {
 				assertError(t, err)
@@ -350,7 +352,7 @@ func TestListIncidentsSeverityParameterMigration(t *testing.T) {
 		}
 
 		client := NewTestClient(mockClient)
-		_, err := client.ListIncidents(params)
+		_, err := client.ListIncidents(context.Background(), params)
 
 		assertNoError(t, err)
 
@@ -369,3 +371,79 @@ func contains(s, substr string) bool {
 	return len(s) >= len(substr) && s[:len(substr)] == substr ||
 		   (len(s) > len(substr) && contains(s[1:], substr))
 }
+
+// TestListIncidentsSeverityRange verifies severity gte/lte bounds are sent to
+// the API and applied by rank to the incidents it returns
+func TestListIncidentsSeverityRange(t *testing.T) {
+	var severityQuery map[string][]string
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if req.URL.Path == "/v1/severities" {
+				return mockResponse(http.StatusOK, `{"severities": [
+					{"id": "sev_minor", "name": "Minor", "rank": 1},
+					{"id": "sev_major", "name": "Major", "rank": 2},
+					{"id": "sev_critical", "name": "Critical", "rank": 3}
+				]}`), nil
+			}
+
+			severityQuery = map[string][]string{
+				"gte": req.URL.Query()["severity[gte]"],
+				"lte": req.URL.Query()["severity[lte]"],
+			}
+			// Respond with every incident, as if the API ignored the filter
+			return mockResponse(http.StatusOK, `{
+				"incidents": [
+					{"id": "inc_critical", "severity": {"id": "sev_critical", "name": "Critical", "rank": 3}},
+					{"id": "inc_major", "severity": {"id": "sev_major", "name": "Major", "rank": 2}},
+					{"id": "inc_minor", "severity": {"id": "sev_minor", "name": "Minor", "rank": 1}},
+					{"id": "inc_unset"}
+				],
+				"pagination_meta": {"page_size": 25}
+			}`), nil
+		},
+	}
+	client := NewTestClient(mockClient)
+
+	severities, err := client.ListSeverities(context.Background())
+	assertNoError(t, err)
+	major := severities.Severities[1]
+
+	tests := []struct {
+		name    string
+		opts    *ListIncidentsOptions
+		wantIDs []string
+		wantGTE []string
+		wantLTE []string
+	}{
+		{
+			name:    "at least Major",
+			opts:    &ListIncidentsOptions{PageSize: 25, SeverityGTE: &major},
+			wantIDs: []string{"inc_critical", "inc_major"},
+			wantGTE: []string{"sev_major"},
+		},
+		{
+			name:    "at most Major",
+			opts:    &ListIncidentsOptions{PageSize: 25, SeverityLTE: &major},
+			wantIDs: []string{"inc_major", "inc_minor"},
+			wantLTE: []string{"sev_major"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := client.ListIncidents(context.Background(), tt.opts)
+			assertNoError(t, err)
+
+			var ids []string
+			for _, incident := range resp.Incidents {
+				ids = append(ids, incident.ID)
+			}
+			if !reflect.DeepEqual(ids, tt.wantIDs) {
+				t.Errorf("expected incidents %v, got %v", tt.wantIDs, ids)
+			}
+			if !reflect.DeepEqual(severityQuery["gte"], tt.wantGTE) || !reflect.DeepEqual(severityQuery["lte"], tt.wantLTE) {
+				t.Errorf("expected severity[gte]=%v severity[lte]=%v, got %v", tt.wantGTE, tt.wantLTE, severityQuery)
+			}
+		})
+	}
+}
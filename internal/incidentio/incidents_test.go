@@ -1,6 +1,7 @@
 package incidentio
 
 import (
+	"context"
 	"net/http"
 	"testing"
 )
@@ -113,7 +114,7 @@ func TestListIncidents(t *testing.T) {
 			}
 
 			client := NewTestClient(mockClient)
-			result, err := client.ListIncidents(tt.params)
+			result, err := client.ListIncidents(context.Background(), tt.params)
 
 			if tt.wantError {
 				assertError(t, err)
@@ -206,7 +207,7 @@ func TestGetIncident(t *testing.T) {
 			}
 
 			client := NewTestClient(mockClient)
-			incident, err := client.GetIncident(tt.incidentID)
+			incident, err := client.GetIncident(context.Background(), tt.incidentID)
 
 			if tt.wantError {
 				assertError(t, err)
@@ -347,7 +348,7 @@ func TestCreateIncident(t *testing.T) {
 			}
 
 			client := NewTestClient(mockClient)
-			incident, err := client.CreateIncident(tt.request)
+			incident, err := client.CreateIncident(context.Background(), tt.request)
 
 			if tt.wantError {
 				assertError(t, err)
@@ -459,7 +460,7 @@ func TestUpdateIncident(t *testing.T) {
 			}
 
 			client := NewTestClient(mockClient)
-			incident, err := client.UpdateIncident(tt.incidentID, tt.request)
+			incident, err := client.UpdateIncident(context.Background(), tt.incidentID, tt.request)
 
 			if tt.wantError {
 				assertError(t, err)
@@ -485,6 +486,87 @@ func TestUpdateIncident(t *testing.T) {
 		})
 	}
 }
+
+func TestMergeIncidents(t *testing.T) {
+	tests := []struct {
+		name           string
+		sourceID       string
+		targetID       string
+		mockResponse   string
+		mockStatusCode int
+		wantError      bool
+		errorContains  string
+		expectRequest  bool
+	}{
+		{
+			name:     "successful merge",
+			sourceID: "inc_duplicate",
+			targetID: "inc_primary",
+			mockResponse: `{
+				"incident": {
+					"id": "inc_primary",
+					"reference": "INC-100",
+					"name": "Database outage",
+					"created_at": "2024-01-01T00:00:00Z",
+					"updated_at": "2024-01-01T05:00:00Z"
+				}
+			}`,
+			mockStatusCode: http.StatusOK,
+			expectRequest:  true,
+		},
+		{
+			name:          "self merge rejected",
+			sourceID:      "inc_primary",
+			targetID:      "inc_primary",
+			wantError:     true,
+			errorContains: "into itself",
+			expectRequest: false,
+		},
+		{
+			name:           "closed incident returns 422",
+			sourceID:       "inc_closed",
+			targetID:       "inc_primary",
+			mockResponse:   `{"error": {"message": "Closed incidents cannot be merged", "code": "unprocessable_entity"}}`,
+			mockStatusCode: http.StatusUnprocessableEntity,
+			wantError:      true,
+			errorContains:  "Closed incidents cannot be merged",
+			expectRequest:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requested := false
+			mockClient := &MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					requested = true
+					assertEqual(t, "POST", req.Method)
+					assertEqual(t, "/incidents/"+tt.sourceID+"/actions/merge", req.URL.Path)
+					return mockResponse(tt.mockStatusCode, tt.mockResponse), nil
+				},
+			}
+
+			client := NewTestClient(mockClient)
+			incident, err := client.MergeIncidents(context.Background(), tt.sourceID, tt.targetID)
+
+			if requested != tt.expectRequest {
+				t.Errorf("expected request to be sent: %v, got: %v", tt.expectRequest, requested)
+			}
+
+			if tt.wantError {
+				assertError(t, err)
+				if !contains(err.Error(), tt.errorContains) {
+					t.Errorf("expected error containing %q, got %q", tt.errorContains, err.Error())
+				}
+				return
+			}
+
+			assertNoError(t, err)
+			assertEqual(t, tt.targetID, incident.ID)
+		})
+	}
+}
+
 func TestGetIncidentDebrief(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -620,7 +702,7 @@ func TestGetIncidentDebrief(t *testing.T) {
 			}
 
 			client := NewTestClient(mockClient)
-			incident, err := client.GetIncidentDebrief(tt.incidentID)
+			incident, err := client.GetIncidentDebrief(context.Background(), tt.incidentID)
 
 			if tt.wantError {
 				assertError(t, err)
@@ -0,0 +1,143 @@
+package incidentio
+
+import (
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Metrics receives counts of the client's API traffic. Endpoints are labelled
+// with the method and path, with IDs replaced by {id}, e.g.
+// "GET /v2/incidents/{id}". Implementations must be safe for concurrent use.
+type Metrics interface {
+	// IncRequest counts a request sent to the API, including each retry
+	IncRequest(endpoint string)
+	// IncError counts a failed request by response status, or 0 when no
+	// response was received
+	IncError(endpoint string, statusCode int)
+	// IncRetry counts a request that is about to be retried
+	IncRetry(endpoint string)
+}
+
+// noopMetrics discards every count, and is used when no collector is set
+type noopMetrics struct{}
+
+func (noopMetrics) IncRequest(string)    {}
+func (noopMetrics) IncError(string, int) {}
+func (noopMetrics) IncRetry(string)      {}
+
+// SetMetrics sets the collector the client reports its requests to. A nil
+// collector turns metrics off.
+func (c *Client) SetMetrics(metrics Metrics) {
+	c.metrics = metrics
+}
+
+func (c *Client) metricsCollector() Metrics {
+	if c.metrics == nil {
+		return noopMetrics{}
+	}
+	return c.metrics
+}
+
+// idSegment matches path segments that identify a single resource: anything
+// with a digit, other than the API version
+var (
+	idSegment      = regexp.MustCompile(`\d`)
+	versionSegment = regexp.MustCompile(`^v\d+$`)
+)
+
+// endpointLabel names the endpoint a request URL was sent to, keeping the
+// number of distinct labels small by replacing IDs with {id}
+func endpointLabel(method, endpoint string) string {
+	path := endpoint
+	if parsed, err := url.Parse(endpoint); err == nil {
+		path = parsed.Path
+	}
+
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if idSegment.MatchString(segment) && !versionSegment.MatchString(segment) {
+			segments[i] = "{id}"
+		}
+	}
+	return method + " " + strings.Join(segments, "/")
+}
+
+// MetricsSnapshot is a point-in-time copy of a CountingMetrics' counters
+type MetricsSnapshot struct {
+	// Requests counts requests sent, by endpoint
+	Requests map[string]int64 `json:"requests"`
+	// Errors counts failed requests by endpoint, then by status code or
+	// "network" when no response was received
+	Errors map[string]map[string]int64 `json:"errors"`
+	// Retries counts retried requests, by endpoint
+	Retries map[string]int64 `json:"retries"`
+}
+
+// CountingMetrics is a Metrics collector that keeps counters in memory
+type CountingMetrics struct {
+	mu       sync.Mutex
+	requests map[string]int64
+	errors   map[string]map[string]int64
+	retries  map[string]int64
+}
+
+func NewCountingMetrics() *CountingMetrics {
+	return &CountingMetrics{
+		requests: map[string]int64{},
+		errors:   map[string]map[string]int64{},
+		retries:  map[string]int64{},
+	}
+}
+
+func (m *CountingMetrics) IncRequest(endpoint string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests[endpoint]++
+}
+
+func (m *CountingMetrics) IncError(endpoint string, statusCode int) {
+	status := "network"
+	if statusCode > 0 {
+		status = strconv.Itoa(statusCode)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.errors[endpoint] == nil {
+		m.errors[endpoint] = map[string]int64{}
+	}
+	m.errors[endpoint][status]++
+}
+
+func (m *CountingMetrics) IncRetry(endpoint string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.retries[endpoint]++
+}
+
+// Snapshot returns a copy of the counters that later requests won't change
+func (m *CountingMetrics) Snapshot() MetricsSnapshot {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	errors := make(map[string]map[string]int64, len(m.errors))
+	for endpoint, counts := range m.errors {
+		errors[endpoint] = copyCounts(counts)
+	}
+	return MetricsSnapshot{
+		Requests: copyCounts(m.requests),
+		Errors:   errors,
+		Retries:  copyCounts(m.retries),
+	}
+}
+
+func copyCounts(counts map[string]int64) map[string]int64 {
+	copied := make(map[string]int64, len(counts))
+	for key, count := range counts {
+		copied[key] = count
+	}
+	return copied
+}
@@ -0,0 +1,137 @@
+package incidentio
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestClientMetrics(t *testing.T) {
+	tests := []struct {
+		name      string
+		responses []int
+		wantError bool
+		expected  MetricsSnapshot
+	}{
+		{
+			name:      "success",
+			responses: []int{http.StatusOK},
+			expected: MetricsSnapshot{
+				Requests: map[string]int64{"GET /incidents/{id}": 1},
+				Errors:   map[string]map[string]int64{},
+				Retries:  map[string]int64{},
+			},
+		},
+		{
+			name:      "error",
+			responses: []int{http.StatusNotFound},
+			wantError: true,
+			expected: MetricsSnapshot{
+				Requests: map[string]int64{"GET /incidents/{id}": 1},
+				Errors:   map[string]map[string]int64{"GET /incidents/{id}": {"404": 1}},
+				Retries:  map[string]int64{},
+			},
+		},
+		{
+			name:      "retry",
+			responses: []int{http.StatusTooManyRequests, 0, http.StatusOK},
+			expected: MetricsSnapshot{
+				Requests: map[string]int64{"GET /incidents/{id}": 3},
+				Errors:   map[string]map[string]int64{"GET /incidents/{id}": {"429": 1, "network": 1}},
+				Retries:  map[string]int64{"GET /incidents/{id}": 2},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempt := 0
+			client := NewTestClient(&MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					status := tt.responses[attempt]
+					attempt++
+					if status == 0 {
+						return nil, syscall.ECONNRESET
+					}
+					return mockResponse(status, `{"incident": {"id": "01HXYZINCIDENT0000000000000"}}`), nil
+				},
+			})
+			client.maxRetries = 3
+			client.sleep = func(time.Duration) {}
+			metrics := NewCountingMetrics()
+			client.SetMetrics(metrics)
+
+			_, err := client.GetIncident(context.Background(), "01HXYZINCIDENT0000000000000")
+			if (err != nil) != tt.wantError {
+				t.Fatalf("expected error %v, got: %v", tt.wantError, err)
+			}
+
+			if snapshot := metrics.Snapshot(); !reflect.DeepEqual(snapshot, tt.expected) {
+				t.Errorf("expected %+v, got %+v", tt.expected, snapshot)
+			}
+		})
+	}
+}
+
+func TestClientMetricsErrorsByEndpoint(t *testing.T) {
+	client := NewTestClient(&MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if req.Method == http.MethodGet {
+				return mockResponse(http.StatusNotFound, `{"type": "not_found"}`), nil
+			}
+			return mockResponse(http.StatusUnprocessableEntity, `{"type": "validation_error"}`), nil
+		},
+	})
+	metrics := NewCountingMetrics()
+	client.SetMetrics(metrics)
+
+	for _, id := range []string{"01HXYZINCIDENT0000000000000", "01HXYZINCIDENT0000000000001"} {
+		_, err := client.GetIncident(context.Background(), id)
+		assertError(t, err)
+	}
+	_, err := client.CreateIncident(context.Background(), &CreateIncidentRequest{Name: "Outage"})
+	assertError(t, err)
+
+	expected := map[string]map[string]int64{
+		"GET /incidents/{id}": {"404": 2},
+		"POST /incidents":     {"422": 1},
+	}
+	if errors := metrics.Snapshot().Errors; !reflect.DeepEqual(errors, expected) {
+		t.Errorf("expected errors %+v, got %+v", expected, errors)
+	}
+}
+
+func TestClientMetricsDisabledByDefault(t *testing.T) {
+	client := NewTestClient(&MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return nil, errors.New("connection refused")
+		},
+	})
+
+	// With no collector set, requests must still work without panicking
+	_, err := client.GetIncident(context.Background(), "inc_1")
+	assertError(t, err)
+}
+
+func TestEndpointLabel(t *testing.T) {
+	tests := []struct {
+		method   string
+		endpoint string
+		expected string
+	}{
+		{"GET", "https://api.incident.io/v2/incidents?page_size=25", "GET /v2/incidents"},
+		{"GET", "https://api.incident.io/v2/incidents/01HXYZINCIDENT0000000000000", "GET /v2/incidents/{id}"},
+		{"PATCH", "https://api.incident.io/v1/actions/01HXYZ/complete", "PATCH /v1/actions/{id}/complete"},
+		{"GET", "https://api.incident.io/v3/catalog_entries", "GET /v3/catalog_entries"},
+	}
+
+	for _, tt := range tests {
+		if got := endpointLabel(tt.method, tt.endpoint); got != tt.expected {
+			t.Errorf("endpointLabel(%s, %s) = %q, want %q", tt.method, tt.endpoint, got, tt.expected)
+		}
+	}
+}
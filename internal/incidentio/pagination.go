@@ -0,0 +1,52 @@
+package incidentio
+
+import (
+	"fmt"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/logging"
+)
+
+// paginationProgressInterval is how many pages pass between progress lines
+// logged at info level. Pages in between are only logged at debug level, so a
+// long export shows it is still moving without flooding the log.
+const paginationProgressInterval = 10
+
+// PaginationGuard stops a pagination loop that would never end because the
+// API handed back a cursor it had already returned, and logs progress as
+// pages are fetched.
+type PaginationGuard struct {
+	resource string
+	seen     map[string]bool
+	pages    int
+	items    int
+}
+
+// NewPaginationGuard returns a guard for paging through resource, starting
+// from the cursor after (empty for the first page)
+func NewPaginationGuard(resource, after string) *PaginationGuard {
+	g := &PaginationGuard{resource: resource, seen: map[string]bool{}}
+	if after != "" {
+		g.seen[after] = true
+	}
+	return g
+}
+
+// Next records a page of count items whose response pointed at the cursor
+// after, before that page is fetched. It returns an error if the API has
+// returned that cursor before, since following it would loop forever.
+func (g *PaginationGuard) Next(after string, count int) error {
+	g.pages++
+	g.items += count
+
+	if g.seen[after] {
+		return fmt.Errorf("stopped paginating %s after %d pages (%d items): the API returned cursor %q again, which would repeat pages forever", g.resource, g.pages, g.items, after)
+	}
+	g.seen[after] = true
+
+	if g.pages%paginationProgressInterval == 0 {
+		logging.Infof("Fetched page %d of %s (%d items so far), fetching the next page", g.pages, g.resource, g.items)
+	} else {
+		logging.Debugf("Fetched page %d of %s (%d items so far), fetching the next page", g.pages, g.resource, g.items)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package incidentio
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/logging"
+)
+
+func TestListAlertsStuckCursor(t *testing.T) {
+	requests := 0
+	client := NewTestClient(&MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requests++
+			// Every page points at the same cursor
+			return mockResponse(http.StatusOK, `{"alerts": [{"id": "alert_1"}], "pagination_meta": {"after": "alert_1", "page_size": 50}}`), nil
+		},
+	})
+
+	_, err := client.ListAlerts(context.Background(), nil)
+	assertError(t, err)
+	if !strings.Contains(err.Error(), `stopped paginating alerts after 2 pages`) {
+		t.Errorf("expected a descriptive pagination error, got: %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests before the repeated cursor was noticed, got %d", requests)
+	}
+}
+
+func TestPaginationGuardLogsProgressAtInfo(t *testing.T) {
+	var out bytes.Buffer
+	previous := logging.Default()
+	logging.SetDefault(logging.New(&out, logging.LevelInfo))
+	defer logging.SetDefault(previous)
+
+	guard := NewPaginationGuard("incidents", "")
+	for page := 1; page <= 2*paginationProgressInterval+5; page++ {
+		assertNoError(t, guard.Next(fmt.Sprintf("cursor_%d", page), 25))
+	}
+
+	// Only every tenth page is logged at info level
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 progress lines, got %d:\n%s", len(lines), out.String())
+	}
+	if !strings.Contains(lines[1], "Fetched page 20 of incidents (500 items so far)") {
+		t.Errorf("unexpected progress line: %s", lines[1])
+	}
+}
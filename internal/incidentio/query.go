@@ -0,0 +1,60 @@
+package incidentio
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// buildQuery encodes query parameters the way the incident.io API expects them:
+//   - strings, ints and bools are encoded as single values
+//   - string slices repeat the key once per element
+//   - nested maps use bracket notation, so
+//     {"custom_field": {"01ABC": {"one_of": []string{"x", "y"}}}} encodes as
+//     custom_field[01ABC][one_of]=x&custom_field[01ABC][one_of]=y
+//
+// Empty strings, zero ints, false bools and nil values are omitted so callers
+// can pass optional fields without checking them first.
+func buildQuery(fields map[string]interface{}) url.Values {
+	params := url.Values{}
+	for key, value := range fields {
+		addQueryValue(params, key, value)
+	}
+	return params
+}
+
+func addQueryValue(params url.Values, key string, value interface{}) {
+	switch v := value.(type) {
+	case nil:
+	case string:
+		if v != "" {
+			params.Add(key, v)
+		}
+	case int:
+		if v != 0 {
+			params.Add(key, strconv.Itoa(v))
+		}
+	case bool:
+		if v {
+			params.Add(key, "true")
+		}
+	case []string:
+		for _, item := range v {
+			addQueryValue(params, key, item)
+		}
+	case map[string]string:
+		for subKey, subValue := range v {
+			addQueryValue(params, key+"["+subKey+"]", subValue)
+		}
+	case map[string][]string:
+		for subKey, subValues := range v {
+			addQueryValue(params, key+"["+subKey+"]", subValues)
+		}
+	case map[string]interface{}:
+		for subKey, subValue := range v {
+			addQueryValue(params, key+"["+subKey+"]", subValue)
+		}
+	default:
+		params.Add(key, fmt.Sprint(v))
+	}
+}
@@ -0,0 +1,96 @@
+package incidentio
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestBuildQuery(t *testing.T) {
+	tests := []struct {
+		name     string
+		fields   map[string]interface{}
+		expected string
+	}{
+		{
+			name: "scalars",
+			fields: map[string]interface{}{
+				"page_size": 25,
+				"after":     "01ABC",
+				"enabled":   true,
+			},
+			expected: "after=01ABC&enabled=true&page_size=25",
+		},
+		{
+			name: "omits zero values",
+			fields: map[string]interface{}{
+				"page_size": 0,
+				"after":     "",
+				"enabled":   false,
+				"status":    []string(nil),
+				"missing":   nil,
+			},
+			expected: "",
+		},
+		{
+			name: "repeats keys for slices",
+			fields: map[string]interface{}{
+				"status": []string{"live", "triage"},
+			},
+			expected: "status=live&status=triage",
+		},
+		{
+			name: "nests maps with bracket notation",
+			fields: map[string]interface{}{
+				"status_category": map[string]interface{}{"one_of": []string{"live", "closed"}},
+				"created_at":      map[string]interface{}{"gte": "2024-12-01", "lte": ""},
+			},
+			expected: "created_at%5Bgte%5D=2024-12-01&status_category%5Bone_of%5D=live&status_category%5Bone_of%5D=closed",
+		},
+		{
+			name: "nests custom field filters",
+			fields: map[string]interface{}{
+				"custom_field": map[string]interface{}{
+					"01FIELD": map[string][]string{"one_of": {"opt_a", "opt_b"}},
+				},
+			},
+			expected: "custom_field%5B01FIELD%5D%5Bone_of%5D=opt_a&custom_field%5B01FIELD%5D%5Bone_of%5D=opt_b",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertEqual(t, tt.expected, buildQuery(tt.fields).Encode())
+		})
+	}
+}
+
+func TestListIncidentsQueryEncoding(t *testing.T) {
+	var query map[string][]string
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			query = req.URL.Query()
+			return mockResponse(http.StatusOK, `{"incidents": [], "pagination_meta": {"page_size": 10}}`), nil
+		},
+	}
+
+	client := NewTestClient(mockClient)
+	_, err := client.ListIncidents(context.Background(), &ListIncidentsOptions{
+		PageSize:       10,
+		Status:         []string{"live", "triage"},
+		Severity:       []string{"sev_1"},
+		CreatedAtRange: "2024-12-01~2024-12-08",
+	})
+	assertNoError(t, err)
+
+	expected := map[string][]string{
+		"page_size":               {"10"},
+		"status_category[one_of]": {"live", "triage"},
+		"severity[one_of]":        {"sev_1"},
+		"created_at[date_range]":  {"2024-12-01~2024-12-08"},
+	}
+	if !reflect.DeepEqual(expected, query) {
+		t.Errorf("expected query %v, got %v", expected, query)
+	}
+}
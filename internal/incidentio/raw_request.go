@@ -0,0 +1,29 @@
+package incidentio
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"regexp"
+)
+
+// apiVersionSuffix matches the API version a base URL ends in, such as /v2
+var apiVersionSuffix = regexp.MustCompile(`/v\d+/?$`)
+
+// RawRequest sends a request to an arbitrary API path, which includes the API
+// version (e.g. /v2/incidents), and returns the response body unchanged. The
+// path is resolved against the base URL with its own version removed, so
+// INCIDENT_IO_BASE_URL still decides which host is called. body is sent as
+// is when set.
+func (c *Client) RawRequest(ctx context.Context, method, path string, params url.Values, body json.RawMessage) ([]byte, error) {
+	var payload interface{}
+	if len(body) > 0 {
+		payload = body
+	}
+	return c.doRequestURL(ctx, method, c.apiRoot()+path, params, payload)
+}
+
+// apiRoot is the base URL without its API version
+func (c *Client) apiRoot() string {
+	return apiVersionSuffix.ReplaceAllString(c.baseURL, "")
+}
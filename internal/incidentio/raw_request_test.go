@@ -0,0 +1,37 @@
+package incidentio
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestRawRequest(t *testing.T) {
+	var gotURL, gotBody string
+	client := NewTestClient(&MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			gotURL = req.URL.String()
+			if req.Body != nil {
+				body, _ := io.ReadAll(req.Body)
+				gotBody = string(body)
+			}
+			return mockResponse(http.StatusOK, `{"ok": true}`), nil
+		},
+	})
+	// The version in the base URL is replaced by the one in the path
+	client.SetBaseURL("https://api.test.incident.io/v2")
+
+	body, err := client.RawRequest(context.Background(), "GET", "/v1/severities", url.Values{"page_size": {"5"}}, nil)
+	assertNoError(t, err)
+	assertEqual(t, `{"ok": true}`, string(body))
+	assertEqual(t, "https://api.test.incident.io/v1/severities?page_size=5", gotURL)
+	assertEqual(t, "", gotBody)
+
+	_, err = client.RawRequest(context.Background(), "POST", "/v2/things", nil, json.RawMessage(`{"name":"x"}`))
+	assertNoError(t, err)
+	assertEqual(t, "https://api.test.incident.io/v2/things", gotURL)
+	assertEqual(t, `{"name":"x"}`, gotBody)
+}
@@ -1,10 +1,26 @@
 package incidentio
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
+	"strings"
+)
+
+// maxCloseUserMatches limits how many suggestions are listed when no user
+// matches an email address
+const maxCloseUserMatches = 5
+
+const (
+	// maxUsersPageSize is the largest page size the users endpoint accepts
+	maxUsersPageSize = 250
+	// maxUserPages caps auto-pagination of users, so at most 2500 users are
+	// fetched in one call
+	maxUserPages = 10
+	// maxIncidentRolePages caps pagination when listing every incident role
+	maxIncidentRolePages = 10
 )
 
 // IncidentRole represents an incident role
@@ -64,28 +80,31 @@ type ListUsersOptions struct {
 	PageSize int
 	After    string
 	Email    string // Filter by email
+	// AutoPaginate follows pagination and returns every user, up to
+	// maxUserPages pages. PageSize and After are ignored.
+	AutoPaginate bool
 }
 
 // ListUsersResponse represents the response from listing users
 type ListUsersResponse struct {
 	Users []UserDetailed `json:"users"`
 	ListResponse
+	// Truncated is set when AutoPaginate stopped at the page limit before
+	// reaching the last page
+	Truncated bool `json:"truncated,omitempty"`
 }
 
 // ListIncidentRoles retrieves a list of incident roles
-func (c *Client) ListIncidentRoles(opts *ListIncidentRolesOptions) (*ListIncidentRolesResponse, error) {
+func (c *Client) ListIncidentRoles(ctx context.Context, opts *ListIncidentRolesOptions) (*ListIncidentRolesResponse, error) {
 	params := url.Values{}
-
 	if opts != nil {
-		if opts.PageSize > 0 {
-			params.Set("page_size", strconv.Itoa(opts.PageSize))
-		}
-		if opts.After != "" {
-			params.Set("after", opts.After)
-		}
+		params = buildQuery(map[string]interface{}{
+			"page_size": opts.PageSize,
+			"after":     opts.After,
+		})
 	}
 
-	respBody, err := c.doRequest("GET", "/incident_roles", params, nil)
+	respBody, err := c.doRequest(ctx, "GET", "/incident_roles", params, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -98,71 +117,259 @@ func (c *Client) ListIncidentRoles(opts *ListIncidentRolesOptions) (*ListInciden
 	return &response, nil
 }
 
-// ListUsers retrieves a list of users (with automatic pagination)
-func (c *Client) ListUsers(opts *ListUsersOptions) (*ListUsersResponse, error) {
-	allUsers := []UserDetailed{}
-	pageSize := 250 // Use max page size
+// ListAllIncidentRoles follows pagination to return every incident role, up
+// to maxIncidentRolePages pages
+func (c *Client) ListAllIncidentRoles(ctx context.Context) ([]IncidentRole, error) {
+	var roles []IncidentRole
 	after := ""
-
-	// If email filter is provided, don't paginate (API filters server-side)
-	if opts != nil && opts.Email != "" {
-		params := url.Values{}
-		params.Set("page_size", strconv.Itoa(pageSize))
-		params.Set("email", opts.Email)
-
-		respBody, err := c.doRequest("GET", "/users", params, nil)
+	guard := NewPaginationGuard("incident roles", after)
+	for page := 0; page < maxIncidentRolePages; page++ {
+		response, err := c.ListIncidentRoles(ctx, &ListIncidentRolesOptions{PageSize: 250, After: after})
 		if err != nil {
 			return nil, err
 		}
 
-		var response ListUsersResponse
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		roles = append(roles, response.IncidentRoles...)
+		if response.PaginationMeta.After == "" || len(response.IncidentRoles) == 0 {
+			break
 		}
+		if err := guard.Next(response.PaginationMeta.After, len(response.IncidentRoles)); err != nil {
+			return nil, err
+		}
+		after = response.PaginationMeta.After
+	}
+	return roles, nil
+}
+
+// FindIncidentRoleByName returns the incident role whose name or shortform
+// matches name, ignoring case
+func (c *Client) FindIncidentRoleByName(ctx context.Context, name string) (*IncidentRole, error) {
+	roles, err := c.ListAllIncidentRoles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list incident roles: %w", err)
+	}
+	return MatchIncidentRoleByName(roles, name)
+}
 
-		return &response, nil
+// MatchIncidentRoleByName picks the role from roles whose name or shortform
+// matches name, ignoring case. It returns an error listing the candidates if
+// no role or several roles match.
+func MatchIncidentRoleByName(roles []IncidentRole, name string) (*IncidentRole, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("role name is required")
 	}
 
-	// For non-filtered requests, paginate through all users
-	maxPages := 10 // Safety limit to prevent infinite loops
-	for page := 0; page < maxPages; page++ {
-		params := url.Values{}
-		params.Set("page_size", strconv.Itoa(pageSize))
-		if after != "" {
-			params.Set("after", after)
+	var matches []IncidentRole
+	for _, role := range roles {
+		if strings.EqualFold(role.Name, name) || strings.EqualFold(role.Shortform, name) {
+			matches = append(matches, role)
 		}
+	}
 
-		respBody, err := c.doRequest("GET", "/users", params, nil)
-		if err != nil {
-			return nil, err
+	switch len(matches) {
+	case 1:
+		return &matches[0], nil
+	case 0:
+		return nil, fmt.Errorf("no incident role named '%s'. Available roles: %s", name, describeIncidentRoles(roles))
+	default:
+		return nil, fmt.Errorf("role name '%s' is ambiguous, it matches %d roles: %s. Use incident_role_id instead", name, len(matches), describeIncidentRoles(matches))
+	}
+}
+
+func describeIncidentRoles(roles []IncidentRole) string {
+	if len(roles) == 0 {
+		return "none"
+	}
+
+	descriptions := make([]string, len(roles))
+	for i, role := range roles {
+		descriptions[i] = fmt.Sprintf("%s (%s)", role.Name, role.ID)
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+// ListUsers retrieves a page of users, or every user when opts.AutoPaginate
+// is set. An email filter is applied server-side and returns a single page.
+func (c *Client) ListUsers(ctx context.Context, opts *ListUsersOptions) (*ListUsersResponse, error) {
+	if opts == nil {
+		opts = &ListUsersOptions{}
+	}
+
+	if opts.Email != "" {
+		return c.listUsersPage(ctx, maxUsersPageSize, "", opts.Email)
+	}
+
+	if !opts.AutoPaginate {
+		pageSize := opts.PageSize
+		if pageSize <= 0 || pageSize > maxUsersPageSize {
+			pageSize = maxUsersPageSize
 		}
+		return c.listUsersPage(ctx, pageSize, opts.After, "")
+	}
 
-		var response ListUsersResponse
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	allUsers := []UserDetailed{}
+	after := ""
+	guard := NewPaginationGuard("users", after)
+	for page := 0; page < maxUserPages; page++ {
+		response, err := c.listUsersPage(ctx, maxUsersPageSize, after, "")
+		if err != nil {
+			return nil, err
 		}
 
 		allUsers = append(allUsers, response.Users...)
 
 		// Check if there are more pages
 		if response.PaginationMeta.After == "" || len(response.Users) == 0 {
+			after = ""
 			break
 		}
+		if err := guard.Next(response.PaginationMeta.After, len(response.Users)); err != nil {
+			return nil, err
+		}
 		after = response.PaginationMeta.After
 	}
 
-	// Return combined results
-	return &ListUsersResponse{
-		Users: allUsers,
-		ListResponse: ListResponse{
-			PaginationMeta: struct {
-				After      string `json:"after,omitempty"`
-				PageSize   int    `json:"page_size"`
-				TotalRecordCount int    `json:"total_record_count,omitempty"`
-			}{
-				PageSize:   pageSize,
-				TotalRecordCount: 0, // API doesn't provide total count
-			},
-		},
-	}, nil
+	combined := &ListUsersResponse{Users: allUsers, Truncated: after != ""}
+	combined.PaginationMeta.After = after
+	combined.PaginationMeta.PageSize = maxUsersPageSize
+	return combined, nil
+}
+
+func (c *Client) listUsersPage(ctx context.Context, pageSize int, after, email string) (*ListUsersResponse, error) {
+	params := url.Values{}
+	params.Set("page_size", strconv.Itoa(pageSize))
+	if after != "" {
+		params.Set("after", after)
+	}
+	if email != "" {
+		params.Set("email", email)
+	}
+
+	respBody, err := c.doRequest(ctx, "GET", "/users", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ListUsersResponse
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetUser retrieves a single user by ID
+func (c *Client) GetUser(ctx context.Context, id string) (*UserDetailed, error) {
+	respBody, err := c.doRequest(ctx, "GET", fmt.Sprintf("/users/%s", id), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		User UserDetailed `json:"user"`
+	}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &response.User, nil
+}
+
+// FindUserByEmail returns the single user with the given email address
+// (case-insensitive). It returns an error if several users match, or if none
+// do, in which case the error lists users with similar email addresses.
+func (c *Client) FindUserByEmail(ctx context.Context, email string) (*UserDetailed, error) {
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return nil, fmt.Errorf("email is required")
+	}
+
+	resp, err := c.ListUsers(ctx, &ListUsersOptions{Email: email})
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up user by email: %w", err)
+	}
+
+	var exact []UserDetailed
+	for _, user := range resp.Users {
+		if strings.EqualFold(user.Email, email) {
+			exact = append(exact, user)
+		}
+	}
+
+	switch {
+	case len(exact) == 1:
+		return &exact[0], nil
+	case len(exact) > 1:
+		return nil, fmt.Errorf("email %s matches %d users: %s. Use user_id instead", email, len(exact), describeUsers(exact))
+	case len(resp.Users) > 1:
+		return nil, fmt.Errorf("email %s is ambiguous, it matches %d users: %s. Use the full email address or user_id", email, len(resp.Users), describeUsers(resp.Users))
+	}
+
+	// Nothing matched exactly, so suggest users with similar email addresses
+	all, err := c.ListUsers(ctx, &ListUsersOptions{AutoPaginate: true})
+	if err != nil {
+		return nil, fmt.Errorf("no user found with email %s", email)
+	}
+
+	closeMatches := closeUserMatches(email, all.Users)
+	if len(closeMatches) == 0 {
+		return nil, fmt.Errorf("no user found with email %s. Call list_users to see available users", email)
+	}
+	return nil, fmt.Errorf("no user found with email %s. Close matches: %s", email, describeUsers(closeMatches))
+}
+
+// closeUserMatches returns users whose email address is similar to email: the
+// local parts (before the @) overlap, or the addresses are a few edits apart
+func closeUserMatches(email string, users []UserDetailed) []UserDetailed {
+	email = strings.ToLower(email)
+	localPart := strings.SplitN(email, "@", 2)[0]
+
+	var matches []UserDetailed
+	for _, user := range users {
+		userEmail := strings.ToLower(user.Email)
+		if userEmail == "" {
+			continue
+		}
+		userLocalPart := strings.SplitN(userEmail, "@", 2)[0]
+
+		overlaps := localPart != "" && (strings.Contains(userLocalPart, localPart) || strings.Contains(localPart, userLocalPart))
+		if overlaps || editDistance(email, userEmail) <= 3 {
+			matches = append(matches, user)
+			if len(matches) == maxCloseUserMatches {
+				break
+			}
+		}
+	}
+	return matches
+}
+
+// editDistance returns the Levenshtein distance between a and b
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+func describeUsers(users []UserDetailed) string {
+	descriptions := make([]string, len(users))
+	for i, user := range users {
+		descriptions[i] = fmt.Sprintf("%s <%s> (%s)", user.Name, user.Email, user.ID)
+	}
+	return strings.Join(descriptions, ", ")
 }
@@ -0,0 +1,81 @@
+package incidentio
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestFindIncidentRoleByName(t *testing.T) {
+	// Roles are split across two pages to check the lookup paginates
+	pages := map[string]string{
+		"": `{
+			"incident_roles": [
+				{"id": "role_lead", "name": "Incident Lead", "shortform": "lead"},
+				{"id": "role_comms", "name": "Communications Lead", "shortform": "comms"}
+			],
+			"pagination_meta": {"after": "role_comms", "page_size": 2}
+		}`,
+		"role_comms": `{
+			"incident_roles": [
+				{"id": "role_scribe", "name": "Scribe", "shortform": "scribe"},
+				{"id": "role_notes", "name": "Note Taker", "shortform": "scribe"}
+			],
+			"pagination_meta": {"page_size": 2}
+		}`,
+	}
+
+	tests := []struct {
+		name         string
+		roleName     string
+		expectedID   string
+		errorContain []string
+	}{
+		{name: "matches name ignoring case", roleName: "incident lead", expectedID: "role_lead"},
+		{name: "matches shortform", roleName: "COMMS", expectedID: "role_comms"},
+		{name: "matches a role on the second page", roleName: "Note Taker", expectedID: "role_notes"},
+		{
+			name:         "ambiguous name",
+			roleName:     "scribe",
+			errorContain: []string{"ambiguous", "Scribe (role_scribe)", "Note Taker (role_notes)"},
+		},
+		{
+			name:         "no matching role",
+			roleName:     "Commander",
+			errorContain: []string{"no incident role named 'Commander'", "Incident Lead (role_lead)", "Scribe (role_scribe)"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					assertEqual(t, "/incident_roles", req.URL.Path)
+					body, ok := pages[req.URL.Query().Get("after")]
+					if !ok {
+						t.Errorf("unexpected after cursor: %s", req.URL.Query().Get("after"))
+						return mockResponse(http.StatusNotFound, `{}`), nil
+					}
+					return mockResponse(http.StatusOK, body), nil
+				},
+			}
+
+			client := NewTestClient(mockClient)
+			role, err := client.FindIncidentRoleByName(context.Background(), tt.roleName)
+
+			if len(tt.errorContain) > 0 {
+				assertError(t, err)
+				for _, expected := range tt.errorContain {
+					if !strings.Contains(err.Error(), expected) {
+						t.Errorf("expected error to contain %q, got: %v", expected, err)
+					}
+				}
+				return
+			}
+
+			assertNoError(t, err)
+			assertEqual(t, tt.expectedID, role.ID)
+		})
+	}
+}
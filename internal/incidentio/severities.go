@@ -1,6 +1,7 @@
 package incidentio
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -12,15 +13,15 @@ type ListSeveritiesResponse struct {
 	Severities []Severity `json:"severities"`
 }
 
-// ListSeverities returns all severities
-func (c *Client) ListSeverities() (*ListSeveritiesResponse, error) {
-	// Note: Severities are under V1 API, not V2
-	// We need to temporarily change the base URL for this request
-	originalBaseURL := c.BaseURL()
-	c.SetBaseURL("https://api.incident.io/v1")
-	defer func() { c.SetBaseURL(originalBaseURL) }()
+// ListSeverities returns all severities. Results are cached for the metadata
+// cache TTL; callers must not modify the returned response.
+func (c *Client) ListSeverities(ctx context.Context) (*ListSeveritiesResponse, error) {
+	if cached, ok := c.cache.get(cacheKeySeverities); ok {
+		return cached.(*ListSeveritiesResponse), nil
+	}
 
-	respBody, err := c.doRequest("GET", "/severities", nil, nil)
+	// Note: Severities are under V1 API, not V2
+	respBody, err := c.doRequestURL(ctx, "GET", c.apiRoot()+"/v1/severities", nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -30,18 +31,14 @@ func (c *Client) ListSeverities() (*ListSeveritiesResponse, error) {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
+	c.cache.set(cacheKeySeverities, &response)
 	return &response, nil
 }
 
 // GetSeverity retrieves a specific severity by ID
-func (c *Client) GetSeverity(id string) (*Severity, error) {
+func (c *Client) GetSeverity(ctx context.Context, id string) (*Severity, error) {
 	// Note: Severities are under V1 API, not V2
-	// We need to temporarily change the base URL for this request
-	originalBaseURL := c.BaseURL()
-	c.SetBaseURL("https://api.incident.io/v1")
-	defer func() { c.SetBaseURL(originalBaseURL) }()
-
-	respBody, err := c.doRequest("GET", fmt.Sprintf("/severities/%s", id), nil, nil)
+	respBody, err := c.doRequestURL(ctx, "GET", c.apiRoot()+fmt.Sprintf("/v1/severities/%s", id), nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,37 @@
+package incidentio
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestListSeverities(t *testing.T) {
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			assertEqual(t, "GET", req.Method)
+			assertEqual(t, "https://api.test.incident.io/v1/severities", req.URL.String())
+
+			return mockResponse(http.StatusOK, `{"severities": [
+				{"id": "sev_critical", "name": "Critical", "description": "Customer-facing outage", "rank": 1},
+				{"id": "sev_minor", "name": "Minor", "rank": 3}
+			]}`), nil
+		},
+	}
+
+	client := NewTestClient(mockClient)
+	resp, err := client.ListSeverities(context.Background())
+	assertNoError(t, err)
+
+	if len(resp.Severities) != 2 {
+		t.Fatalf("expected 2 severities, got %d", len(resp.Severities))
+	}
+	assertEqual(t, "sev_critical", resp.Severities[0].ID)
+	assertEqual(t, "Critical", resp.Severities[0].Name)
+	if resp.Severities[0].Rank != 1 || resp.Severities[1].Rank != 3 {
+		t.Errorf("expected ranks 1 and 3, got %d and %d", resp.Severities[0].Rank, resp.Severities[1].Rank)
+	}
+
+	// The base URL is restored after the V1 request
+	assertEqual(t, "https://api.test.incident.io", client.BaseURL())
+}
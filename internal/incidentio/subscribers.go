@@ -0,0 +1,72 @@
+package incidentio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// IncidentSubscriber is a user who receives notifications about an incident
+// without holding a role in it
+type IncidentSubscriber struct {
+	ID         string    `json:"id"`
+	IncidentID string    `json:"incident_id"`
+	User       User      `json:"user"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// ListIncidentSubscribersResponse represents the response from listing an
+// incident's subscribers
+type ListIncidentSubscribersResponse struct {
+	IncidentSubscribers []IncidentSubscriber `json:"incident_subscribers"`
+}
+
+// AddIncidentSubscriberRequest represents a request to subscribe a user to an
+// incident
+type AddIncidentSubscriberRequest struct {
+	UserID string `json:"user_id"`
+}
+
+// ListIncidentSubscribers retrieves the users subscribed to an incident
+func (c *Client) ListIncidentSubscribers(ctx context.Context, incidentID string) (*ListIncidentSubscribersResponse, error) {
+	if incidentID == "" {
+		return nil, fmt.Errorf("incident_id is required")
+	}
+
+	respBody, err := c.doRequest(ctx, "GET", fmt.Sprintf("/incidents/%s/subscribers", incidentID), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ListIncidentSubscribersResponse
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// AddIncidentSubscriber subscribes a user to an incident
+func (c *Client) AddIncidentSubscriber(ctx context.Context, incidentID string, req *AddIncidentSubscriberRequest) (*IncidentSubscriber, error) {
+	if incidentID == "" {
+		return nil, fmt.Errorf("incident_id is required")
+	}
+	if req.UserID == "" {
+		return nil, fmt.Errorf("user_id is required")
+	}
+
+	respBody, err := c.doRequest(ctx, "POST", fmt.Sprintf("/incidents/%s/subscribers", incidentID), nil, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		IncidentSubscriber IncidentSubscriber `json:"incident_subscriber"`
+	}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &response.IncidentSubscriber, nil
+}
@@ -0,0 +1,57 @@
+package incidentio
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestListIncidentSubscribers(t *testing.T) {
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			assertEqual(t, "GET", req.Method)
+			assertEqual(t, "/incidents/inc_1/subscribers", req.URL.Path)
+
+			return mockResponse(http.StatusOK, `{
+				"incident_subscribers": [
+					{"id": "sub_1", "incident_id": "inc_1", "user": {"id": "user_1", "name": "Ada", "email": "ada@example.com"}, "created_at": "2024-12-01T09:00:00Z"}
+				]
+			}`), nil
+		},
+	}
+
+	client := NewTestClient(mockClient)
+	resp, err := client.ListIncidentSubscribers(context.Background(), "inc_1")
+	assertNoError(t, err)
+	if len(resp.IncidentSubscribers) != 1 {
+		t.Fatalf("expected 1 subscriber, got %d", len(resp.IncidentSubscribers))
+	}
+	assertEqual(t, "user_1", resp.IncidentSubscribers[0].User.ID)
+	assertEqual(t, "ada@example.com", resp.IncidentSubscribers[0].User.Email)
+}
+
+func TestAddIncidentSubscriber(t *testing.T) {
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			assertEqual(t, "POST", req.Method)
+			assertEqual(t, "/incidents/inc_1/subscribers", req.URL.Path)
+
+			var body AddIncidentSubscriberRequest
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode request body: %v", err)
+			}
+			assertEqual(t, "user_1", body.UserID)
+
+			return mockResponse(http.StatusCreated, `{
+				"incident_subscriber": {"id": "sub_1", "incident_id": "inc_1", "user": {"id": "user_1", "name": "Ada"}}
+			}`), nil
+		},
+	}
+
+	client := NewTestClient(mockClient)
+	subscriber, err := client.AddIncidentSubscriber(context.Background(), "inc_1", &AddIncidentSubscriberRequest{UserID: "user_1"})
+	assertNoError(t, err)
+	assertEqual(t, "sub_1", subscriber.ID)
+	assertEqual(t, "Ada", subscriber.User.Name)
+}
@@ -0,0 +1,79 @@
+package incidentio
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultMaxIdleConnsPerHost is how many keep-alive connections to the API are kept open
+	defaultMaxIdleConnsPerHost = 10
+	// defaultIdleConnTimeout is how long an unused keep-alive connection stays open
+	defaultIdleConnTimeout = 90 * time.Second
+)
+
+// transportConfig is the connection pooling configuration of a transport
+type transportConfig struct {
+	maxIdleConnsPerHost int
+	idleConnTimeout     time.Duration
+}
+
+var (
+	transportsMu sync.Mutex
+	// transports holds one transport per configuration, shared by every client
+	transports = map[transportConfig]*http.Transport{}
+)
+
+// transportConfigFromEnv reads INCIDENT_IO_MAX_IDLE_CONNS_PER_HOST and
+// INCIDENT_IO_IDLE_CONN_TIMEOUT
+func transportConfigFromEnv() (transportConfig, error) {
+	config := transportConfig{maxIdleConnsPerHost: defaultMaxIdleConnsPerHost}
+
+	if value := os.Getenv("INCIDENT_IO_MAX_IDLE_CONNS_PER_HOST"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			return transportConfig{}, fmt.Errorf("INCIDENT_IO_MAX_IDLE_CONNS_PER_HOST must be a positive integer, got %q", value)
+		}
+		config.maxIdleConnsPerHost = parsed
+	}
+
+	timeout, err := parseDurationSetting("INCIDENT_IO_IDLE_CONN_TIMEOUT", os.Getenv("INCIDENT_IO_IDLE_CONN_TIMEOUT"), defaultIdleConnTimeout)
+	if err != nil {
+		return transportConfig{}, err
+	}
+	config.idleConnTimeout = timeout
+
+	return config, nil
+}
+
+// sharedTransport returns the transport for config, creating it on first use.
+// Clients with the same configuration, including per-session clients, share
+// a transport and with it a pool of keep-alive connections, so batch and
+// paginated calls skip a new TCP and TLS handshake per request.
+func sharedTransport(config transportConfig) *http.Transport {
+	transportsMu.Lock()
+	defer transportsMu.Unlock()
+
+	if transport, ok := transports[config]; ok {
+		return transport
+	}
+
+	// Start from the default transport for its proxy, dial and HTTP/2 settings
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	transport.MaxIdleConnsPerHost = config.maxIdleConnsPerHost
+	if transport.MaxIdleConns < config.maxIdleConnsPerHost {
+		transport.MaxIdleConns = config.maxIdleConnsPerHost
+	}
+	transport.IdleConnTimeout = config.idleConnTimeout
+
+	transports[config] = transport
+	return transport
+}
@@ -0,0 +1,90 @@
+package incidentio
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClientReusesConnections(t *testing.T) {
+	var connections, requests int32
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Write([]byte(`{"incident": {"id": "inc_1"}}`))
+	}))
+	server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt32(&connections, 1)
+		}
+	}
+	server.Start()
+	defer server.Close()
+
+	t.Setenv("INCIDENT_IO_API_KEY", "test-key")
+	t.Setenv("INCIDENT_IO_BASE_URL", server.URL)
+
+	client, err := NewClient()
+	assertNoError(t, err)
+	// A second client, such as a per-session one, shares the same pool
+	sessionClient, err := NewClientWithAPIKey("session-key")
+	assertNoError(t, err)
+
+	for i := 0; i < 5; i++ {
+		_, err := client.GetIncident(context.Background(), "inc_1")
+		assertNoError(t, err)
+		_, err = sessionClient.GetIncident(context.Background(), "inc_1")
+		assertNoError(t, err)
+	}
+
+	if requests != 10 {
+		t.Fatalf("expected 10 requests, got %d", requests)
+	}
+	if connections != 1 {
+		t.Errorf("expected every request to reuse one keep-alive connection, got %d connections", connections)
+	}
+}
+
+func TestTransportConfigFromEnv(t *testing.T) {
+	tests := []struct {
+		name        string
+		maxIdle     string
+		idleTimeout string
+		expected    transportConfig
+		wantError   bool
+	}{
+		{name: "defaults", expected: transportConfig{maxIdleConnsPerHost: 10, idleConnTimeout: 90 * time.Second}},
+		{name: "custom", maxIdle: "32", idleTimeout: "2m", expected: transportConfig{maxIdleConnsPerHost: 32, idleConnTimeout: 2 * time.Minute}},
+		{name: "timeout in seconds", idleTimeout: "30", expected: transportConfig{maxIdleConnsPerHost: 10, idleConnTimeout: 30 * time.Second}},
+		{name: "zero idle connections", maxIdle: "0", wantError: true},
+		{name: "invalid timeout", idleTimeout: "forever", wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("INCIDENT_IO_MAX_IDLE_CONNS_PER_HOST", tt.maxIdle)
+			t.Setenv("INCIDENT_IO_IDLE_CONN_TIMEOUT", tt.idleTimeout)
+
+			config, err := transportConfigFromEnv()
+			if tt.wantError {
+				assertError(t, err)
+				return
+			}
+			assertNoError(t, err)
+			if config != tt.expected {
+				t.Errorf("expected %+v, got %+v", tt.expected, config)
+			}
+
+			transport := sharedTransport(config)
+			if transport.MaxIdleConnsPerHost != tt.expected.maxIdleConnsPerHost || transport.IdleConnTimeout != tt.expected.idleConnTimeout {
+				t.Errorf("transport not configured from %+v", config)
+			}
+			if sharedTransport(config) != transport {
+				t.Error("expected the same transport for the same configuration")
+			}
+		})
+	}
+}
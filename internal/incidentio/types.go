@@ -21,6 +21,7 @@ type Incident struct {
 	SlackChannelName             string                              `json:"slack_channel_name,omitempty"`
 	IncidentRoleAssignments      []RoleAssignment                    `json:"incident_role_assignments"`
 	CustomFieldEntries           []CustomFieldEntry                  `json:"custom_field_entries"`
+	IncidentTimestampValues      []IncidentTimestampValue            `json:"incident_timestamp_values,omitempty"`
 	HasDebrief                   bool                                `json:"has_debrief"`
 	PostmortemDocumentURL        string                              `json:"postmortem_document_url,omitempty"`
 	RetrospectiveIncidentOptions *RetrospectiveIncidentOptionsResponse `json:"retrospective_incident_options,omitempty"`
@@ -95,6 +96,23 @@ type CustomFieldEntry struct {
 	Values []interface{} `json:"values"`
 }
 
+// IncidentTimestamp represents an incident timestamp definition, such as
+// "Reported at" or "Resolved at"
+type IncidentTimestamp struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Rank int    `json:"rank"`
+}
+
+// IncidentTimestampValue represents the value of a timestamp on an incident.
+// Value is nil when the timestamp has not been set.
+type IncidentTimestampValue struct {
+	IncidentTimestamp IncidentTimestamp `json:"incident_timestamp"`
+	Value             *struct {
+		Value *time.Time `json:"value,omitempty"`
+	} `json:"value,omitempty"`
+}
+
 // Alert represents an alert in incident.io
 type Alert struct {
 	ID              string            `json:"id"`
@@ -120,6 +138,36 @@ type Action struct {
 	Assignee    *User      `json:"assignee,omitempty"`
 }
 
+// FollowUp represents a follow-up in incident.io
+type FollowUp struct {
+	ID                     string                  `json:"id"`
+	IncidentID             string                  `json:"incident_id"`
+	Title                  string                  `json:"title"`
+	Description            string                  `json:"description,omitempty"`
+	Status                 string                  `json:"status"`
+	Assignee               *User                   `json:"assignee,omitempty"`
+	Priority               *FollowUpPriority       `json:"priority,omitempty"`
+	ExternalIssueReference *ExternalIssueReference `json:"external_issue_reference,omitempty"`
+	CreatedAt              time.Time               `json:"created_at"`
+	UpdatedAt              time.Time               `json:"updated_at"`
+	CompletedAt            *time.Time              `json:"completed_at,omitempty"`
+}
+
+// FollowUpPriority represents the priority of a follow-up
+type FollowUpPriority struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Rank        int    `json:"rank"`
+}
+
+// ExternalIssueReference represents an issue in an external tracker linked to a follow-up
+type ExternalIssueReference struct {
+	Provider       string `json:"provider"`
+	IssueName      string `json:"issue_name"`
+	IssuePermalink string `json:"issue_permalink"`
+}
+
 // Workflow represents a workflow in incident.io
 type Workflow struct {
 	ID        string                 `json:"id"`
@@ -232,15 +280,16 @@ type IncidentTimestampValueRequest struct {
 
 // UpdateIncidentRequest represents a request to update an incident
 type UpdateIncidentRequest struct {
-	Name                     string                          `json:"name,omitempty"`
-	Summary                  string                          `json:"summary,omitempty"`
-	IncidentStatusID         string                          `json:"incident_status_id,omitempty"`
-	SeverityID               string                          `json:"severity_id,omitempty"`
-	CallURL                  string                          `json:"call_url,omitempty"`
-	SlackChannelNameOverride string                          `json:"slack_channel_name_override,omitempty"`
-	CustomFieldEntries       []CustomFieldEntryRequest       `json:"custom_field_entries,omitempty"`
-	IncidentRoleAssignments  []CreateRoleAssignmentRequest   `json:"incident_role_assignments,omitempty"`
-	IncidentTimestampValues  []IncidentTimestampValueRequest `json:"incident_timestamp_values,omitempty"`
+	Name                         string                               `json:"name,omitempty"`
+	Summary                      string                               `json:"summary,omitempty"`
+	IncidentStatusID             string                               `json:"incident_status_id,omitempty"`
+	SeverityID                   string                               `json:"severity_id,omitempty"`
+	CallURL                      string                               `json:"call_url,omitempty"`
+	SlackChannelNameOverride     string                               `json:"slack_channel_name_override,omitempty"`
+	CustomFieldEntries           []CustomFieldEntryRequest            `json:"custom_field_entries,omitempty"`
+	IncidentRoleAssignments      []CreateRoleAssignmentRequest        `json:"incident_role_assignments,omitempty"`
+	IncidentTimestampValues      []IncidentTimestampValueRequest      `json:"incident_timestamp_values,omitempty"`
+	RetrospectiveIncidentOptions *RetrospectiveIncidentOptionsRequest `json:"retrospective_incident_options,omitempty"`
 }
 
 // IncidentUpdate represents a status update posted to an incident
@@ -251,6 +300,10 @@ type IncidentUpdate struct {
 	CreatedAt  time.Time `json:"created_at"`
 	UpdatedAt  time.Time `json:"updated_at"`
 	Author     *User     `json:"author,omitempty"`
+	// NewIncidentStatus and NewSeverity are set when the update changed the
+	// incident's status or severity
+	NewIncidentStatus *IncidentStatus `json:"new_incident_status,omitempty"`
+	NewSeverity       *Severity       `json:"new_severity,omitempty"`
 }
 
 // CreateIncidentUpdateRequest represents a request to create an incident update
@@ -283,23 +336,35 @@ type ListResponse struct {
 
 // CatalogType represents a catalog type in incident.io
 type CatalogType struct {
-	ID          string                 `json:"id"`
-	Name        string                 `json:"name"`
-	Description string                 `json:"description"`
-	TypeName    string                 `json:"type_name"`
-	Color       string                 `json:"color"`
-	Icon        string                 `json:"icon"`
-	Annotations map[string]interface{} `json:"annotations"`
-	Attributes  []CatalogAttribute     `json:"attributes"`
-	CreatedAt   time.Time              `json:"created_at"`
-	UpdatedAt   time.Time              `json:"updated_at"`
-}
-
-// CatalogAttribute represents an attribute of a catalog type
+	ID            string                 `json:"id"`
+	Name          string                 `json:"name"`
+	Description   string                 `json:"description"`
+	TypeName      string                 `json:"type_name"`
+	Color         string                 `json:"color"`
+	Icon          string                 `json:"icon"`
+	Annotations   map[string]interface{} `json:"annotations"`
+	Categories    []string               `json:"categories,omitempty"`
+	Ranked        bool                   `json:"ranked"`
+	SourceRepoURL string                 `json:"source_repo_url,omitempty"`
+	Attributes    []CatalogAttribute     `json:"attributes"`
+	Schema        *CatalogTypeSchema     `json:"schema,omitempty"`
+	CreatedAt     time.Time              `json:"created_at"`
+	UpdatedAt     time.Time              `json:"updated_at"`
+}
+
+// CatalogTypeSchema is the versioned list of attributes of a catalog type
+type CatalogTypeSchema struct {
+	Version    int                `json:"version"`
+	Attributes []CatalogAttribute `json:"attributes"`
+}
+
+// CatalogAttribute represents an attribute of a catalog type. Type is one of
+// String, Text, Number or Bool, or the type_name of another catalog type.
 type CatalogAttribute struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
-	Type string `json:"type"`
+	ID    string `json:"id,omitempty"`
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Array bool   `json:"array"`
 }
 
 // CatalogEntry represents a catalog entry in incident.io
@@ -339,6 +404,16 @@ type ListCatalogEntriesResponse struct {
 	ListResponse
 }
 
+// CreateCatalogEntryRequest represents a request to create a catalog entry
+type CreateCatalogEntryRequest struct {
+	CatalogTypeID   string                                `json:"catalog_type_id"`
+	Name            string                                `json:"name"`
+	Aliases         []string                              `json:"aliases,omitempty"`
+	AttributeValues map[string]CatalogEntryAttributeValue `json:"attribute_values,omitempty"`
+	ExternalID      string                                `json:"external_id,omitempty"`
+	Rank            int                                   `json:"rank,omitempty"`
+}
+
 // UpdateCatalogEntryRequest represents a request to update a catalog entry
 type UpdateCatalogEntryRequest struct {
 	Name             string                                `json:"name,omitempty"`
@@ -348,3 +423,30 @@ type UpdateCatalogEntryRequest struct {
 	Rank             int                                   `json:"rank,omitempty"`
 	UpdateAttributes []string                              `json:"update_attributes,omitempty"`
 }
+
+// CreateCatalogTypeRequest represents a request to create a catalog type
+type CreateCatalogTypeRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	TypeName    string `json:"type_name,omitempty"`
+}
+
+// UpdateCatalogTypeRequest represents a request to update a catalog type.
+// The API replaces every field, so unchanged values must be sent as well.
+type UpdateCatalogTypeRequest struct {
+	Name          string                 `json:"name"`
+	Description   string                 `json:"description"`
+	Color         string                 `json:"color,omitempty"`
+	Icon          string                 `json:"icon,omitempty"`
+	Annotations   map[string]interface{} `json:"annotations,omitempty"`
+	Categories    []string               `json:"categories,omitempty"`
+	Ranked        bool                   `json:"ranked"`
+	SourceRepoURL string                 `json:"source_repo_url,omitempty"`
+}
+
+// UpdateCatalogTypeSchemaRequest replaces the attributes of a catalog type.
+// Version is the schema version the change was based on.
+type UpdateCatalogTypeSchemaRequest struct {
+	Version    int                `json:"version"`
+	Attributes []CatalogAttribute `json:"attributes"`
+}
@@ -1,6 +1,7 @@
 package incidentio
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -22,24 +23,16 @@ type ListWorkflowsResponse struct {
 }
 
 // ListWorkflows returns all workflows
-func (c *Client) ListWorkflows(params *ListWorkflowsParams) (*ListWorkflowsResponse, error) {
-	endpoint := "/workflows"
-
+func (c *Client) ListWorkflows(ctx context.Context, params *ListWorkflowsParams) (*ListWorkflowsResponse, error) {
 	v := url.Values{}
 	if params != nil {
-		if params.PageSize > 0 {
-			v.Set("page_size", fmt.Sprintf("%d", params.PageSize))
-		}
-		if params.After != "" {
-			v.Set("after", params.After)
-		}
-	}
-
-	if len(v) > 0 {
-		endpoint = endpoint + "?" + v.Encode()
+		v = buildQuery(map[string]interface{}{
+			"page_size": params.PageSize,
+			"after":     params.After,
+		})
 	}
 
-	respBody, err := c.doRequest("GET", endpoint, nil, nil)
+	respBody, err := c.doRequest(ctx, "GET", "/workflows", v, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -53,10 +46,10 @@ func (c *Client) ListWorkflows(params *ListWorkflowsParams) (*ListWorkflowsRespo
 }
 
 // GetWorkflow returns a specific workflow by ID
-func (c *Client) GetWorkflow(id string) (*Workflow, error) {
+func (c *Client) GetWorkflow(ctx context.Context, id string) (*Workflow, error) {
 	endpoint := fmt.Sprintf("/workflows/%s", id)
 
-	respBody, err := c.doRequest("GET", endpoint, nil, nil)
+	respBody, err := c.doRequest(ctx, "GET", endpoint, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -79,10 +72,10 @@ type UpdateWorkflowRequest struct {
 }
 
 // UpdateWorkflow updates a workflow
-func (c *Client) UpdateWorkflow(id string, req *UpdateWorkflowRequest) (*Workflow, error) {
+func (c *Client) UpdateWorkflow(ctx context.Context, id string, req *UpdateWorkflowRequest) (*Workflow, error) {
 	endpoint := fmt.Sprintf("/workflows/%s", id)
 
-	respBody, err := c.doRequest("PATCH", endpoint, nil, req)
+	respBody, err := c.doRequest(ctx, "PATCH", endpoint, nil, req)
 	if err != nil {
 		return nil, err
 	}
@@ -96,3 +89,15 @@ func (c *Client) UpdateWorkflow(id string, req *UpdateWorkflowRequest) (*Workflo
 
 	return &result.Workflow, nil
 }
+
+// EnableWorkflow turns a workflow on
+func (c *Client) EnableWorkflow(ctx context.Context, id string) (*Workflow, error) {
+	enabled := true
+	return c.UpdateWorkflow(ctx, id, &UpdateWorkflowRequest{Enabled: &enabled})
+}
+
+// DisableWorkflow pauses a workflow so it no longer runs
+func (c *Client) DisableWorkflow(ctx context.Context, id string) (*Workflow, error) {
+	enabled := false
+	return c.UpdateWorkflow(ctx, id, &UpdateWorkflowRequest{Enabled: &enabled})
+}
@@ -1,7 +1,10 @@
 package incidentio
 
 import (
+	"context"
+	"io"
 	"net/http"
+	"strconv"
 	"testing"
 )
 
@@ -72,7 +75,7 @@ func TestListWorkflows(t *testing.T) {
 			}
 
 			client := NewTestClient(mockClient)
-			result, err := client.ListWorkflows(tt.params)
+			result, err := client.ListWorkflows(context.Background(), tt.params)
 
 			if tt.wantError {
 				assertError(t, err)
@@ -137,7 +140,7 @@ func TestGetWorkflow(t *testing.T) {
 			}
 
 			client := NewTestClient(mockClient)
-			workflow, err := client.GetWorkflow(tt.workflowID)
+			workflow, err := client.GetWorkflow(context.Background(), tt.workflowID)
 
 			if tt.wantError {
 				assertError(t, err)
@@ -218,7 +221,7 @@ func TestUpdateWorkflow(t *testing.T) {
 			}
 
 			client := NewTestClient(mockClient)
-			workflow, err := client.UpdateWorkflow(tt.workflowID, tt.request)
+			workflow, err := client.UpdateWorkflow(context.Background(), tt.workflowID, tt.request)
 
 			if tt.wantError {
 				assertError(t, err)
@@ -241,6 +244,49 @@ func TestUpdateWorkflow(t *testing.T) {
 	}
 }
 
+func TestEnableDisableWorkflow(t *testing.T) {
+	tests := []struct {
+		name         string
+		enabled      bool
+		expectedBody string
+	}{
+		{name: "enable workflow", enabled: true, expectedBody: `{"enabled":true}`},
+		{name: "disable workflow", enabled: false, expectedBody: `{"enabled":false}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					assertEqual(t, "PATCH", req.Method)
+					assertEqual(t, "/workflows/wf_123", req.URL.Path)
+
+					body, err := io.ReadAll(req.Body)
+					assertNoError(t, err)
+					assertEqual(t, tt.expectedBody, string(body))
+
+					return mockResponse(http.StatusOK, `{"workflow": {"id": "wf_123", "enabled": `+strconv.FormatBool(tt.enabled)+`}}`), nil
+				},
+			}
+
+			client := NewTestClient(mockClient)
+
+			var workflow *Workflow
+			var err error
+			if tt.enabled {
+				workflow, err = client.EnableWorkflow(context.Background(), "wf_123")
+			} else {
+				workflow, err = client.DisableWorkflow(context.Background(), "wf_123")
+			}
+
+			assertNoError(t, err)
+			if workflow.Enabled != tt.enabled {
+				t.Errorf("expected enabled to be %v, got %v", tt.enabled, workflow.Enabled)
+			}
+		})
+	}
+}
+
 // Helper function to create a bool pointer
 func boolPtr(b bool) *bool {
 	return &b
@@ -0,0 +1,158 @@
+// Package logging provides a small leveled logger for the MCP server.
+//
+// Logs always go to stderr by default: stdout carries the JSON-RPC stream, so
+// anything written there would corrupt the protocol.
+package logging
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Level is the severity of a log message
+type Level int
+
+const (
+	LevelDebug Level = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+// redacted replaces credentials in logs and error messages
+const redacted = "***"
+
+// bearerToken matches the credential part of an Authorization header value
+var bearerToken = regexp.MustCompile(`(?i)(bearer\s+)[^\s"',;]+`)
+
+// sensitiveHeaders are never written to logs
+var sensitiveHeaders = []string{"Authorization", "Cookie", "Set-Cookie", "X-Api-Key"}
+
+func (l Level) String() string {
+	switch l {
+	case LevelDebug:
+		return "DEBUG"
+	case LevelInfo:
+		return "INFO"
+	case LevelWarn:
+		return "WARN"
+	default:
+		return "ERROR"
+	}
+}
+
+// ParseLevel parses a level name such as "debug" or "WARN". An empty string
+// means the default level, INFO.
+func ParseLevel(value string) (Level, error) {
+	switch strings.ToUpper(strings.TrimSpace(value)) {
+	case "DEBUG":
+		return LevelDebug, nil
+	case "", "INFO":
+		return LevelInfo, nil
+	case "WARN", "WARNING":
+		return LevelWarn, nil
+	case "ERROR":
+		return LevelError, nil
+	}
+	return LevelInfo, fmt.Errorf("invalid log level %q: must be one of DEBUG, INFO, WARN, ERROR", value)
+}
+
+// Logger writes messages at or above its level to an io.Writer
+type Logger struct {
+	mu    sync.Mutex
+	out   io.Writer
+	level Level
+	now   func() time.Time
+}
+
+// New returns a logger writing messages at or above level to out
+func New(out io.Writer, level Level) *Logger {
+	return &Logger{out: out, level: level, now: time.Now}
+}
+
+// Enabled reports whether messages at level are written
+func (l *Logger) Enabled(level Level) bool {
+	return level >= l.level
+}
+
+func (l *Logger) Debugf(format string, args ...interface{}) { l.logf(LevelDebug, format, args...) }
+func (l *Logger) Infof(format string, args ...interface{})  { l.logf(LevelInfo, format, args...) }
+func (l *Logger) Warnf(format string, args ...interface{})  { l.logf(LevelWarn, format, args...) }
+func (l *Logger) Errorf(format string, args ...interface{}) { l.logf(LevelError, format, args...) }
+
+func (l *Logger) logf(level Level, format string, args ...interface{}) {
+	if !l.Enabled(level) {
+		return
+	}
+
+	message := fmt.Sprintf(format, args...)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	fmt.Fprintf(l.out, "%s %-5s %s\n", l.now().UTC().Format(time.RFC3339), level, strings.TrimRight(message, "\n"))
+}
+
+// RedactHeaders returns a copy of header with credentials such as the
+// Authorization header replaced, so it is safe to log
+func RedactHeaders(header http.Header) http.Header {
+	clean := header.Clone()
+	for _, name := range sensitiveHeaders {
+		if clean.Get(name) != "" {
+			clean.Set(name, redacted)
+		}
+	}
+	return clean
+}
+
+// Redact replaces the configured INCIDENT_IO_API_KEY, any other given
+// secrets, and bearer tokens in text with "***". Use it on anything that may
+// end up in logs or in output shown to the model.
+func Redact(text string, secrets ...string) string {
+	secrets = append(secrets, os.Getenv("INCIDENT_IO_API_KEY"))
+	for _, secret := range secrets {
+		if secret != "" {
+			text = strings.ReplaceAll(text, secret, redacted)
+		}
+	}
+	return bearerToken.ReplaceAllString(text, "${1}"+redacted)
+}
+
+var (
+	defaultMu     sync.RWMutex
+	defaultLogger = newFromEnv()
+)
+
+// newFromEnv builds the default logger from LOG_LEVEL, falling back to INFO
+// when the variable is not a valid level
+func newFromEnv() *Logger {
+	level, err := ParseLevel(os.Getenv("LOG_LEVEL"))
+	logger := New(os.Stderr, level)
+	if err != nil {
+		logger.Warnf("%v; using INFO", err)
+	}
+	return logger
+}
+
+// Default returns the logger used by the package-level functions
+func Default() *Logger {
+	defaultMu.RLock()
+	defer defaultMu.RUnlock()
+	return defaultLogger
+}
+
+// SetDefault replaces the logger used by the package-level functions
+func SetDefault(logger *Logger) {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	defaultLogger = logger
+}
+
+func Debugf(format string, args ...interface{}) { Default().Debugf(format, args...) }
+func Infof(format string, args ...interface{})  { Default().Infof(format, args...) }
+func Warnf(format string, args ...interface{})  { Default().Warnf(format, args...) }
+func Errorf(format string, args ...interface{}) { Default().Errorf(format, args...) }
@@ -0,0 +1,100 @@
+package logging
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseLevel(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    Level
+		wantErr bool
+	}{
+		{value: "", want: LevelInfo},
+		{value: "debug", want: LevelDebug},
+		{value: "INFO", want: LevelInfo},
+		{value: "Warning", want: LevelWarn},
+		{value: "error", want: LevelError},
+		{value: "verbose", want: LevelInfo, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseLevel(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseLevel(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseLevel(%q) = %s, want %s", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoggerLevels(t *testing.T) {
+	var out bytes.Buffer
+	logger := New(&out, LevelWarn)
+	logger.now = func() time.Time { return time.Date(2024, 12, 1, 9, 30, 0, 0, time.UTC) }
+
+	logger.Debugf("debug message")
+	logger.Infof("info message")
+	logger.Warnf("warn %s", "message")
+	logger.Errorf("error message")
+
+	expected := "2024-12-01T09:30:00Z WARN  warn message\n2024-12-01T09:30:00Z ERROR error message\n"
+	if out.String() != expected {
+		t.Errorf("expected output:\n%s\ngot:\n%s", expected, out.String())
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	header := http.Header{}
+	header.Set("Authorization", "Bearer secret-api-key")
+	header.Set("Content-Type", "application/json")
+
+	clean := RedactHeaders(header)
+
+	if got := clean.Get("Authorization"); got != redacted {
+		t.Errorf("expected Authorization to be redacted, got %q", got)
+	}
+	if got := clean.Get("Content-Type"); got != "application/json" {
+		t.Errorf("expected Content-Type to be kept, got %q", got)
+	}
+	if got := header.Get("Authorization"); got != "Bearer secret-api-key" {
+		t.Errorf("expected original header to be unchanged, got %q", got)
+	}
+
+	var out bytes.Buffer
+	New(&out, LevelDebug).Debugf("headers: %v", clean)
+	if strings.Contains(out.String(), "secret-api-key") {
+		t.Errorf("expected API key to be kept out of logs, got: %s", out.String())
+	}
+}
+
+func TestRedact(t *testing.T) {
+	t.Setenv("INCIDENT_IO_API_KEY", "env-api-key")
+
+	tests := []struct {
+		name    string
+		text    string
+		secrets []string
+		want    string
+	}{
+		{name: "configured API key", text: "invalid key env-api-key", want: "invalid key ***"},
+		{name: "explicit secret", text: "token other-key rejected", secrets: []string{"other-key"}, want: "token *** rejected"},
+		{name: "bearer token", text: `header "Authorization: Bearer abc123" sent`, want: `header "Authorization: Bearer ***" sent`},
+		{name: "nothing to redact", text: "request failed", want: "request failed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Redact(tt.text, tt.secrets...); got != tt.want {
+				t.Errorf("Redact(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/logging"
+)
+
+// cancelMethods are the notifications that cancel an in-flight request. MCP
+// clients send notifications/cancelled with params.requestId; some older
+// clients send the LSP-style $/cancelled with params.id.
+var cancelMethods = map[string]bool{
+	"notifications/cancelled": true,
+	"$/cancelled":             true,
+}
+
+// inFlightRequests tracks the requests being handled, so a cancellation
+// notification can cancel the context of the request it names. Requests are
+// keyed by their JSON-encoded ID, so the numeric ID 1 and the string ID "1"
+// are different requests.
+type inFlightRequests struct {
+	mu       sync.Mutex
+	requests map[string]*inFlightRequest
+}
+
+type inFlightRequest struct {
+	cancel context.CancelFunc
+	// cancelled is set when the client cancelled the request
+	cancelled bool
+}
+
+func newInFlightRequests() *inFlightRequests {
+	return &inFlightRequests{requests: make(map[string]*inFlightRequest)}
+}
+
+// requestKey is the registry key for a request ID
+func requestKey(id interface{}) string {
+	key, _ := json.Marshal(id)
+	return string(key)
+}
+
+// start registers a request, returning the context to handle it on and a
+// function to call once it has been handled. That function reports whether
+// the client cancelled the request, in which case no response should be
+// sent. Notifications have no ID and cannot be cancelled.
+func (r *inFlightRequests) start(ctx context.Context, id interface{}) (context.Context, func() bool) {
+	if id == nil {
+		return ctx, func() bool { return false }
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	request := &inFlightRequest{cancel: cancel}
+	key := requestKey(id)
+
+	r.mu.Lock()
+	r.requests[key] = request
+	r.mu.Unlock()
+
+	return ctx, func() bool {
+		cancel()
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if r.requests[key] == request {
+			delete(r.requests, key)
+		}
+		return request.cancelled
+	}
+}
+
+// cancel cancels the in-flight request with the given ID, reporting whether
+// there was one
+func (r *inFlightRequests) cancel(id interface{}) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	request, ok := r.requests[requestKey(id)]
+	if !ok {
+		return false
+	}
+	request.cancelled = true
+	request.cancel()
+	return true
+}
+
+// handleCancellation cancels the request named by raw if it is a cancellation
+// notification, reporting whether it was one. Cancellations for requests that
+// have already finished, or were never sent, are ignored.
+func (r *inFlightRequests) handleCancellation(raw []byte) bool {
+	var msg struct {
+		Method string `json:"method"`
+		Params struct {
+			RequestID json.RawMessage `json:"requestId"`
+			ID        json.RawMessage `json:"id"`
+			Reason    string          `json:"reason"`
+		} `json:"params"`
+	}
+	if json.Unmarshal(raw, &msg) != nil || !cancelMethods[msg.Method] {
+		return false
+	}
+
+	rawID := msg.Params.RequestID
+	if len(rawID) == 0 {
+		rawID = msg.Params.ID
+	}
+	id := parseRequestID(rawID)
+	if id == nil {
+		logging.Warnf("Ignoring %s without a request ID", msg.Method)
+		return true
+	}
+
+	if r.cancel(id) {
+		logging.Infof("Cancelled request %s: %s", requestKey(id), msg.Params.Reason)
+	} else {
+		logging.Debugf("Ignoring %s for request %s, which is not in flight", msg.Method, requestKey(id))
+	}
+	return true
+}
+
+// lineRequestID returns the ID of a raw JSON-RPC message, or nil if it has
+// none or cannot be parsed
+func lineRequestID(raw []byte) interface{} {
+	var envelope struct {
+		ID json.RawMessage `json:"id"`
+	}
+	if json.Unmarshal(raw, &envelope) != nil {
+		return nil
+	}
+	return parseRequestID(envelope.ID)
+}
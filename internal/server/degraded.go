@@ -0,0 +1,67 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/tools"
+)
+
+// degradedToolNames are the common tools registered as stubs in degraded
+// mode, so calling them explains what is wrong instead of failing with
+// "Tool not found"
+var degradedToolNames = []string{
+	"list_incidents",
+	"get_incident",
+	"search_incidents",
+	"create_incident",
+	"update_incident",
+	"close_incident",
+	"list_incident_updates",
+	"create_incident_update",
+	"list_alerts",
+	"list_actions",
+	"list_follow_ups",
+	"list_severities",
+	"list_users",
+}
+
+// degradedTool stands in for an incident.io tool while the client cannot be
+// created. It accepts any arguments and always fails with the reason.
+type degradedTool struct {
+	name   string
+	server *Server
+}
+
+var _ tools.Tool = (*degradedTool)(nil)
+
+func (t *degradedTool) Name() string {
+	return t.name
+}
+
+func (t *degradedTool) Description() string {
+	return fmt.Sprintf(`Unavailable: the incident.io client is not configured, so %s cannot run.
+
+Set INCIDENT_IO_API_KEY (or INCIDENT_IO_API_KEY_FILE) and call %s to load every incident.io tool.`, t.name, reloadClientToolName)
+}
+
+func (t *degradedTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{"type": "object"}
+}
+
+func (t *degradedTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	return "", degradedError(t.server.clientErr)
+}
+
+// degradedError is the error for calling an incident.io tool in degraded mode
+func degradedError(clientErr error) error {
+	return fmt.Errorf("incident.io client not configured: set INCIDENT_IO_API_KEY (or INCIDENT_IO_API_KEY_FILE), then call %s. Cause: %v",
+		reloadClientToolName, clientErr)
+}
+
+// registerDegradedTools registers a stub for each of degradedToolNames
+func (s *Server) registerDegradedTools() {
+	for _, name := range degradedToolNames {
+		s.tools[name] = &degradedTool{name: name, server: s}
+	}
+}
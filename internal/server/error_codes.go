@@ -0,0 +1,71 @@
+package server
+
+import (
+	"errors"
+	"math"
+	"net/http"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+// JSON-RPC error codes for failed incident.io API requests, from the range
+// JSON-RPC reserves for implementation-defined server errors. Other API
+// failures, such as 5xx responses, keep the generic internal error code.
+const (
+	codeAPIUnauthorized = -32001
+	codeAPIForbidden    = -32003
+	codeAPINotFound     = -32004
+	codeAPIValidation   = -32022
+	codeAPIRateLimited  = -32029
+)
+
+// apiErrorTypes name each API error code in the error data, so clients can
+// branch on it without knowing the numbers
+var apiErrorTypes = map[int]string{
+	codeAPIUnauthorized: "unauthorized",
+	codeAPIForbidden:    "forbidden",
+	codeAPINotFound:     "not_found",
+	codeAPIValidation:   "validation_error",
+	codeAPIRateLimited:  "rate_limited",
+}
+
+// apiErrorCode maps an incident.io API error to a JSON-RPC error code and
+// the data sent with it: the error type, the HTTP status, and depending on
+// the status the field errors or how long to wait before retrying. ok is
+// false for errors that have no specific code.
+func apiErrorCode(err error) (code int, data map[string]interface{}, ok bool) {
+	var apiErr *incidentio.APIError
+	if !errors.As(err, &apiErr) {
+		return 0, nil, false
+	}
+
+	switch apiErr.StatusCode {
+	case http.StatusUnauthorized:
+		code = codeAPIUnauthorized
+	case http.StatusForbidden:
+		code = codeAPIForbidden
+	case http.StatusNotFound:
+		code = codeAPINotFound
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		code = codeAPIValidation
+	case http.StatusTooManyRequests:
+		code = codeAPIRateLimited
+	default:
+		return 0, nil, false
+	}
+
+	data = map[string]interface{}{
+		"type":        apiErrorTypes[code],
+		"http_status": apiErr.StatusCode,
+	}
+	if apiErr.RequestID != "" {
+		data["request_id"] = apiErr.RequestID
+	}
+	if code == codeAPIValidation && len(apiErr.Errors) > 0 {
+		data["errors"] = apiErr.Errors
+	}
+	if code == codeAPIRateLimited && apiErr.RetryAfter > 0 {
+		data["retry_after_seconds"] = int(math.Ceil(apiErr.RetryAfter.Seconds()))
+	}
+	return code, data, true
+}
@@ -0,0 +1,145 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+func TestHandleRawMessage_APIErrorCodes(t *testing.T) {
+	const incidentID = "01HXYZINCIDENT0000000000000"
+
+	s := newTestServerWithAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/incidents/"+incidentID:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"type": "not_found", "status": 404, "request_id": "req_404",
+				"errors": [{"code": "not_found", "message": "Incident not found"}]}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/incidents":
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprint(w, `{"type": "validation_error", "status": 422, "request_id": "req_422",
+				"errors": [{"code": "is_required", "message": "is required", "source": {"field": "severity_id"}}]}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+
+	tests := []struct {
+		name         string
+		tool         string
+		args         map[string]interface{}
+		expectedCode int
+		expectedData map[string]interface{}
+	}{
+		{
+			name:         "404 is not found",
+			tool:         "get_incident",
+			args:         map[string]interface{}{"incident_id": incidentID},
+			expectedCode: codeAPINotFound,
+			expectedData: map[string]interface{}{
+				"type":        "not_found",
+				"http_status": float64(404),
+				"request_id":  "req_404",
+			},
+		},
+		{
+			name:         "422 is a validation error with field details",
+			tool:         "create_incident",
+			args:         map[string]interface{}{"name": "Database outage", "idempotency_key": "key-1"},
+			expectedCode: codeAPIValidation,
+			expectedData: map[string]interface{}{
+				"type":        "validation_error",
+				"http_status": float64(422),
+				"request_id":  "req_422",
+				"errors": []interface{}{
+					map[string]interface{}{"code": "is_required", "message": "is required", "field": "severity_id"},
+				},
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw, err := json.Marshal(map[string]interface{}{
+				"jsonrpc": "2.0",
+				"id":      i + 1,
+				"method":  "tools/call",
+				"params":  map[string]interface{}{"name": tt.tool, "arguments": tt.args},
+			})
+			if err != nil {
+				t.Fatalf("failed to marshal request: %v", err)
+			}
+
+			response := s.handleRawMessage(context.Background(), raw)
+			if response == nil || response.Error == nil {
+				t.Fatalf("expected an error response, got %+v", response)
+			}
+			if response.Error.Code != tt.expectedCode {
+				t.Errorf("expected code %d, got %d: %s", tt.expectedCode, response.Error.Code, response.Error.Message)
+			}
+
+			// Round-trip the data as a client would see it
+			encoded, err := json.Marshal(response.Error.Data)
+			if err != nil {
+				t.Fatalf("failed to marshal error data: %v", err)
+			}
+			var data map[string]interface{}
+			if err := json.Unmarshal(encoded, &data); err != nil {
+				t.Fatalf("failed to parse error data: %v", err)
+			}
+			if !reflect.DeepEqual(data, tt.expectedData) {
+				t.Errorf("expected data %v, got %v", tt.expectedData, data)
+			}
+		})
+	}
+}
+
+func TestAPIErrorCode(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		expectedCode int
+		expectedData map[string]interface{}
+	}{
+		{
+			name:         "unauthorized",
+			err:          &incidentio.APIError{StatusCode: http.StatusUnauthorized},
+			expectedCode: codeAPIUnauthorized,
+			expectedData: map[string]interface{}{"type": "unauthorized", "http_status": 401},
+		},
+		{
+			name:         "rate limited with retry-after",
+			err:          fmt.Errorf("failed to list incidents: %w", &incidentio.APIError{StatusCode: http.StatusTooManyRequests, RetryAfter: 1500 * time.Millisecond}),
+			expectedCode: codeAPIRateLimited,
+			expectedData: map[string]interface{}{"type": "rate_limited", "http_status": 429, "retry_after_seconds": 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, data, ok := apiErrorCode(tt.err)
+			if !ok || code != tt.expectedCode {
+				t.Fatalf("expected code %d, got %d (ok=%v)", tt.expectedCode, code, ok)
+			}
+			if !reflect.DeepEqual(data, tt.expectedData) {
+				t.Errorf("expected data %v, got %v", tt.expectedData, data)
+			}
+		})
+	}
+
+	for _, err := range []error{
+		&incidentio.APIError{StatusCode: http.StatusInternalServerError},
+		fmt.Errorf("incident_id parameter is required"),
+	} {
+		if code, _, ok := apiErrorCode(err); ok {
+			t.Errorf("expected no specific code for %v, got %d", err, code)
+		}
+	}
+}
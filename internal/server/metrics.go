@@ -0,0 +1,51 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+// clientMetricsToolName is the tool that reports the client's API traffic
+const clientMetricsToolName = "get_client_metrics"
+
+// clientMetricsTool reports the counters the server's incident.io clients
+// have collected. The counters belong to the server, so they carry on
+// across client reloads.
+type clientMetricsTool struct {
+	metrics *incidentio.CountingMetrics
+}
+
+func newClientMetricsTool(metrics *incidentio.CountingMetrics) *clientMetricsTool {
+	return &clientMetricsTool{metrics: metrics}
+}
+
+func (t *clientMetricsTool) Name() string {
+	return clientMetricsToolName
+}
+
+func (t *clientMetricsTool) Description() string {
+	return `Show how many requests this server has sent to the incident.io API since it started.
+
+Use this to see which endpoints a session is calling, or why calls are slow or failing, such as many retries after rate limiting.
+
+Returns {"requests": {endpoint: count}, "errors": {endpoint: {status: count}}, "retries": {endpoint: count}}. Endpoints are the method and path with IDs replaced by {id}, e.g. "GET /v2/incidents/{id}". Errors are counted per endpoint by response status, or "network" when no response was received. Every attempt is counted, including retries.`
+}
+
+func (t *clientMetricsTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type":                 "object",
+		"properties":           map[string]interface{}{},
+		"additionalProperties": false,
+	}
+}
+
+func (t *clientMetricsTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	result, err := json.MarshalIndent(t.metrics.Snapshot(), "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal response: %w", err)
+	}
+	return string(result), nil
+}
@@ -0,0 +1,92 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/logging"
+	"github.com/incident-io/incidentio-mcp-golang/internal/tools"
+	"github.com/incident-io/incidentio-mcp-golang/pkg/mcp"
+)
+
+// messageWriter writes JSON-RPC messages to the client. Responses are written
+// by the serve loop while notifications come from running tool calls, so
+// writes are serialized to keep each message on its own line.
+type messageWriter struct {
+	mu      sync.Mutex
+	encoder *json.Encoder
+	// closed drops notifications from a tool call still running after serve returned
+	closed bool
+}
+
+func (w *messageWriter) write(message *mcp.Message) {
+	if message == nil {
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return
+	}
+	if err := w.encoder.Encode(message); err != nil {
+		// Log encoding errors but continue processing
+		logging.Errorf("Failed to encode response: %v", err)
+	}
+}
+
+func (w *messageWriter) close() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.closed = true
+}
+
+type notifierContextKey struct{}
+
+// withNotifier returns a context on which requests can send notifications to
+// the client through w
+func withNotifier(ctx context.Context, w *messageWriter) context.Context {
+	return context.WithValue(ctx, notifierContextKey{}, w)
+}
+
+// sendNotification sends a notification to the client, if the request on
+// ctx is able to
+func sendNotification(ctx context.Context, method string, params interface{}) {
+	if w, ok := ctx.Value(notifierContextKey{}).(*messageWriter); ok {
+		w.write(&mcp.Message{Jsonrpc: "2.0", Method: method, Params: params})
+	}
+}
+
+// withProgressNotifications returns a context on which tool progress is sent
+// to the client as notifications/progress, when the tools/call request asked
+// for progress with a _meta.progressToken and notifications can be sent
+func withProgressNotifications(ctx context.Context, params map[string]interface{}) context.Context {
+	w, ok := ctx.Value(notifierContextKey{}).(*messageWriter)
+	if !ok {
+		return ctx
+	}
+	meta, _ := params["_meta"].(map[string]interface{})
+	token := meta["progressToken"]
+	if token == nil {
+		return ctx
+	}
+
+	return tools.WithProgress(ctx, func(progress, total int, message string) {
+		notification := map[string]interface{}{
+			"progressToken": token,
+			"progress":      progress,
+		}
+		if total > 0 {
+			notification["total"] = total
+		}
+		if message != "" {
+			notification["message"] = message
+		}
+		w.write(&mcp.Message{
+			Jsonrpc: "2.0",
+			Method:  "notifications/progress",
+			Params:  notification,
+		})
+	})
+}
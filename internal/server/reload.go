@@ -0,0 +1,107 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+	"github.com/incident-io/incidentio-mcp-golang/internal/logging"
+	"github.com/incident-io/incidentio-mcp-golang/internal/tools"
+)
+
+// reloadClientToolName is the tool that recreates the incident.io client
+const reloadClientToolName = "reload_incidentio_client"
+
+// reloadClientTool recreates the incident.io client from the environment and
+// re-registers the tools around it, so a server that started in degraded mode
+// recovers once a key is available without restarting
+type reloadClientTool struct {
+	server *Server
+}
+
+func newReloadClientTool(s *Server) *reloadClientTool {
+	return &reloadClientTool{server: s}
+}
+
+func (t *reloadClientTool) Name() string {
+	return reloadClientToolName
+}
+
+func (t *reloadClientTool) Description() string {
+	return `Reload the incident.io API client, e.g. after fixing or rotating the API key.
+
+Use this when the server reports degraded mode (serverInfo.degraded in the initialize response) or when every call fails with an authentication error. The API key is read again from INCIDENT_IO_API_KEY, or from INCIDENT_IO_API_KEY_FILE when that is unset, and checked with a test request before it replaces the current client. A session that supplied its own API key at initialize keeps using that key; only the rest of the configuration is read again.
+
+If the reload fails, the current client and tools are kept.
+
+On success every incident.io tool is registered again and the server sends notifications/tools/list_changed, so call tools/list to see them.`
+}
+
+func (t *reloadClientTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type":                 "object",
+		"properties":           map[string]interface{}{},
+		"additionalProperties": false,
+	}
+}
+
+func (t *reloadClientTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	s := t.server
+
+	// A failed reload leaves the current client and tools in place, so it
+	// only updates the reason for degraded mode if the server was already in it
+	client, err := s.newClient()
+	if err != nil {
+		if s.clientErr != nil {
+			s.clientErr = err
+		}
+		return "", fmt.Errorf("failed to reload the incident.io client: %w", err)
+	}
+	if err := client.Ping(ctx); err != nil {
+		return "", fmt.Errorf("failed to reload the incident.io client, the API rejected the test request: %w", err)
+	}
+
+	before := toolNames(s.tools)
+	s.tools = make(map[string]tools.Tool)
+	s.registerClientTools(client)
+	s.tools[reloadClientToolName] = t
+	logging.Infof("Reloaded the incident.io client, registered %d tools", len(s.tools))
+
+	changed := !reflect.DeepEqual(before, toolNames(s.tools))
+	if changed {
+		sendNotification(ctx, "notifications/tools/list_changed", nil)
+	}
+
+	result, err := json.MarshalIndent(map[string]interface{}{
+		"reloaded":         true,
+		"toolCount":        len(s.tools),
+		"toolsListChanged": changed,
+	}, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal response: %w", err)
+	}
+	return string(result), nil
+}
+
+// newClient creates an incident.io client for the session: with the API key
+// the session supplied at initialize if it gave one, so reloading never
+// switches a session to another identity, and from the environment otherwise
+func (s *Server) newClient() (*incidentio.Client, error) {
+	if s.sessionAPIKey != "" {
+		return incidentio.NewClientWithAPIKey(s.sessionAPIKey)
+	}
+	return incidentio.NewClient()
+}
+
+// toolNames lists the names of registered tools in sorted order
+func toolNames(registered map[string]tools.Tool) []string {
+	names := make([]string, 0, len(registered))
+	for name := range registered {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,78 @@
+package server
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/logging"
+)
+
+// defaultMaxResponseBytes caps the text of a tool result when
+// MCP_MAX_RESPONSE_BYTES is not set. Larger results do not fit in a model's
+// context window anyway.
+const defaultMaxResponseBytes = 1 << 20
+
+// maxResponseBytesFromEnv reads MCP_MAX_RESPONSE_BYTES, where 0 turns the
+// limit off. An invalid value is logged and the default is used, so a typo
+// does not stop the server from starting.
+func maxResponseBytesFromEnv() int {
+	value := strings.TrimSpace(os.Getenv("MCP_MAX_RESPONSE_BYTES"))
+	if value == "" {
+		return defaultMaxResponseBytes
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil || parsed < 0 {
+		logging.Warnf("MCP_MAX_RESPONSE_BYTES must be a non-negative integer, got %q. Using %d", value, defaultMaxResponseBytes)
+		return defaultMaxResponseBytes
+	}
+	return parsed
+}
+
+// truncateResult cuts a tool result down to at most limit bytes, ending it
+// with a note that says how many lines were dropped and how to ask for less.
+// The cut is made at the last line break that fits, so line-based results
+// such as NDJSON lose whole lines only; a result whose first line alone is
+// over the limit is cut on a UTF-8 boundary instead. A limit of 0 means no
+// limit.
+func truncateResult(result string, limit int) (string, bool) {
+	if limit <= 0 || len(result) <= limit {
+		return result, false
+	}
+
+	totalLines := countLines(result)
+	noteFor := func(dropped int) string {
+		return fmt.Sprintf("\n\n...truncated: the result was %d bytes, over the %d byte limit (MCP_MAX_RESPONSE_BYTES), so %d of its %d lines were dropped. "+
+			"Use fields to return fewer fields, or pagination (page_size and after) to fetch fewer items per call.", len(result), limit, dropped, totalLines)
+	}
+
+	// The dropped count has at most as many digits as the total, so the
+	// final note is never longer than this one
+	keep := limit - len(noteFor(totalLines))
+	if keep <= 0 {
+		return strings.TrimPrefix(noteFor(totalLines), "\n\n"), true
+	}
+	for keep > 0 && !utf8.RuneStart(result[keep]) {
+		keep--
+	}
+	kept := result[:keep]
+	if newline := strings.LastIndexByte(kept, '\n'); newline >= 0 {
+		kept = kept[:newline]
+	}
+	return kept + noteFor(totalLines-countLines(kept)), true
+}
+
+// countLines returns the number of lines in s, counting a final line without
+// a trailing line break
+func countLines(s string) int {
+	if s == "" {
+		return 0
+	}
+	lines := strings.Count(s, "\n")
+	if !strings.HasSuffix(s, "\n") {
+		lines++
+	}
+	return lines
+}
@@ -1,88 +1,361 @@
 package server
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"runtime/debug"
+	"sort"
+	"time"
 
 	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+	"github.com/incident-io/incidentio-mcp-golang/internal/logging"
 	"github.com/incident-io/incidentio-mcp-golang/internal/tools"
 	"github.com/incident-io/incidentio-mcp-golang/pkg/mcp"
 )
 
+// Version is the server version reported to clients. Builds set it with
+// -ldflags "-X github.com/incident-io/incidentio-mcp-golang/internal/server.Version=...".
+var Version = "dev"
+
+// Server is the MCP server for incident.io, speaking JSON-RPC over stdio. It
+// is the only server implementation; cmd/mcp-server just runs it.
 type Server struct {
 	tools map[string]tools.Tool
+
+	// clientErr is why the incident.io client could not be created, leaving
+	// the server in degraded mode with only stub tools
+	clientErr error
+
+	// shutdownTimeout bounds how long shutdown waits for an in-flight request
+	shutdownTimeout time.Duration
+
+	// toolsPageSize is the most tools returned by one tools/list request
+	toolsPageSize int
+
+	// maxResponseBytes caps the size of a tool result, 0 meaning no limit
+	maxResponseBytes int
+
+	// sessionAPIKey is the API key the session supplied at initialize, used
+	// instead of INCIDENT_IO_API_KEY when the client is reloaded
+	sessionAPIKey string
+
+	// metrics counts the API traffic of every client the server creates
+	metrics *incidentio.CountingMetrics
 }
 
+// defaultShutdownTimeout is how long the server waits for an in-flight tool
+// call to finish once shutdown starts
+const defaultShutdownTimeout = 30 * time.Second
+
+// defaultToolsPageSize is how many tools tools/list returns per page. It is
+// above the current tool count, so clients get every tool in one page.
+const defaultToolsPageSize = 200
+
 func New() *Server {
 	return &Server{
-		tools: make(map[string]tools.Tool),
+		tools:            make(map[string]tools.Tool),
+		shutdownTimeout:  defaultShutdownTimeout,
+		toolsPageSize:    defaultToolsPageSize,
+		maxResponseBytes: maxResponseBytesFromEnv(),
+		metrics:          incidentio.NewCountingMetrics(),
 	}
 }
 
+// Start registers the incident.io tools and serves requests on stdin and
+// stdout until stdin is closed or ctx is cancelled. Cancelling ctx shuts the
+// server down gracefully: see serve.
 func (s *Server) Start(ctx context.Context) error {
 	s.registerTools()
 
-	encoder := json.NewEncoder(os.Stdout)
-	decoder := json.NewDecoder(os.Stdin)
+	// Logs go to stderr (stdout is reserved for MCP protocol)
+	logging.Infof("Starting incident.io MCP server...")
+	logging.Infof("Registered %d tools", len(s.tools))
+
+	return s.serve(ctx, os.Stdin, os.Stdout)
+}
+
+// serve reads newline-delimited JSON-RPC messages from r and writes responses
+// to w until r is exhausted or ctx is cancelled. Reading happens on its own
+// goroutine so cancellation is noticed while waiting for input.
+//
+// Cancelling ctx stops reading new messages, but a request that is already
+// running is given up to shutdownTimeout to finish and have its response
+// written, so a create_incident is not abandoned halfway through.
+//
+// Cancellation notifications are handled as soon as they are read, so a
+// client can cancel the request that is running. The cancelled request's
+// context is cancelled and its response is not sent.
+func (s *Server) serve(ctx context.Context, r io.Reader, w io.Writer) error {
+	out := &messageWriter{encoder: json.NewEncoder(w)}
+	defer out.close()
+
+	// Requests run on a context that outlives ctx, and is only cancelled when
+	// an in-flight request overruns the shutdown timeout
+	requestCtx, cancelRequests := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancelRequests()
+	requestCtx = withNotifier(requestCtx, out)
+
+	// Requests are registered as they are read, before the next line is, so
+	// a cancellation that follows its request always finds it. Messages are
+	// queued rather than handed straight to the loop below, so the reader
+	// keeps reading, and sees cancellations, while a request runs and others
+	// wait behind it.
+	inFlight := newInFlightRequests()
+	queued := make(chan inboundMessage)
+	lines := queueMessages(ctx, queued)
+	readErr := make(chan error, 1)
+	go func() {
+		defer close(queued)
+		reader := bufio.NewReader(r)
+		for {
+			line, err := reader.ReadBytes('\n')
+			if len(bytes.TrimSpace(line)) > 0 && !inFlight.handleCancellation(line) {
+				msgCtx, finish := inFlight.start(requestCtx, lineRequestID(line))
+				select {
+				case queued <- inboundMessage{raw: line, ctx: msgCtx, finish: finish}:
+				case <-ctx.Done():
+					finish()
+					return
+				}
+			}
+			if err != nil {
+				readErr <- err
+				return
+			}
+		}
+	}()
 
 	for {
 		select {
 		case <-ctx.Done():
+			logging.Infof("Context cancelled, shutting down server...")
 			return ctx.Err()
-		default:
-			var msg mcp.Message
-			if err := decoder.Decode(&msg); err != nil {
-				if err == io.EOF {
-					return nil
+		case msg, ok := <-lines:
+			// The queue closes once every message read before the reader
+			// stopped has been handled
+			if !ok {
+				select {
+				case err := <-readErr:
+					if err == io.EOF {
+						logging.Infof("stdin closed, shutting down server...")
+						return nil
+					}
+					return fmt.Errorf("failed to read request: %w", err)
+				default:
+					logging.Infof("Context cancelled, shutting down server...")
+					return ctx.Err()
 				}
-				continue
 			}
 
-			response, err := s.handleMessage(&msg)
-			if err != nil {
-				response = s.createErrorResponse(msg.ID, err)
+			done := make(chan *mcp.Message, 1)
+			go func() {
+				done <- s.handleRawMessage(msg.ctx, msg.raw)
+			}()
+
+			select {
+			case response := <-done:
+				msg.respond(out, response)
+			case <-ctx.Done():
+				logging.Infof("Shutting down, waiting up to %s for the in-flight request to finish...", s.shutdownTimeout)
+				timer := time.NewTimer(s.shutdownTimeout)
+				defer timer.Stop()
+
+				select {
+				case response := <-done:
+					msg.respond(out, response)
+					logging.Infof("In-flight request finished, shutting down server...")
+				case <-timer.C:
+					logging.Warnf("In-flight request did not finish within %s, cancelling it", s.shutdownTimeout)
+					cancelRequests()
+				}
+				return ctx.Err()
 			}
+		}
+	}
+}
+
+// queueMessages forwards the messages sent on in to the returned channel in
+// order, holding as many as needed so that sending on in never waits for the
+// receiver. The returned channel is closed once in is closed and drained, or
+// when ctx is cancelled, in which case queued messages are dropped.
+func queueMessages(ctx context.Context, in <-chan inboundMessage) <-chan inboundMessage {
+	out := make(chan inboundMessage)
+	go func() {
+		defer close(out)
 
-			if response != nil {
-				if err := encoder.Encode(response); err != nil {
-					// Log encoding errors but continue processing
-					fmt.Fprintf(os.Stderr, "Failed to encode response: %v\n", err)
+		var pending []inboundMessage
+		for in != nil || len(pending) > 0 {
+			// Sending on a nil channel blocks, so nothing is sent while the
+			// queue is empty
+			var next chan<- inboundMessage
+			var head inboundMessage
+			if len(pending) > 0 {
+				next, head = out, pending[0]
+			}
+
+			select {
+			case msg, ok := <-in:
+				if !ok {
+					in = nil
+					continue
 				}
+				pending = append(pending, msg)
+			case next <- head:
+				pending = pending[1:]
+			case <-ctx.Done():
+				for _, msg := range pending {
+					msg.finish()
+				}
+				return
 			}
 		}
+	}()
+	return out
+}
+
+// inboundMessage is a raw message read from the client, with the context to
+// handle it on
+type inboundMessage struct {
+	raw []byte
+	ctx context.Context
+	// finish unregisters the message once handled, reporting whether the
+	// client cancelled it
+	finish func() bool
+}
+
+// respond writes the response to the message, unless the client cancelled
+// it: MCP clients do not expect a response to a cancelled request
+func (m inboundMessage) respond(out *messageWriter, response *mcp.Message) {
+	if m.finish() {
+		logging.Debugf("Dropping the response to a cancelled request")
+		return
+	}
+	out.write(response)
+}
+
+// handleRawMessage parses and handles a single JSON-RPC message, returning
+// the response to send, if any. Malformed messages get a JSON-RPC error when
+// they carry enough of an envelope to answer, and are dropped otherwise.
+func (s *Server) handleRawMessage(ctx context.Context, raw []byte) *mcp.Message {
+	var msg mcp.Message
+	if err := json.Unmarshal(raw, &msg); err != nil {
+		// If we can't parse it, try to extract an ID to send proper error
+		var partialMsg struct {
+			ID      json.RawMessage `json:"id"`
+			Jsonrpc string          `json:"jsonrpc"`
+		}
+		if json.Unmarshal(raw, &partialMsg) == nil && partialMsg.Jsonrpc == "2.0" {
+			return errorMessage(parseRequestID(partialMsg.ID), -32700, "Parse error")
+		}
+		logging.Warnf("Dropping malformed message: %v", err)
+		return nil
+	}
+
+	// Decoding the ID into interface{} turns every number into a float64,
+	// so decode it again keeping the type and digits the client sent
+	msg.ID = lineRequestID(raw)
+
+	// Validate required fields
+	if msg.Jsonrpc != "2.0" {
+		if msg.ID == nil {
+			return nil
+		}
+		return errorMessage(msg.ID, -32600, "Invalid Request: missing or invalid jsonrpc field")
+	}
+
+	response, err := s.handleMessage(ctx, &msg)
+	if err != nil {
+		response = s.createErrorResponse(msg.ID, err)
+	}
+	return response
+}
+
+// parseRequestID converts a raw JSON-RPC id into the value echoed back in the
+// response. Strings stay strings and numbers become a json.Number, which is
+// written back with exactly the digits the client sent, so 1 and "1" remain
+// distinct and large integers do not lose precision. A missing or null id is
+// nil.
+func parseRequestID(raw json.RawMessage) interface{} {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+		return nil
+	}
+
+	var number json.Number
+	if raw[0] != '"' && json.Unmarshal(raw, &number) == nil {
+		return number
+	}
+	var id interface{}
+	if json.Unmarshal(raw, &id) != nil {
+		return nil
 	}
+	return id
 }
 
 func (s *Server) registerTools() {
+	// The client can be recreated later, e.g. once a missing key is provided
+	s.tools[reloadClientToolName] = newReloadClientTool(s)
+
 	// Initialize incident.io client
 	client, err := incidentio.NewClient()
 	if err != nil {
-		// If client initialization fails, no tools are registered
+		// If client initialization fails, only the reload tool and stubs
+		// explaining the problem are registered
+		logging.Warnf("No incident.io tools registered: %v", err)
+		s.clientErr = err
+		s.registerDegradedTools()
 		return
 	}
+	s.registerClientTools(client)
+}
+
+// registerClientTools registers every incident.io tool against client
+func (s *Server) registerClientTools(client *incidentio.Client) {
+	s.clientErr = nil
+	client.SetMetrics(s.metrics)
+
+	// Register connectivity check
+	s.tools["ping_incidentio"] = tools.NewPingTool(client)
+	s.tools[clientMetricsToolName] = newClientMetricsTool(s.metrics)
 
 	// Register Incident tools
 	s.tools["list_incidents"] = tools.NewListIncidentsTool(client)
+	s.tools["export_incidents"] = tools.NewExportIncidentsTool(client)
+	s.tools["search_incidents"] = tools.NewSearchIncidentsTool(client)
+	s.tools["get_incident_stats"] = tools.NewIncidentStatsTool(client)
 	s.tools["get_incident"] = tools.NewGetIncidentTool(client)
+	s.tools["get_incidents"] = tools.NewGetIncidentsTool(client)
 	s.tools["get_incident_debrief"] = tools.NewGetIncidentDebriefTool(client)
+	s.tools["export_incident_postmortem"] = tools.NewExportIncidentTool(client)
 	s.tools["debug_incident"] = tools.NewDebugIncidentTool(client)
 	s.tools["create_incident"] = tools.NewCreateIncidentTool(client)
 	s.tools["create_incident_smart"] = tools.NewCreateIncidentEnhancedTool(client)
 	s.tools["update_incident"] = tools.NewUpdateIncidentTool(client)
 	s.tools["close_incident"] = tools.NewCloseIncidentTool(client)
+	s.tools["bulk_close_incidents"] = tools.NewBulkCloseIncidentsTool(client)
+	s.tools["merge_incidents"] = tools.NewMergeIncidentsTool(client)
+	s.tools["set_incident_timestamps"] = tools.NewSetIncidentTimestampsTool(client)
+	s.tools["check_closure_readiness"] = tools.NewCheckClosureReadinessTool(client)
 	s.tools["list_incident_statuses"] = tools.NewListIncidentStatusesTool(client)
 	s.tools["list_incident_types"] = tools.NewListIncidentTypesTool(client)
 	s.tools["list_severities"] = tools.NewListSeveritiesTool(client)
 	s.tools["get_severity"] = tools.NewGetSeverityTool(client)
+	s.tools["create_custom_field_options"] = tools.NewBulkCreateCustomFieldOptionsTool(client)
+	s.tools["update_custom_field_option"] = tools.NewUpdateCustomFieldOptionTool(client)
+	s.tools["delete_custom_field_option"] = tools.NewDeleteCustomFieldOptionTool(client)
 
 	// Register Incident Update tools
 	s.tools["list_incident_updates"] = tools.NewListIncidentUpdatesTool(client)
 	s.tools["get_incident_update"] = tools.NewGetIncidentUpdateTool(client)
 	s.tools["create_incident_update"] = tools.NewCreateIncidentUpdateTool(client)
 	s.tools["delete_incident_update"] = tools.NewDeleteIncidentUpdateTool(client)
+	s.tools["get_incident_timeline"] = tools.NewGetIncidentTimelineTool(client)
 
 	// Register Alert tools
 	s.tools["list_alerts"] = tools.NewListAlertsTool(client)
@@ -92,47 +365,82 @@ func (s *Server) registerTools() {
 	// Register Action tools
 	s.tools["list_actions"] = tools.NewListActionsTool(client)
 	s.tools["get_action"] = tools.NewGetActionTool(client)
+	s.tools["update_action"] = tools.NewUpdateActionTool(client)
+	s.tools["complete_action"] = tools.NewCompleteActionTool(client)
+
+	// Register Follow-up tools
+	s.tools["list_follow_ups"] = tools.NewListFollowUpsTool(client)
+	s.tools["create_follow_up"] = tools.NewCreateFollowUpTool(client)
+	s.tools["update_follow_up"] = tools.NewUpdateFollowUpTool(client)
+	s.tools["complete_follow_up"] = tools.NewCompleteFollowUpTool(client)
 
 	// Register Role tools
 	s.tools["list_available_incident_roles"] = tools.NewListIncidentRolesTool(client)
 	s.tools["list_users"] = tools.NewListUsersTool(client)
+	s.tools["get_user"] = tools.NewGetUserTool(client)
 	s.tools["assign_incident_role"] = tools.NewAssignIncidentRoleTool(client)
+	s.tools["unassign_incident_role"] = tools.NewUnassignIncidentRoleTool(client)
+
+	// Register Subscriber tools
+	s.tools["list_incident_subscribers"] = tools.NewListIncidentSubscribersTool(client)
+	s.tools["add_incident_subscriber"] = tools.NewAddIncidentSubscriberTool(client)
 
 	// Register Workflow tools
 	s.tools["list_workflows"] = tools.NewListWorkflowsTool(client)
 	s.tools["get_workflow"] = tools.NewGetWorkflowTool(client)
 	s.tools["update_workflow"] = tools.NewUpdateWorkflowTool(client)
+	s.tools["enable_workflow"] = tools.NewEnableWorkflowTool(client)
+	s.tools["disable_workflow"] = tools.NewDisableWorkflowTool(client)
 
 	// Register Alert Route tools
 	s.tools["list_alert_routes"] = tools.NewListAlertRoutesTool(client)
 	s.tools["get_alert_route"] = tools.NewGetAlertRouteTool(client)
 	s.tools["create_alert_route"] = tools.NewCreateAlertRouteTool(client)
 	s.tools["update_alert_route"] = tools.NewUpdateAlertRouteTool(client)
+	s.tools["delete_alert_route"] = tools.NewDeleteAlertRouteTool(client)
+	s.tools["list_escalations"] = tools.NewListEscalationsTool(client)
 
 	// Register Alert Source and Event tools
 	s.tools["list_alert_sources"] = tools.NewListAlertSourcesTool(client)
+	s.tools["get_alert_source"] = tools.NewGetAlertSourceTool(client)
 	s.tools["create_alert_event"] = tools.NewCreateAlertEventTool(client)
 
 	// Register Catalog tools
 	s.tools["list_catalog_types"] = tools.NewListCatalogTypesTool(client)
+	s.tools["create_catalog_type"] = tools.NewCreateCatalogTypeTool(client)
+	s.tools["update_catalog_type"] = tools.NewUpdateCatalogTypeTool(client)
 	s.tools["list_catalog_entries"] = tools.NewListCatalogEntriesTool(client)
+	s.tools["search_catalog_entries"] = tools.NewSearchCatalogEntriesTool(client)
+	s.tools["get_catalog_entry"] = tools.NewGetCatalogEntryTool(client)
+	s.tools["create_catalog_entry"] = tools.NewCreateCatalogEntryTool(client)
 	s.tools["update_catalog_entry"] = tools.NewUpdateCatalogEntryTool(client)
+	s.tools["delete_catalog_entry"] = tools.NewDeleteCatalogEntryTool(client)
+
+	// Register the raw request passthrough only when explicitly enabled
+	methods, err := tools.RawRequestMethodsFromEnv()
+	if err != nil {
+		logging.Warnf("incidentio_raw_request not registered: %v", err)
+	} else if methods != nil {
+		s.tools["incidentio_raw_request"] = tools.NewRawRequestTool(client, methods)
+	}
 }
 
-func (s *Server) handleMessage(msg *mcp.Message) (*mcp.Message, error) {
+func (s *Server) handleMessage(ctx context.Context, msg *mcp.Message) (*mcp.Message, error) {
 	// Handle notifications (no ID means it's a notification)
 	if msg.ID == nil {
 		// Notifications don't require a response
 		return nil, nil
 	}
 
+	logging.Debugf("JSON-RPC request: method=%s id=%v", msg.Method, msg.ID)
+
 	switch msg.Method {
 	case "initialize":
 		return s.handleInitialize(msg)
 	case "tools/list":
 		return s.handleToolsList(msg)
 	case "tools/call":
-		return s.handleToolCall(msg)
+		return s.handleToolCall(ctx, msg)
 	default:
 		// Return proper JSON-RPC error for unknown methods
 		return &mcp.Message{
@@ -146,68 +454,176 @@ func (s *Server) handleMessage(msg *mcp.Message) (*mcp.Message, error) {
 	}
 }
 
+// sessionAPIKeyParam is the initialize parameter a session can use to supply
+// its own incident.io API key
+const sessionAPIKeyParam = "incidentIoApiKey"
+
 func (s *Server) handleInitialize(msg *mcp.Message) (*mcp.Message, error) {
+	// A session can bring its own API key so that one deployment can serve
+	// several incident.io organizations. Its tools are rebuilt around a
+	// client for that key, replacing any using INCIDENT_IO_API_KEY.
+	if params, ok := msg.Params.(map[string]interface{}); ok {
+		if value, exists := params[sessionAPIKeyParam]; exists {
+			apiKey, ok := value.(string)
+			if !ok || apiKey == "" {
+				return nil, &invalidParamsError{message: fmt.Sprintf("%s must be a non-empty string", sessionAPIKeyParam)}
+			}
+			client, err := incidentio.NewClientWithAPIKey(apiKey)
+			if err != nil {
+				return nil, err
+			}
+			s.sessionAPIKey = apiKey
+			s.tools = make(map[string]tools.Tool)
+			s.tools[reloadClientToolName] = newReloadClientTool(s)
+			s.registerClientTools(client)
+			logging.Infof("Using the session's API key, registered %d tools", len(s.tools))
+		}
+	}
+
+	// Clients can spot degraded mode, where the incident.io client could not
+	// be created and only the reload tool is available, from the server info
+	serverInfo := map[string]interface{}{
+		"name":      "incidentio-mcp-server",
+		"version":   Version,
+		"toolCount": len(s.tools),
+		"degraded":  s.clientErr != nil,
+	}
+	if s.clientErr != nil {
+		serverInfo["degradedReason"] = s.clientErr.Error()
+	}
+
 	response := &mcp.Message{
 		Jsonrpc: "2.0",
 		ID:      msg.ID,
 		Result: map[string]interface{}{
 			"protocolVersion": "2024-11-05",
 			"capabilities": map[string]interface{}{
-				"tools": map[string]interface{}{},
-			},
-			"serverInfo": map[string]interface{}{
-				"name":    "incidentio-mcp-server",
-				"version": "0.1.0",
+				"tools": map[string]interface{}{
+					"listChanged": true,
+				},
 			},
+			"serverInfo": serverInfo,
 		},
 	}
 	return response, nil
 }
 
+// handleToolsList returns the tools sorted by name, so the order is the same
+// on every call. Long lists are paginated: a page that is not the last has a
+// nextCursor, which the client sends back as the cursor param to get the next
+// page. The cursor is the name of the last tool on the previous page.
 func (s *Server) handleToolsList(msg *mcp.Message) (*mcp.Message, error) {
-	var toolsList []map[string]interface{}
-	for _, tool := range s.tools {
+	names := make([]string, 0, len(s.tools))
+	for name := range s.tools {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	start := 0
+	if params, ok := msg.Params.(map[string]interface{}); ok {
+		if value, exists := params["cursor"]; exists {
+			cursor, ok := value.(string)
+			if !ok {
+				return nil, &invalidParamsError{message: "cursor must be a string"}
+			}
+			if _, known := s.tools[cursor]; cursor != "" && !known {
+				return nil, &invalidParamsError{message: fmt.Sprintf("Invalid cursor: %s", cursor)}
+			}
+			start = sort.Search(len(names), func(i int) bool { return names[i] > cursor })
+		}
+	}
+
+	end := len(names)
+	if s.toolsPageSize > 0 && start+s.toolsPageSize < end {
+		end = start + s.toolsPageSize
+	}
+
+	toolsList := make([]map[string]interface{}, 0, end-start)
+	for _, name := range names[start:end] {
+		tool := s.tools[name]
 		toolsList = append(toolsList, map[string]interface{}{
 			"name":        tool.Name(),
 			"description": tool.Description(),
-			"inputSchema": tool.InputSchema(),
+			"inputSchema": tools.WithDryRunProperty(tool.InputSchema()),
 		})
 	}
 
+	result := map[string]interface{}{
+		"tools": toolsList,
+	}
+	if end < len(names) {
+		result["nextCursor"] = names[end-1]
+	}
+
 	response := &mcp.Message{
 		Jsonrpc: "2.0",
 		ID:      msg.ID,
-		Result: map[string]interface{}{
-			"tools": toolsList,
-		},
+		Result:  result,
 	}
 	return response, nil
 }
 
-func (s *Server) handleToolCall(msg *mcp.Message) (*mcp.Message, error) {
+func (s *Server) handleToolCall(ctx context.Context, msg *mcp.Message) (response *mcp.Message, err error) {
 	params, ok := msg.Params.(map[string]interface{})
 	if !ok {
-		return nil, fmt.Errorf("invalid params")
+		return nil, &invalidParamsError{message: "Invalid params"}
 	}
 
 	toolName, ok := params["name"].(string)
 	if !ok {
-		return nil, fmt.Errorf("missing tool name")
+		return nil, &invalidParamsError{message: "Missing tool name"}
 	}
 
 	tool, exists := s.tools[toolName]
 	if !exists {
-		return nil, fmt.Errorf("tool not found: %s", toolName)
+		logging.Warnf("Tool not found: %s", toolName)
+		if s.clientErr != nil {
+			return nil, &invalidParamsError{message: fmt.Sprintf("Tool not found: %s. %v", toolName, degradedError(s.clientErr))}
+		}
+		return nil, &invalidParamsError{message: fmt.Sprintf("Tool not found: %s", toolName)}
 	}
 
+	// A panicking tool must not take down the stdio loop, so report it as an
+	// internal error for this call only. This is installed before the schema
+	// is read and the arguments validated, since those run tool code too.
+	// The panic value and stack may contain request data, so they go to
+	// stderr rather than to the client.
+	defer func() {
+		if r := recover(); r != nil {
+			logging.Errorf("Tool %s panicked: %v\n%s", toolName, r, debug.Stack())
+			response = nil
+			err = fmt.Errorf("internal error while running tool %s", toolName)
+		}
+	}()
+
 	args, _ := params["arguments"].(map[string]interface{})
+	if tools.TakeDryRunArgument(args) {
+		ctx = incidentio.WithDryRun(ctx)
+	}
+	if err := tools.ValidateArguments(tool.InputSchema(), args); err != nil {
+		logging.Warnf("Invalid arguments for tool %s: %v", toolName, err)
+		return nil, &invalidParamsError{message: fmt.Sprintf("Invalid arguments for %s: %v", toolName, err)}
+	}
+	logging.Debugf("Executing tool: %s", toolName)
+	ctx = withProgressNotifications(ctx, params)
 
-	result, err := tool.Execute(args)
+	result, err := tool.Execute(ctx, args)
+	if dryRun, ok := incidentio.AsDryRun(err); ok {
+		result, err = tools.FormatDryRun(dryRun)
+	}
 	if err != nil {
+		logging.Warnf("Tool execution failed: %s - %v", toolName, err)
 		return nil, err
 	}
 
-	response := &mcp.Message{
+	logging.Debugf("Tool executed successfully: %s", toolName)
+
+	if truncated, ok := truncateResult(result, s.maxResponseBytes); ok {
+		logging.Warnf("Truncated the %d byte result of %s to the %d byte limit", len(result), toolName, s.maxResponseBytes)
+		result = truncated
+	}
+
+	response = &mcp.Message{
 		Jsonrpc: "2.0",
 		ID:      msg.ID,
 		Result: map[string]interface{}{
@@ -222,13 +638,34 @@ func (s *Server) handleToolCall(msg *mcp.Message) (*mcp.Message, error) {
 	return response, nil
 }
 
+// invalidParamsError is a malformed tools/call request, reported with the
+// JSON-RPC invalid params code rather than as an internal error
+type invalidParamsError struct {
+	message string
+}
+
+func (e *invalidParamsError) Error() string { return e.message }
+
 func (s *Server) createErrorResponse(id interface{}, err error) *mcp.Message {
+	var paramsErr *invalidParamsError
+	if errors.As(err, &paramsErr) {
+		return errorMessage(id, -32602, paramsErr.message)
+	}
+	if code, data, ok := apiErrorCode(err); ok {
+		response := errorMessage(id, code, tools.FormatError(err))
+		response.Error.Data = data
+		return response
+	}
+	return errorMessage(id, -32603, tools.FormatError(err))
+}
+
+func errorMessage(id interface{}, code int, message string) *mcp.Message {
 	return &mcp.Message{
 		Jsonrpc: "2.0",
 		ID:      id,
 		Error: &mcp.Error{
-			Code:    -32603,
-			Message: err.Error(),
+			Code:    code,
+			Message: message,
 		},
 	}
 }
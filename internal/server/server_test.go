@@ -0,0 +1,1331 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+	"github.com/incident-io/incidentio-mcp-golang/internal/tools"
+	"github.com/incident-io/incidentio-mcp-golang/pkg/mcp"
+)
+
+// newTestServer registers all tools against a mock API that fails the test on
+// any request, so malformed arguments must be rejected before reaching it
+func newTestServer(t *testing.T) *Server {
+	t.Helper()
+
+	return newTestServerWithAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+}
+
+// newTestServerWithAPI registers all tools against a mock API served by handler
+func newTestServerWithAPI(t *testing.T, handler http.HandlerFunc) *Server {
+	t.Helper()
+
+	api := httptest.NewServer(handler)
+	t.Cleanup(api.Close)
+
+	t.Setenv("INCIDENT_IO_API_KEY", "test-key")
+	t.Setenv("INCIDENT_IO_BASE_URL", api.URL)
+
+	s := New()
+	s.registerTools()
+	return s
+}
+
+func toolCallMessage(id int, name string, args map[string]interface{}) *mcp.Message {
+	return &mcp.Message{
+		Jsonrpc: "2.0",
+		ID:      id,
+		Method:  "tools/call",
+		Params: map[string]interface{}{
+			"name":      name,
+			"arguments": args,
+		},
+	}
+}
+
+func TestHandleToolCall_MalformedArguments(t *testing.T) {
+	s := newTestServer(t)
+
+	tests := []struct {
+		name    string
+		tool    string
+		args    map[string]interface{}
+		wantErr string
+	}{
+		{
+			name: "create_alert_route with a non-object condition",
+			tool: "create_alert_route",
+			args: map[string]interface{}{
+				"name":        "Production alerts",
+				"conditions":  []interface{}{"severity equals critical"},
+				"escalations": []interface{}{map[string]interface{}{"id": "esc_123", "level": float64(1)}},
+			},
+			wantErr: "field 'conditions[0]' must be of type object, got string",
+		},
+		{
+			name: "update_alert_route with a missing escalation ID",
+			tool: "update_alert_route",
+			args: map[string]interface{}{
+				"id":          "01HXYZALERTROUTE0000000000",
+				"escalations": []interface{}{map[string]interface{}{"level": float64(1)}},
+			},
+			wantErr: "missing required field 'escalations[0].id'",
+		},
+		{
+			name:    "list_workflows with an unknown argument",
+			tool:    "list_workflows",
+			args:    map[string]interface{}{"workflow_id": "wf_123"},
+			wantErr: "unknown argument 'workflow_id'",
+		},
+		{
+			name:    "get_incident without incident_id",
+			tool:    "get_incident",
+			args:    map[string]interface{}{},
+			wantErr: "missing required argument 'incident_id'",
+		},
+		{
+			name:    "list_incidents with a page_size of the wrong type",
+			tool:    "list_incidents",
+			args:    map[string]interface{}{"page_size": "lots"},
+			wantErr: "argument 'page_size' must be of type integer, got string",
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response, err := s.handleMessage(context.Background(), toolCallMessage(i+1, tt.tool, tt.args))
+			if err == nil {
+				t.Fatalf("expected error, got response: %+v", response)
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got: %v", tt.wantErr, err)
+			}
+			if code := s.createErrorResponse(i+1, err).Error.Code; code != -32602 {
+				t.Errorf("expected invalid params error code -32602, got %d", code)
+			}
+		})
+	}
+
+	// The server should keep handling requests after rejecting bad calls
+	response, err := s.handleMessage(context.Background(), &mcp.Message{Jsonrpc: "2.0", ID: 99, Method: "tools/list"})
+	if err != nil || response == nil || response.Error != nil {
+		t.Fatalf("expected tools/list to succeed after malformed calls, got response %+v, error %v", response, err)
+	}
+}
+
+// panickingTool is a stub tool whose Execute always panics
+type panickingTool struct{}
+
+var _ tools.Tool = panickingTool{}
+
+func (panickingTool) Name() string        { return "panic" }
+func (panickingTool) Description() string { return "Always panics" }
+func (panickingTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{"type": "object"}
+}
+func (panickingTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	panic("secret request data")
+}
+
+// schemaPanickingTool is a stub tool whose InputSchema panics, which is read
+// while the arguments are validated
+type schemaPanickingTool struct{ panickingTool }
+
+func (schemaPanickingTool) InputSchema() map[string]interface{} {
+	panic("secret schema data")
+}
+
+// largeResultTool returns a result of many lines, each naming an incident
+type largeResultTool struct{ lines int }
+
+var _ tools.Tool = largeResultTool{}
+
+func (largeResultTool) Name() string        { return "large" }
+func (largeResultTool) Description() string { return "Returns a large result" }
+func (largeResultTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{"type": "object"}
+}
+func (t largeResultTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	var b strings.Builder
+	for i := 0; i < t.lines; i++ {
+		fmt.Fprintf(&b, "{\"id\": \"inc_%04d\", \"name\": \"Incident é %d\"}\n", i, i)
+	}
+	return b.String(), nil
+}
+
+func TestHandleToolCall_TruncatesLargeResults(t *testing.T) {
+	tests := []struct {
+		name          string
+		limit         int
+		wantTruncated bool
+	}{
+		{name: "over the limit", limit: 1000, wantTruncated: true},
+		{name: "under the limit", limit: 1 << 20},
+		{name: "no limit", limit: 0},
+	}
+
+	full, _ := largeResultTool{lines: 200}.Execute(context.Background(), nil)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := New()
+			s.tools["large"] = largeResultTool{lines: 200}
+			s.maxResponseBytes = tt.limit
+
+			response, err := s.handleToolCall(context.Background(), toolCallMessage(1, "large", nil))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			text := response.Result.(map[string]interface{})["content"].([]map[string]interface{})[0]["text"].(string)
+
+			if !tt.wantTruncated {
+				if text != full {
+					t.Errorf("expected the full %d byte result, got %d bytes", len(full), len(text))
+				}
+				return
+			}
+
+			if len(text) > tt.limit {
+				t.Errorf("expected at most %d bytes, got %d", tt.limit, len(text))
+			}
+			if !utf8.ValidString(text) {
+				t.Error("expected truncation to keep the result valid UTF-8")
+			}
+			kept := strings.SplitN(text, "\n\n...truncated", 2)[0]
+			if !strings.HasPrefix(full, kept+"\n") {
+				t.Error("expected the kept part to be whole lines from the start of the result")
+			}
+			dropped := 200 - len(strings.Split(kept, "\n"))
+			if !strings.Contains(text, fmt.Sprintf("%d of its 200 lines were dropped", dropped)) {
+				t.Errorf("expected the note to say %d lines were dropped, got: %s", dropped, text)
+			}
+			for _, expected := range []string{"...truncated", fmt.Sprintf("result was %d bytes", len(full)), "use fields", "pagination"} {
+				if !strings.Contains(strings.ToLower(text), strings.ToLower(expected)) {
+					t.Errorf("expected the result to contain %q, got: %s", expected, text)
+				}
+			}
+		})
+	}
+}
+
+func TestMaxResponseBytesFromEnv(t *testing.T) {
+	tests := []struct {
+		value string
+		want  int
+	}{
+		{value: "", want: defaultMaxResponseBytes},
+		{value: "50000", want: 50000},
+		{value: "0", want: 0},
+		{value: "-1", want: defaultMaxResponseBytes},
+		{value: "lots", want: defaultMaxResponseBytes},
+	}
+
+	for _, tt := range tests {
+		t.Setenv("MCP_MAX_RESPONSE_BYTES", tt.value)
+		if got := maxResponseBytesFromEnv(); got != tt.want {
+			t.Errorf("MCP_MAX_RESPONSE_BYTES=%q: expected %d, got %d", tt.value, tt.want, got)
+		}
+	}
+}
+
+func TestServe_RecoversFromToolPanic(t *testing.T) {
+	s := New()
+	s.tools["panic"] = panickingTool{}
+
+	var input bytes.Buffer
+	encoder := json.NewEncoder(&input)
+	for _, msg := range []*mcp.Message{
+		toolCallMessage(1, "panic", nil),
+		{Jsonrpc: "2.0", ID: 2, Method: "tools/list"},
+	} {
+		if err := encoder.Encode(msg); err != nil {
+			t.Fatalf("failed to encode message: %v", err)
+		}
+	}
+
+	var output bytes.Buffer
+	if err := s.serve(context.Background(), &input, &output); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var responses []mcp.Message
+	decoder := json.NewDecoder(&output)
+	for decoder.More() {
+		var response mcp.Message
+		if err := decoder.Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		responses = append(responses, response)
+	}
+	if len(responses) != 2 {
+		t.Fatalf("expected 2 responses, got %d: %s", len(responses), output.String())
+	}
+
+	panicked := responses[0]
+	if panicked.Error == nil || panicked.Error.Code != -32603 {
+		t.Fatalf("expected -32603 error for panicking tool, got: %+v", panicked)
+	}
+	if strings.Contains(panicked.Error.Message, "secret request data") {
+		t.Errorf("expected panic value to be kept out of the response, got: %s", panicked.Error.Message)
+	}
+
+	// The server should carry on with the next message
+	if responses[1].Error != nil || responses[1].Result == nil {
+		t.Errorf("expected tools/list to succeed after the panic, got: %+v", responses[1])
+	}
+}
+
+func TestHandleToolCall_RecoversFromPanicDuringValidation(t *testing.T) {
+	s := New()
+	s.tools["panic"] = schemaPanickingTool{}
+
+	response, err := s.handleToolCall(context.Background(), toolCallMessage(1, "panic", map[string]interface{}{"dry_run": true}))
+	if response != nil {
+		t.Errorf("expected no response, got: %+v", response)
+	}
+	if err == nil || err.Error() != "internal error while running tool panic" {
+		t.Errorf("expected an internal error, got: %v", err)
+	}
+}
+
+func TestHandleToolCall_DryRun(t *testing.T) {
+	tests := []struct {
+		name   string
+		env    string
+		dryRun interface{}
+	}{
+		{name: "DRY_RUN environment variable", env: "true"},
+		{name: "dry_run argument", dryRun: true},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("DRY_RUN", tt.env)
+			// The mock API fails the test on any request, so a dry run must not
+			// reach it
+			s := newTestServer(t)
+
+			args := map[string]interface{}{
+				"name":        "Database outage",
+				"summary":     "Primary database is unreachable",
+				"severity_id": "sev_critical",
+			}
+			if tt.dryRun != nil {
+				args["dry_run"] = tt.dryRun
+			}
+
+			response, err := s.handleMessage(context.Background(), toolCallMessage(i+1, "create_incident", args))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			content := response.Result.(map[string]interface{})["content"].([]map[string]interface{})
+			var preview struct {
+				DryRun bool                             `json:"dry_run"`
+				Method string                           `json:"method"`
+				URL    string                           `json:"url"`
+				Body   incidentio.CreateIncidentRequest `json:"body"`
+			}
+			if err := json.Unmarshal([]byte(content[0]["text"].(string)), &preview); err != nil {
+				t.Fatalf("failed to parse dry run result: %v", err)
+			}
+
+			if !preview.DryRun || preview.Method != "POST" || !strings.HasSuffix(preview.URL, "/incidents") {
+				t.Errorf("expected a POST /incidents preview, got %+v", preview)
+			}
+			if preview.Body.Name != "Database outage" || preview.Body.SeverityID != "sev_critical" || preview.Body.Summary != "Primary database is unreachable" {
+				t.Errorf("expected serialized create request, got %+v", preview.Body)
+			}
+		})
+	}
+}
+
+func TestHandleToolCall_GetIncidentResolvesIdentifiers(t *testing.T) {
+	const incidentID = "01HXYZINCIDENT0000000000000"
+	incident := `{"id": "` + incidentID + `", "reference": "INC-123", "name": "Database outage",
+		"slack_channel_id": "C0123ABCDEF", "slack_channel_name": "inc-123-database-outage"}`
+
+	s := newTestServerWithAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/incidents":
+			fmt.Fprintf(w, `{"incidents": [%s], "pagination_meta": {}}`, incident)
+		case "/incidents/" + incidentID, "/incidents/123":
+			fmt.Fprintf(w, `{"incident": %s}`, incident)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	tests := []struct {
+		name       string
+		identifier string
+	}{
+		{name: "incident ID", identifier: incidentID},
+		{name: "reference", identifier: "INC-123"},
+		{name: "Slack channel ID", identifier: "C0123ABCDEF"},
+		{name: "Slack channel name", identifier: "inc-123-database-outage"},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response, err := s.handleMessage(context.Background(), toolCallMessage(i+1, "get_incident", map[string]interface{}{
+				"incident_id": tt.identifier,
+				"fields":      "id,reference",
+			}))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			content := response.Result.(map[string]interface{})["content"].([]map[string]interface{})
+			if text := content[0]["text"].(string); !strings.Contains(text, incidentID) || !strings.Contains(text, "INC-123") {
+				t.Errorf("expected incident %s, got: %s", incidentID, text)
+			}
+		})
+	}
+}
+
+func TestServe_MalformedInput(t *testing.T) {
+	s := New()
+
+	input := strings.Join([]string{
+		`{"jsonrpc": "2.0", "id": 1, "method": "tools/list"`,
+		`not json at all`,
+		`{"jsonrpc": "1.0", "id": 2, "method": "tools/list"}`,
+		`{"jsonrpc": "2.0", "id": 3, "method": "tools/call", "params": {"name": "missing_tool"}}`,
+		`{"jsonrpc": "2.0", "id": 4, "method": "tools/list"}`,
+	}, "\n") + "\n"
+
+	var output bytes.Buffer
+	if err := s.serve(context.Background(), strings.NewReader(input), &output); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var responses []mcp.Message
+	decoder := json.NewDecoder(&output)
+	for decoder.More() {
+		var response mcp.Message
+		if err := decoder.Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		responses = append(responses, response)
+	}
+
+	// The truncated and non-JSON lines are dropped, the rest are answered
+	if len(responses) != 3 {
+		t.Fatalf("expected 3 responses, got %d: %s", len(responses), output.String())
+	}
+	if responses[0].Error == nil || responses[0].Error.Code != -32600 {
+		t.Errorf("expected -32600 for an invalid jsonrpc version, got: %+v", responses[0])
+	}
+	if responses[1].Error == nil || responses[1].Error.Code != -32602 || !strings.Contains(responses[1].Error.Message, "missing_tool") {
+		t.Errorf("expected -32602 for an unknown tool, got: %+v", responses[1])
+	}
+	if responses[2].Error != nil || responses[2].Result == nil {
+		t.Errorf("expected tools/list to succeed after malformed input, got: %+v", responses[2])
+	}
+}
+
+func TestServe_PreservesRequestIDTypes(t *testing.T) {
+	s := New()
+
+	ids := []string{`1`, `"1"`, `9007199254740993`, `"req-42"`, `-3`, `1.5`}
+	var lines []string
+	for i, id := range ids {
+		method := "tools/list"
+		if i%2 == 1 {
+			// Error responses must echo the ID too
+			method = "no/such/method"
+		}
+		lines = append(lines, fmt.Sprintf(`{"jsonrpc": "2.0", "id": %s, "method": %q}`, id, method))
+	}
+	// Only the envelope of a parse error is read, and its ID is kept as well
+	lines = append(lines, `{"jsonrpc": "2.0", "id": "broken", "method": 7}`)
+	ids = append(ids, `"broken"`)
+
+	var output bytes.Buffer
+	if err := s.serve(context.Background(), strings.NewReader(strings.Join(lines, "\n")+"\n"), &output); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got []string
+	decoder := json.NewDecoder(&output)
+	for decoder.More() {
+		var response struct {
+			ID json.RawMessage `json:"id"`
+		}
+		if err := decoder.Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		got = append(got, string(response.ID))
+	}
+
+	if !reflect.DeepEqual(got, ids) {
+		t.Errorf("expected response IDs %v, got %v", ids, got)
+	}
+}
+
+func TestParseRequestID(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want interface{}
+	}{
+		{raw: `1`, want: json.Number("1")},
+		{raw: ` 12345678901234567890 `, want: json.Number("12345678901234567890")},
+		{raw: `"1"`, want: "1"},
+		{raw: `""`, want: ""},
+		{raw: `null`, want: nil},
+		{raw: ``, want: nil},
+	}
+
+	for _, tt := range tests {
+		if got := parseRequestID(json.RawMessage(tt.raw)); got != tt.want {
+			t.Errorf("parseRequestID(%s) = %#v, want %#v", tt.raw, got, tt.want)
+		}
+	}
+}
+
+// slowTool is a stub tool that signals when it starts and then blocks until
+// released or its context is cancelled
+type slowTool struct {
+	started chan struct{}
+	release chan struct{}
+	// ctxErr receives the context error seen when Execute returns
+	ctxErr chan error
+}
+
+func newSlowTool() *slowTool {
+	return &slowTool{started: make(chan struct{}), release: make(chan struct{}), ctxErr: make(chan error, 1)}
+}
+
+var _ tools.Tool = (*slowTool)(nil)
+
+func (t *slowTool) Name() string        { return "slow" }
+func (t *slowTool) Description() string { return "Blocks until released" }
+func (t *slowTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{"type": "object"}
+}
+func (t *slowTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	close(t.started)
+	select {
+	case <-t.release:
+	case <-ctx.Done():
+	}
+	t.ctxErr <- ctx.Err()
+	return "done", ctx.Err()
+}
+
+// serveInBackground runs s.serve on a pipe, sending a call to the slow tool,
+// and returns a channel that receives serve's result
+func serveInBackground(t *testing.T, s *Server, ctx context.Context, output io.Writer) <-chan error {
+	t.Helper()
+
+	input, inputWriter := io.Pipe()
+	t.Cleanup(func() { inputWriter.Close() })
+
+	result := make(chan error, 1)
+	go func() {
+		result <- s.serve(ctx, input, output)
+	}()
+
+	go func() {
+		message, _ := json.Marshal(toolCallMessage(1, "slow", nil))
+		inputWriter.Write(append(message, '\n'))
+	}()
+	return result
+}
+
+func TestServe_ShutdownWaitsForInFlightToolCall(t *testing.T) {
+	tool := newSlowTool()
+	s := New()
+	s.tools["slow"] = tool
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var output bytes.Buffer
+	result := serveInBackground(t, s, ctx, &output)
+
+	<-tool.started
+	cancel()
+
+	// Shutdown must wait for the tool rather than return straight away
+	select {
+	case err := <-result:
+		t.Fatalf("serve returned before the in-flight tool finished: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(tool.release)
+	if err := <-tool.ctxErr; err != nil {
+		t.Errorf("expected the tool's context to stay live during shutdown, got: %v", err)
+	}
+
+	select {
+	case err := <-result:
+		if err != context.Canceled {
+			t.Errorf("expected context.Canceled, got: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("serve did not return after the in-flight tool finished")
+	}
+
+	var response mcp.Message
+	if err := json.Unmarshal(output.Bytes(), &response); err != nil {
+		t.Fatalf("expected the tool's response to be written before exit, got %q: %v", output.String(), err)
+	}
+	if response.Error != nil || response.Result == nil {
+		t.Errorf("expected a successful response, got: %+v", response)
+	}
+}
+
+func TestServe_ShutdownTimeoutCancelsToolCall(t *testing.T) {
+	tool := newSlowTool()
+	s := New()
+	s.tools["slow"] = tool
+	s.shutdownTimeout = 10 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var output bytes.Buffer
+	result := serveInBackground(t, s, ctx, &output)
+
+	<-tool.started
+	cancel()
+
+	select {
+	case err := <-result:
+		if err != context.Canceled {
+			t.Errorf("expected context.Canceled, got: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("serve did not return after the shutdown timeout")
+	}
+
+	select {
+	case err := <-tool.ctxErr:
+		if err == nil {
+			t.Error("expected the overrunning tool call to be cancelled")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("tool call was not cancelled after the shutdown timeout")
+	}
+}
+
+func TestServe_CancelledNotificationCancelsToolCall(t *testing.T) {
+	tests := []struct {
+		name         string
+		cancellation string
+	}{
+		{name: "MCP notification", cancellation: `{"jsonrpc": "2.0", "method": "notifications/cancelled", "params": {"requestId": "call-1", "reason": "user pressed stop"}}`},
+		{name: "LSP-style notification", cancellation: `{"jsonrpc": "2.0", "method": "$/cancelled", "params": {"id": "call-1"}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tool := newSlowTool()
+			s := New()
+			s.tools["slow"] = tool
+
+			input, inputWriter := io.Pipe()
+			var output bytes.Buffer
+			result := make(chan error, 1)
+			go func() {
+				result <- s.serve(context.Background(), input, &output)
+			}()
+
+			writeLine := func(line string) {
+				t.Helper()
+				if _, err := inputWriter.Write([]byte(line + "\n")); err != nil {
+					t.Fatalf("failed to write input: %v", err)
+				}
+			}
+
+			writeLine(`{"jsonrpc": "2.0", "id": "call-1", "method": "tools/call", "params": {"name": "slow"}}`)
+			<-tool.started
+			writeLine(tt.cancellation)
+
+			select {
+			case err := <-tool.ctxErr:
+				if err != context.Canceled {
+					t.Errorf("expected the tool's context to be cancelled, got: %v", err)
+				}
+			case <-time.After(time.Second):
+				t.Fatal("the tool's context was not cancelled")
+			}
+
+			// The server keeps serving after a cancellation
+			writeLine(`{"jsonrpc": "2.0", "id": 2, "method": "tools/list"}`)
+			inputWriter.Close()
+			if err := <-result; err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var responses []map[string]interface{}
+			decoder := json.NewDecoder(&output)
+			for decoder.More() {
+				var response map[string]interface{}
+				if err := decoder.Decode(&response); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				responses = append(responses, response)
+			}
+			if len(responses) != 1 || responses[0]["id"] != float64(2) {
+				t.Errorf("expected only the tools/list response, got: %s", output.String())
+			}
+		})
+	}
+}
+
+func TestServe_CancelsToolCallWithAnotherQueued(t *testing.T) {
+	first, second := newSlowTool(), newSlowTool()
+	s := New()
+	s.tools["slow"] = first
+	s.tools["second"] = second
+
+	input, inputWriter := io.Pipe()
+	var output bytes.Buffer
+	result := make(chan error, 1)
+	go func() {
+		result <- s.serve(context.Background(), input, &output)
+	}()
+
+	// Writes to the pipe block until the server reads them, so write from a
+	// goroutine to see whether the cancellation is read at all
+	written := make(chan error, 1)
+	go func() {
+		<-first.started
+		_, err := inputWriter.Write([]byte(`{"jsonrpc": "2.0", "id": 2, "method": "tools/call", "params": {"name": "second"}}` + "\n" +
+			`{"jsonrpc": "2.0", "method": "notifications/cancelled", "params": {"requestId": 1}}` + "\n"))
+		written <- err
+	}()
+	if _, err := inputWriter.Write([]byte(`{"jsonrpc": "2.0", "id": 1, "method": "tools/call", "params": {"name": "slow"}}` + "\n")); err != nil {
+		t.Fatalf("failed to write input: %v", err)
+	}
+
+	select {
+	case err := <-first.ctxErr:
+		if err != context.Canceled {
+			t.Errorf("expected the first call's context to be cancelled, got: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("the running call was not cancelled while another call was queued")
+	}
+	if err := <-written; err != nil {
+		t.Fatalf("failed to write input: %v", err)
+	}
+
+	// The queued call runs once the cancelled one has finished
+	select {
+	case <-second.started:
+	case <-time.After(time.Second):
+		t.Fatal("the queued call did not start")
+	}
+	close(second.release)
+	inputWriter.Close()
+	if err := <-result; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var response map[string]interface{}
+	decoder := json.NewDecoder(&output)
+	if err := decoder.Decode(&response); err != nil || response["id"] != float64(2) || decoder.More() {
+		t.Errorf("expected only the second call's response, got: %s", output.String())
+	}
+}
+
+func TestInFlightRequests_MatchesIDType(t *testing.T) {
+	requests := newInFlightRequests()
+	numericCtx, finishNumeric := requests.start(context.Background(), json.Number("1"))
+	stringCtx, finishString := requests.start(context.Background(), "1")
+
+	if !requests.handleCancellation([]byte(`{"jsonrpc": "2.0", "method": "notifications/cancelled", "params": {"requestId": "1"}}`)) {
+		t.Fatal("expected a cancellation notification to be recognised")
+	}
+	if stringCtx.Err() != context.Canceled {
+		t.Errorf("expected the string ID request to be cancelled, got: %v", stringCtx.Err())
+	}
+	if numericCtx.Err() != nil {
+		t.Errorf("expected the numeric ID request to keep running, got: %v", numericCtx.Err())
+	}
+
+	if !finishString() {
+		t.Error("expected the string ID request to be reported as cancelled")
+	}
+	if finishNumeric() {
+		t.Error("expected the numeric ID request not to be reported as cancelled")
+	}
+
+	if requests.handleCancellation([]byte(`{"jsonrpc": "2.0", "id": 3, "method": "tools/list"}`)) {
+		t.Error("expected a request not to be treated as a cancellation")
+	}
+	if requests.cancel(json.Number("1")) {
+		t.Error("expected finished requests to be unregistered")
+	}
+}
+
+func TestHandleInitialize_SessionAPIKey(t *testing.T) {
+	var mu sync.Mutex
+	seen := map[string]int{}
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		seen[r.Header.Get("Authorization")]++
+		mu.Unlock()
+
+		// Echo the key back, as some auth errors do, to check it is redacted
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprintf(w, `{"type": "authentication_error", "message": "invalid API key %s"}`, strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "))
+	}
+
+	sessions := map[string]*Server{
+		"org-a-key": newTestServerWithAPI(t, handler),
+		"org-b-key": newTestServerWithAPI(t, handler),
+	}
+
+	for apiKey, s := range sessions {
+		response, err := s.handleMessage(context.Background(), &mcp.Message{
+			Jsonrpc: "2.0",
+			ID:      1,
+			Method:  "initialize",
+			Params:  map[string]interface{}{"protocolVersion": "2024-11-05", sessionAPIKeyParam: apiKey},
+		})
+		if err != nil || response.Error != nil {
+			t.Fatalf("initialize failed: response %+v, error %v", response, err)
+		}
+
+		_, err = s.handleMessage(context.Background(), toolCallMessage(2, "list_alerts", nil))
+		if err == nil {
+			t.Fatal("expected the mock API to reject the call")
+		}
+		if message := s.createErrorResponse(2, err).Error.Message; strings.Contains(message, apiKey) {
+			t.Errorf("expected the session API key to be redacted, got: %s", message)
+		}
+	}
+
+	// Each session authenticated with its own key, never the environment's
+	for _, apiKey := range []string{"org-a-key", "org-b-key"} {
+		if seen["Bearer "+apiKey] == 0 {
+			t.Errorf("expected requests with the %s session key, got %v", apiKey, seen)
+		}
+	}
+	if len(seen) != 2 {
+		t.Errorf("expected only the two session keys to be used, got %v", seen)
+	}
+}
+
+func TestHandleInitialize_InvalidSessionAPIKey(t *testing.T) {
+	s := newTestServer(t)
+
+	_, err := s.handleMessage(context.Background(), &mcp.Message{
+		Jsonrpc: "2.0",
+		ID:      1,
+		Method:  "initialize",
+		Params:  map[string]interface{}{sessionAPIKeyParam: 42},
+	})
+	if err == nil || !strings.Contains(err.Error(), sessionAPIKeyParam) {
+		t.Fatalf("expected an invalid API key error, got: %v", err)
+	}
+}
+
+func initializeServerInfo(t *testing.T, s *Server) map[string]interface{} {
+	t.Helper()
+
+	response, err := s.handleMessage(context.Background(), &mcp.Message{Jsonrpc: "2.0", ID: 1, Method: "initialize"})
+	if err != nil {
+		t.Fatalf("initialize failed: %v", err)
+	}
+	return response.Result.(map[string]interface{})["serverInfo"].(map[string]interface{})
+}
+
+func TestHandleInitialize_ServerInfo(t *testing.T) {
+	t.Run("reports the registered tools", func(t *testing.T) {
+		s := newTestServer(t)
+		info := initializeServerInfo(t, s)
+
+		if info["version"] != Version {
+			t.Errorf("expected version %q, got %v", Version, info["version"])
+		}
+		if count := info["toolCount"]; count != len(s.tools) || len(s.tools) == 0 {
+			t.Errorf("expected toolCount %d, got %v", len(s.tools), count)
+		}
+		if info["degraded"] != false {
+			t.Errorf("expected degraded=false, got %v", info["degraded"])
+		}
+	})
+
+	t.Run("reports degraded mode when the client cannot be created", func(t *testing.T) {
+		t.Setenv("INCIDENT_IO_API_KEY", "")
+		t.Setenv("INCIDENT_IO_API_KEY_FILE", "")
+
+		s := New()
+		s.registerTools()
+		info := initializeServerInfo(t, s)
+
+		// Only the reload tool and the degraded mode stubs are available
+		if info["toolCount"] != 1+len(degradedToolNames) {
+			t.Errorf("expected toolCount %d, got %v", 1+len(degradedToolNames), info["toolCount"])
+		}
+		if info["degraded"] != true {
+			t.Errorf("expected degraded=true, got %v", info["degraded"])
+		}
+		if reason, _ := info["degradedReason"].(string); !strings.Contains(reason, "INCIDENT_IO_API_KEY") {
+			t.Errorf("expected degradedReason to mention the missing API key, got %q", reason)
+		}
+	})
+}
+
+// listToolNames calls tools/list with params and returns the tool names and
+// next cursor of the page
+func listToolNames(t *testing.T, s *Server, params interface{}) ([]string, interface{}) {
+	t.Helper()
+
+	response, err := s.handleMessage(context.Background(), &mcp.Message{Jsonrpc: "2.0", ID: 1, Method: "tools/list", Params: params})
+	if err != nil {
+		t.Fatalf("tools/list failed: %v", err)
+	}
+	result := response.Result.(map[string]interface{})
+
+	var names []string
+	for _, tool := range result["tools"].([]map[string]interface{}) {
+		names = append(names, tool["name"].(string))
+	}
+	return names, result["nextCursor"]
+}
+
+func TestHandleToolsList_SortedByName(t *testing.T) {
+	s := newTestServer(t)
+
+	first, _ := listToolNames(t, s, nil)
+	if len(first) != len(s.tools) {
+		t.Fatalf("expected all %d tools in one page, got %d", len(s.tools), len(first))
+	}
+	if !sort.StringsAreSorted(first) {
+		t.Errorf("expected tools sorted by name, got %v", first)
+	}
+
+	for i := 0; i < 5; i++ {
+		names, _ := listToolNames(t, s, nil)
+		if !reflect.DeepEqual(names, first) {
+			t.Fatalf("expected the same order on every call, got %v then %v", first, names)
+		}
+	}
+}
+
+func TestHandleToolsList_Pagination(t *testing.T) {
+	s := newTestServer(t)
+	s.toolsPageSize = 10
+
+	all := make([]string, 0, len(s.tools))
+	for name := range s.tools {
+		all = append(all, name)
+	}
+	sort.Strings(all)
+
+	var listed []string
+	var params interface{}
+	for pages := 0; ; pages++ {
+		if pages > len(all) {
+			t.Fatal("pagination did not terminate")
+		}
+		names, cursor := listToolNames(t, s, params)
+		if len(names) > s.toolsPageSize {
+			t.Fatalf("expected at most %d tools per page, got %d", s.toolsPageSize, len(names))
+		}
+		listed = append(listed, names...)
+		if cursor == nil {
+			break
+		}
+		params = map[string]interface{}{"cursor": cursor}
+	}
+
+	if !reflect.DeepEqual(listed, all) {
+		t.Errorf("expected pages to cover every tool in order, got %v", listed)
+	}
+
+	_, err := s.handleMessage(context.Background(), &mcp.Message{
+		Jsonrpc: "2.0",
+		ID:      2,
+		Method:  "tools/list",
+		Params:  map[string]interface{}{"cursor": "no_such_tool"},
+	})
+	var paramsErr *invalidParamsError
+	if !errors.As(err, &paramsErr) {
+		t.Errorf("expected an invalid params error for an unknown cursor, got %v", err)
+	}
+}
+
+func TestRegisterTools_RawRequestRequiresFlag(t *testing.T) {
+	t.Setenv("ENABLE_RAW_REQUESTS", "")
+	if _, ok := newTestServer(t).tools["incidentio_raw_request"]; ok {
+		t.Error("expected incidentio_raw_request to be hidden unless ENABLE_RAW_REQUESTS is set")
+	}
+
+	t.Setenv("ENABLE_RAW_REQUESTS", "true")
+	if _, ok := newTestServer(t).tools["incidentio_raw_request"]; !ok {
+		t.Error("expected incidentio_raw_request to be registered when ENABLE_RAW_REQUESTS is set")
+	}
+}
+
+// descriptionExamples returns the JSON arguments in the EXAMPLES section of a
+// tool description: each object on an example line, such as
+// `- Find an incident: {"id": "INC-123"}`
+func descriptionExamples(description string) []string {
+	var examples []string
+	inExamples := false
+	for _, line := range strings.Split(description, "\n") {
+		switch {
+		case strings.TrimSpace(line) == "EXAMPLES:":
+			inExamples = true
+			continue
+		case strings.TrimSpace(line) == "":
+			inExamples = false
+		}
+		if !inExamples || !strings.HasPrefix(line, "- ") {
+			continue
+		}
+
+		// Pick out each top-level {...} on the line
+		depth, start := 0, -1
+		for i, r := range line {
+			switch r {
+			case '{':
+				if depth == 0 {
+					start = i
+				}
+				depth++
+			case '}':
+				depth--
+				if depth == 0 && start >= 0 {
+					examples = append(examples, line[start:i+1])
+				}
+			}
+		}
+	}
+	return examples
+}
+
+func TestToolDescriptions_ExamplesMatchInputSchema(t *testing.T) {
+	t.Setenv("ENABLE_RAW_REQUESTS", "true")
+	s := newTestServer(t)
+
+	checked := 0
+	for name, tool := range s.tools {
+		for _, example := range descriptionExamples(tool.Description()) {
+			// Abbreviated examples such as "conditions": [...] aren't
+			// complete arguments
+			if strings.Contains(example, "[...]") {
+				continue
+			}
+			var args map[string]interface{}
+			if err := json.Unmarshal([]byte(example), &args); err != nil {
+				t.Errorf("%s: example %s is not valid JSON: %v", name, example, err)
+				continue
+			}
+			// dry_run is taken out before validation, as in handleToolCall
+			tools.TakeDryRunArgument(args)
+			if err := tools.ValidateArguments(tool.InputSchema(), args); err != nil {
+				t.Errorf("%s: example %s is rejected: %v", name, example, err)
+			}
+			checked++
+		}
+	}
+	if checked == 0 {
+		t.Fatal("expected tool descriptions to contain examples")
+	}
+}
+
+// progressTool is a stub tool that reports progress over three steps
+type progressTool struct{}
+
+func (progressTool) Name() string        { return "progress" }
+func (progressTool) Description() string { return "Reports progress" }
+func (progressTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{"type": "object"}
+}
+func (progressTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	for i := 1; i <= 2; i++ {
+		tools.ReportProgress(ctx, i, 3, fmt.Sprintf("Fetched %d of 3 pages", i))
+	}
+	return "done", nil
+}
+
+func TestServe_ProgressNotifications(t *testing.T) {
+	s := New()
+	s.tools["progress"] = progressTool{}
+
+	input := strings.Join([]string{
+		`{"jsonrpc": "2.0", "id": 1, "method": "tools/call", "params": {"name": "progress", "_meta": {"progressToken": "tok-1"}}}`,
+		`{"jsonrpc": "2.0", "id": 2, "method": "tools/call", "params": {"name": "progress"}}`,
+	}, "\n") + "\n"
+
+	var output bytes.Buffer
+	if err := s.serve(context.Background(), strings.NewReader(input), &output); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var messages []map[string]interface{}
+	decoder := json.NewDecoder(&output)
+	for decoder.More() {
+		var message map[string]interface{}
+		if err := decoder.Decode(&message); err != nil {
+			t.Fatalf("failed to decode message: %v", err)
+		}
+		messages = append(messages, message)
+	}
+
+	// Progress comes before the response, and only when a token was given
+	if len(messages) != 4 {
+		t.Fatalf("expected 2 notifications and 2 responses, got %d: %s", len(messages), output.String())
+	}
+	for i, progress := range []float64{1, 2} {
+		notification := messages[i]
+		if notification["method"] != "notifications/progress" || notification["id"] != nil {
+			t.Fatalf("expected message %d to be a progress notification, got %v", i, notification)
+		}
+		expected := map[string]interface{}{
+			"progressToken": "tok-1",
+			"progress":      progress,
+			"total":         float64(3),
+			"message":       fmt.Sprintf("Fetched %d of 3 pages", int(progress)),
+		}
+		if !reflect.DeepEqual(notification["params"], expected) {
+			t.Errorf("expected params %v, got %v", expected, notification["params"])
+		}
+	}
+	if messages[2]["id"] != float64(1) || messages[3]["id"] != float64(2) {
+		t.Errorf("expected the responses to follow the notifications, got %v and %v", messages[2], messages[3])
+	}
+}
+
+func TestDegradedMode_ToolsExplainMissingClient(t *testing.T) {
+	t.Setenv("INCIDENT_IO_API_KEY", "")
+	t.Setenv("INCIDENT_IO_API_KEY_FILE", "")
+
+	s := New()
+	s.registerTools()
+
+	input := strings.Join([]string{
+		`{"jsonrpc": "2.0", "id": 1, "method": "tools/call", "params": {"name": "list_incidents", "arguments": {"status": "live", "page_size": 5}}}`,
+		`{"jsonrpc": "2.0", "id": 2, "method": "tools/call", "params": {"name": "list_workflows"}}`,
+	}, "\n") + "\n"
+	var output bytes.Buffer
+	if err := s.serve(context.Background(), strings.NewReader(input), &output); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var responses []mcp.Message
+	decoder := json.NewDecoder(&output)
+	for decoder.More() {
+		var response mcp.Message
+		if err := decoder.Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		responses = append(responses, response)
+	}
+	if len(responses) != 2 {
+		t.Fatalf("expected 2 responses, got: %s", output.String())
+	}
+
+	// A stubbed tool explains how to fix the problem
+	stubbed := responses[0].Error
+	if stubbed == nil || !strings.Contains(stubbed.Message, "incident.io client not configured: set INCIDENT_IO_API_KEY") ||
+		!strings.Contains(stubbed.Message, reloadClientToolName) {
+		t.Errorf("expected an actionable error for list_incidents, got: %+v", responses[0])
+	}
+
+	// Tools without a stub are still not found, with the same hint
+	missing := responses[1].Error
+	if missing == nil || missing.Code != -32602 || !strings.Contains(missing.Message, "Tool not found: list_workflows") ||
+		!strings.Contains(missing.Message, "incident.io client not configured") {
+		t.Errorf("expected a not found error with the degraded mode hint, got: %+v", responses[1])
+	}
+}
+
+func TestReloadClient_KeepsSessionAPIKey(t *testing.T) {
+	var mu sync.Mutex
+	seen := map[string]int{}
+	s := newTestServerWithAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		seen[r.Header.Get("Authorization")]++
+		mu.Unlock()
+
+		switch r.URL.Path {
+		case "/users":
+			fmt.Fprint(w, `{"users": []}`)
+		case "/alerts":
+			fmt.Fprint(w, `{"alerts": [], "pagination_meta": {}}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	response, err := s.handleMessage(context.Background(), &mcp.Message{
+		Jsonrpc: "2.0",
+		ID:      1,
+		Method:  "initialize",
+		Params:  map[string]interface{}{"protocolVersion": "2024-11-05", sessionAPIKeyParam: "session-key"},
+	})
+	if err != nil || response.Error != nil {
+		t.Fatalf("initialize failed: response %+v, error %v", response, err)
+	}
+
+	for i, name := range []string{reloadClientToolName, "list_alerts"} {
+		response, err := s.handleMessage(context.Background(), toolCallMessage(i+2, name, nil))
+		if err != nil || response.Error != nil {
+			t.Fatalf("%s failed: response %+v, error %v", name, response, err)
+		}
+	}
+
+	// The reload's test request and the calls after it use the session's
+	// key, never INCIDENT_IO_API_KEY
+	if len(seen) != 1 || seen["Bearer session-key"] != 2 {
+		t.Errorf("expected both requests to use the session key, got %v", seen)
+	}
+}
+
+func TestClientMetricsTool_CountsRequests(t *testing.T) {
+	s := newTestServerWithAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/alerts":
+			fmt.Fprint(w, `{"alerts": [], "pagination_meta": {}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"type": "not_found", "status": 404}`)
+		}
+	})
+
+	for i := 1; i <= 2; i++ {
+		if _, err := s.handleMessage(context.Background(), toolCallMessage(i, "list_alerts", nil)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if _, err := s.handleMessage(context.Background(), toolCallMessage(3, "get_alert", map[string]interface{}{"id": "alert_1"})); err == nil {
+		t.Fatal("expected get_alert to fail")
+	}
+
+	response, err := s.handleMessage(context.Background(), toolCallMessage(4, clientMetricsToolName, nil))
+	if err != nil || response.Error != nil {
+		t.Fatalf("%s failed: response %+v, error %v", clientMetricsToolName, response, err)
+	}
+	content := response.Result.(map[string]interface{})["content"].([]map[string]interface{})
+	var snapshot incidentio.MetricsSnapshot
+	if err := json.Unmarshal([]byte(content[0]["text"].(string)), &snapshot); err != nil {
+		t.Fatalf("failed to parse metrics: %v", err)
+	}
+
+	expected := incidentio.MetricsSnapshot{
+		Requests: map[string]int64{"GET /alerts": 2, "GET /alerts/{id}": 1},
+		Errors:   map[string]map[string]int64{"GET /alerts/{id}": {"404": 1}},
+		Retries:  map[string]int64{},
+	}
+	if !reflect.DeepEqual(snapshot, expected) {
+		t.Errorf("expected metrics %+v, got %+v", expected, snapshot)
+	}
+}
+
+func TestReloadClient_FailureKeepsWorkingClient(t *testing.T) {
+	s := newTestServerWithAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"alerts": [], "pagination_meta": {}}`)
+	})
+
+	// The key goes missing, so the reload cannot create a client
+	t.Setenv("INCIDENT_IO_API_KEY", "")
+	t.Setenv("INCIDENT_IO_API_KEY_FILE", "")
+	if _, err := s.handleMessage(context.Background(), toolCallMessage(1, reloadClientToolName, nil)); err == nil {
+		t.Fatal("expected the reload to fail")
+	}
+
+	if info := initializeServerInfo(t, s); info["degraded"] != false {
+		t.Errorf("expected degraded=false after a failed reload, got %v", info["degraded"])
+	}
+	response, err := s.handleMessage(context.Background(), toolCallMessage(2, "list_alerts", nil))
+	if err != nil || response.Error != nil {
+		t.Errorf("expected the existing client to keep working, got response %+v, error %v", response, err)
+	}
+}
+
+func TestReloadClient_RecoversFromDegradedMode(t *testing.T) {
+	t.Setenv("INCIDENT_IO_API_KEY", "")
+	t.Setenv("INCIDENT_IO_API_KEY_FILE", "")
+
+	s := New()
+	s.registerTools()
+	expected := append([]string{reloadClientToolName}, degradedToolNames...)
+	sort.Strings(expected)
+	if names, _ := listToolNames(t, s, nil); !reflect.DeepEqual(names, expected) {
+		t.Fatalf("expected only %s and the degraded mode stubs, got %v", reloadClientToolName, names)
+	}
+
+	// Reloading without a key keeps the server degraded
+	input := `{"jsonrpc": "2.0", "id": 1, "method": "tools/call", "params": {"name": "reload_incidentio_client"}}` + "\n"
+	var output bytes.Buffer
+	if err := s.serve(context.Background(), strings.NewReader(input), &output); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(output.String(), "INCIDENT_IO_API_KEY") || strings.Contains(output.String(), "list_changed") {
+		t.Fatalf("expected reloading without a key to fail, got: %s", output.String())
+	}
+
+	// Once a key is available the full toolset comes back
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users" || r.Header.Get("Authorization") != "Bearer fixed-key" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Write([]byte(`{"users": []}`))
+	}))
+	defer api.Close()
+	t.Setenv("INCIDENT_IO_API_KEY", "fixed-key")
+	t.Setenv("INCIDENT_IO_BASE_URL", api.URL)
+
+	output.Reset()
+	if err := s.serve(context.Background(), strings.NewReader(input), &output); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var messages []mcp.Message
+	decoder := json.NewDecoder(&output)
+	for decoder.More() {
+		var message mcp.Message
+		if err := decoder.Decode(&message); err != nil {
+			t.Fatalf("failed to decode message: %v", err)
+		}
+		messages = append(messages, message)
+	}
+	if len(messages) != 2 || messages[0].Method != "notifications/tools/list_changed" {
+		t.Fatalf("expected a list_changed notification then the response, got: %s", output.String())
+	}
+	if messages[1].Error != nil {
+		t.Fatalf("expected the reload to succeed, got: %+v", messages[1].Error)
+	}
+
+	names, _ := listToolNames(t, s, nil)
+	if len(names) < 10 || !sort.StringsAreSorted(names) {
+		t.Fatalf("expected the full toolset after reloading, got %v", names)
+	}
+	// Every degraded mode stub is replaced by the real tool
+	for _, name := range append([]string{reloadClientToolName}, degradedToolNames...) {
+		if _, ok := s.tools[name]; !ok {
+			t.Errorf("expected %s to be registered after reloading", name)
+		} else if _, stub := s.tools[name].(*degradedTool); stub {
+			t.Errorf("expected %s to be the real tool after reloading", name)
+		}
+	}
+	if info := initializeServerInfo(t, s); info["degraded"] != false {
+		t.Errorf("expected the server to leave degraded mode, got %v", info)
+	}
+}
@@ -1,8 +1,10 @@
 package tools
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
 )
@@ -31,13 +33,16 @@ USAGE WORKFLOW:
 
 PARAMETERS:
 - page_size: Number of results (default 25, max 250). Set to 0 or omit for auto-pagination.
-- incident_id: Filter actions by specific incident ID
-- status: Array of status values (outstanding, completed, deleted) - Multiple values match any (OR logic)
+- incident_id: Filter actions by incident. Accepts an incident ID, reference (INC-123) or Slack channel
+- status: Array or comma-separated string of statuses (outstanding, completed, deleted; "open" means outstanding) - Multiple values match any (OR logic)
+- output_format: "json" (default) or "csv". csv returns a header row plus one row per action, with the fields
+  as columns (default: id, incident_id, status, description, assignee.name, created_at, completed_at)
 
 EXAMPLES:
 - List all outstanding actions: {"status": ["outstanding"]}
-- List actions for incident: {"incident_id": "01HXYZ..."}
-- List outstanding actions for incident: {"incident_id": "01HXYZ...", "status": ["outstanding"]}`
+- List actions for incident: {"incident_id": "INC-123"}
+- List open actions for incident: {"incident_id": "01HXYZ...", "status": "open"}
+- Outstanding actions for a spreadsheet: {"status": "outstanding", "output_format": "csv"}`
 }
 
 func (t *ListActionsTool) InputSchema() map[string]interface{} {
@@ -51,47 +56,108 @@ func (t *ListActionsTool) InputSchema() map[string]interface{} {
 			},
 			"incident_id": map[string]interface{}{
 				"type":        "string",
-				"description": "Filter actions by incident ID",
+				"description": "Filter actions by incident ID, reference (INC-123) or Slack channel",
 			},
 			"status": map[string]interface{}{
-				"type":        "array",
+				"type":        []string{"array", "string"},
 				"items":       map[string]interface{}{"type": "string"},
-				"description": "Filter by action status (outstanding, completed, deleted)",
+				"description": "Filter by action status (outstanding, completed, deleted; \"open\" means outstanding). Accepts an array or a comma-separated string. Multiple values match any of them (OR logic).",
 			},
+			"fields":        fieldsProperty(),
+			"output_format": outputFormatProperty(),
 		},
 	}
 }
 
-func (t *ListActionsTool) Execute(args map[string]interface{}) (string, error) {
+// defaultActionCSVColumns are the columns list_actions writes as CSV when no
+// fields are given
+var defaultActionCSVColumns = []string{"id", "incident_id", "status", "description", "assignee.name", "created_at", "completed_at"}
+
+func (t *ListActionsTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	outputFormat, err := parseOutputFormat(args)
+	if err != nil {
+		return "", err
+	}
+
 	opts := &incidentio.ListActionsOptions{}
 
 	if pageSize, ok := args["page_size"].(float64); ok {
 		opts.PageSize = int(pageSize)
 	}
 
-	if incidentID, ok := args["incident_id"].(string); ok {
-		opts.IncidentID = incidentID
+	if status, ok := args["status"]; ok {
+		statuses, err := parseActionStatuses(status)
+		if err != nil {
+			return "", err
+		}
+		opts.Status = statuses
 	}
 
-	if statuses, ok := args["status"].([]interface{}); ok {
-		for _, s := range statuses {
-			if str, ok := s.(string); ok {
-				opts.Status = append(opts.Status, str)
-			}
+	if identifier, ok := args["incident_id"].(string); ok && identifier != "" {
+		incidentID, err := resolveIncidentID(ctx, t.client, identifier)
+		if err != nil {
+			return "", err
 		}
+		opts.IncidentID = incidentID
 	}
 
-	resp, err := t.client.ListActions(opts)
+	resp, err := t.client.ListActions(ctx, opts)
 	if err != nil {
 		return "", err
 	}
 
-	result, err := json.MarshalIndent(resp, "", "  ")
-	if err != nil {
-		return "", fmt.Errorf("failed to format response: %w", err)
+	if outputFormat == "csv" {
+		fieldsStr, _ := args["fields"].(string)
+		return formatCSVResult(resp, "actions", fieldsStr, defaultActionCSVColumns)
 	}
+	return formatJSONResult(resp, args)
+}
 
-	return string(result), nil
+// actionStatuses are the statuses an action can have
+var actionStatuses = []string{"outstanding", "completed", "deleted"}
+
+// actionStatusAliases maps other common names onto action statuses
+var actionStatusAliases = map[string]string{"open": "outstanding"}
+
+func isActionStatus(status string) bool {
+	for _, s := range actionStatuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// parseActionStatuses reads the status filter, given as an array or a
+// comma-separated string, into valid action statuses
+func parseActionStatuses(raw interface{}) ([]string, error) {
+	var inputs []string
+	switch v := raw.(type) {
+	case []interface{}:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				inputs = append(inputs, s)
+			}
+		}
+	case string:
+		inputs = strings.Split(v, ",")
+	}
+
+	var statuses []string
+	for _, input := range inputs {
+		status := strings.ToLower(strings.TrimSpace(input))
+		if status == "" {
+			continue
+		}
+		if alias, ok := actionStatusAliases[status]; ok {
+			status = alias
+		}
+		if !isActionStatus(status) {
+			return nil, fmt.Errorf("invalid action status '%s'. Valid statuses: %s (or \"open\" for outstanding)", input, strings.Join(actionStatuses, ", "))
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
 }
 
 // GetActionTool retrieves a specific action
@@ -130,18 +196,173 @@ func (t *GetActionTool) InputSchema() map[string]interface{} {
 				"type":        "string",
 				"description": "The action ID",
 			},
+			"fields": fieldsProperty(),
 		},
 		"required": []string{"id"},
 	}
 }
 
-func (t *GetActionTool) Execute(args map[string]interface{}) (string, error) {
+func (t *GetActionTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
 	id, ok := args["id"].(string)
 	if !ok {
 		return "", fmt.Errorf("id parameter is required")
 	}
 
-	action, err := t.client.GetAction(id)
+	action, err := t.client.GetAction(ctx, id)
+	if err != nil {
+		return "", err
+	}
+
+	return formatJSONResult(action, args)
+}
+
+// UpdateActionTool updates an existing action
+type UpdateActionTool struct {
+	client *incidentio.Client
+}
+
+func NewUpdateActionTool(client *incidentio.Client) *UpdateActionTool {
+	return &UpdateActionTool{client: client}
+}
+
+func (t *UpdateActionTool) Name() string {
+	return "update_action"
+}
+
+func (t *UpdateActionTool) Description() string {
+	return `Update an action's status, description or assignee.
+
+USAGE WORKFLOW:
+1. Get action ID from list_actions
+2. Optionally get the new assignee's user ID from list_users
+3. Provide only the fields you want to change
+
+PARAMETERS:
+- id: Required. The action ID to update
+- status: New status (outstanding, completed, deleted)
+- description: New description
+- assignee_id: User ID of the new assignee
+At least one of status, description or assignee_id is required.
+
+EXAMPLES:
+- Reassign: {"id": "01HACT...", "assignee_id": "01HUSER..."}
+- Reopen: {"id": "01HACT...", "status": "outstanding"}`
+}
+
+func (t *UpdateActionTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"id": map[string]interface{}{
+				"type":        "string",
+				"description": "The action ID to update",
+			},
+			"status": map[string]interface{}{
+				"type":        "string",
+				"description": "Update the action status",
+				"enum":        actionStatuses,
+			},
+			"description": map[string]interface{}{
+				"type":        "string",
+				"description": "Update the action description",
+			},
+			"assignee_id": map[string]interface{}{
+				"type":        "string",
+				"description": "User ID of the new assignee (get from list_users)",
+			},
+		},
+		"required":             []interface{}{"id"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *UpdateActionTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	id, ok := args["id"].(string)
+	if !ok || id == "" {
+		return "", fmt.Errorf("id parameter is required")
+	}
+
+	req := &incidentio.UpdateActionRequest{}
+	hasUpdate := false
+
+	if status, ok := args["status"].(string); ok && status != "" {
+		req.Status = status
+		hasUpdate = true
+	}
+	if description, ok := args["description"].(string); ok && description != "" {
+		req.Description = description
+		hasUpdate = true
+	}
+	if assigneeID, ok := args["assignee_id"].(string); ok && assigneeID != "" {
+		req.AssigneeID = assigneeID
+		hasUpdate = true
+	}
+
+	if !hasUpdate {
+		return "", fmt.Errorf("at least one field to update must be provided")
+	}
+
+	action, err := t.client.UpdateAction(ctx, id, req)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := json.MarshalIndent(action, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to format response: %w", err)
+	}
+
+	return string(result), nil
+}
+
+// CompleteActionTool marks an action as completed
+type CompleteActionTool struct {
+	client *incidentio.Client
+}
+
+func NewCompleteActionTool(client *incidentio.Client) *CompleteActionTool {
+	return &CompleteActionTool{client: client}
+}
+
+func (t *CompleteActionTool) Name() string {
+	return "complete_action"
+}
+
+func (t *CompleteActionTool) Description() string {
+	return `Mark an action as completed and record when it was completed.
+
+USAGE WORKFLOW:
+1. Get action ID from list_actions (e.g. {"status": "open"})
+2. Call this tool once the action is done
+
+PARAMETERS:
+- id: Required. The action ID to complete
+
+EXAMPLES:
+- Complete: {"id": "01HACT..."}`
+}
+
+func (t *CompleteActionTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"id": map[string]interface{}{
+				"type":        "string",
+				"description": "The action ID to complete",
+			},
+		},
+		"required":             []interface{}{"id"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *CompleteActionTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	id, ok := args["id"].(string)
+	if !ok || id == "" {
+		return "", fmt.Errorf("id parameter is required")
+	}
+
+	action, err := t.client.CompleteAction(ctx, id)
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,188 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+// newActionsTestClient serves two actions for one incident and records the
+// query of every list request
+func newActionsTestClient(t *testing.T, queries *[]map[string][]string) *ListActionsTool {
+	t.Helper()
+
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/incidents/123":
+			fmt.Fprint(w, `{"incident": {"id": "01HXYZINCIDENT00000000000", "reference": "INC-123"}}`)
+		case "/actions":
+			*queries = append(*queries, r.URL.Query())
+			fmt.Fprint(w, `{"actions": [
+				{"id": "act_1", "incident_id": "01HXYZINCIDENT00000000000", "status": "outstanding", "description": "Roll back the deploy"},
+				{"id": "act_2", "incident_id": "01HXYZINCIDENT00000000000", "status": "completed", "description": "Page the database team"}
+			], "pagination_meta": {"page_size": 250}}`)
+		default:
+			t.Errorf("unexpected request: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	return NewListActionsTool(client)
+}
+
+func actionIDs(t *testing.T, result string) []string {
+	t.Helper()
+
+	var response struct {
+		Actions []struct {
+			ID string `json:"id"`
+		} `json:"actions"`
+	}
+	if err := json.Unmarshal([]byte(result), &response); err != nil {
+		t.Fatalf("failed to parse result: %v", err)
+	}
+	ids := []string{}
+	for _, action := range response.Actions {
+		ids = append(ids, action.ID)
+	}
+	return ids
+}
+
+func TestListActionsTool_Filters(t *testing.T) {
+	t.Run("resolves an incident reference", func(t *testing.T) {
+		var queries []map[string][]string
+		tool := newActionsTestClient(t, &queries)
+
+		result, err := tool.Execute(context.Background(), map[string]interface{}{"incident_id": "INC-123"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(queries) != 1 || !reflect.DeepEqual(queries[0]["incident_id"], []string{"01HXYZINCIDENT00000000000"}) {
+			t.Errorf("expected actions to be listed for the resolved incident ID, got queries %v", queries)
+		}
+		if ids := actionIDs(t, result); !reflect.DeepEqual(ids, []string{"act_1", "act_2"}) {
+			t.Errorf("expected both actions, got %v", ids)
+		}
+	})
+
+	t.Run("returns only open actions", func(t *testing.T) {
+		var queries []map[string][]string
+		tool := newActionsTestClient(t, &queries)
+
+		result, err := tool.Execute(context.Background(), map[string]interface{}{"status": "open"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(queries) != 1 || !reflect.DeepEqual(queries[0]["status"], []string{"outstanding"}) {
+			t.Errorf("expected status=outstanding to be sent, got queries %v", queries)
+		}
+		if ids := actionIDs(t, result); !reflect.DeepEqual(ids, []string{"act_1"}) {
+			t.Errorf("expected only the outstanding action, got %v", ids)
+		}
+	})
+
+	t.Run("matches any of several statuses", func(t *testing.T) {
+		var queries []map[string][]string
+		tool := newActionsTestClient(t, &queries)
+
+		result, err := tool.Execute(context.Background(), map[string]interface{}{"status": []interface{}{"outstanding", "completed"}})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if ids := actionIDs(t, result); !reflect.DeepEqual(ids, []string{"act_1", "act_2"}) {
+			t.Errorf("expected both actions, got %v", ids)
+		}
+	})
+
+	t.Run("rejects an unknown status", func(t *testing.T) {
+		var queries []map[string][]string
+		tool := newActionsTestClient(t, &queries)
+
+		_, err := tool.Execute(context.Background(), map[string]interface{}{"status": []interface{}{"pending"}})
+		if err == nil || !strings.Contains(err.Error(), "invalid action status 'pending'") {
+			t.Fatalf("expected invalid status error, got: %v", err)
+		}
+		if len(queries) != 0 {
+			t.Errorf("expected no list requests, got %v", queries)
+		}
+	})
+}
+
+func TestCompleteActionTool_Execute(t *testing.T) {
+	var body map[string]interface{}
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/actions/act_1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode request body: %v", err)
+		}
+
+		fmt.Fprintf(w, `{"action": {"id": "act_1", "description": "Roll back the deploy", "status": %q, "completed_at": %q}}`, body["status"], body["completed_at"])
+	})
+
+	result, err := NewCompleteActionTool(client).Execute(context.Background(), map[string]interface{}{"id": "act_1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if body["status"] != "completed" {
+		t.Errorf("expected status=completed in request body, got %v", body["status"])
+	}
+	if completedAt, ok := body["completed_at"].(string); !ok || completedAt == "" {
+		t.Errorf("expected completed_at in request body, got %v", body["completed_at"])
+	}
+
+	var action incidentio.Action
+	if err := json.Unmarshal([]byte(result), &action); err != nil {
+		t.Fatalf("failed to parse result: %v", err)
+	}
+	if action.Status != "completed" || action.CompletedAt == nil {
+		t.Errorf("expected completed action with completed_at, got %+v", action)
+	}
+}
+
+func TestUpdateActionTool_Execute(t *testing.T) {
+	t.Run("rejects update with no fields", func(t *testing.T) {
+		requests := 0
+		client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+			requests++
+		})
+
+		_, err := NewUpdateActionTool(client).Execute(context.Background(), map[string]interface{}{"id": "act_1"})
+		if err == nil || !strings.Contains(err.Error(), "at least one field") {
+			t.Fatalf("expected at least one field error, got %v", err)
+		}
+		if requests != 0 {
+			t.Errorf("expected no API requests, got %d", requests)
+		}
+	})
+
+	t.Run("sends only the changed fields", func(t *testing.T) {
+		var body map[string]interface{}
+		client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPatch || r.URL.Path != "/actions/act_1" {
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode request body: %v", err)
+			}
+			fmt.Fprint(w, `{"action": {"id": "act_1", "status": "outstanding", "assignee": {"id": "user_2"}}}`)
+		})
+
+		_, err := NewUpdateActionTool(client).Execute(context.Background(), map[string]interface{}{"id": "act_1", "assignee_id": "user_2"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(body) != 1 || body["assignee_id"] != "user_2" {
+			t.Errorf("expected only assignee_id in request body, got %v", body)
+		}
+	})
+}
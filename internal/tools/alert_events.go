@@ -1,12 +1,26 @@
 package tools
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
 )
 
+// alertEventStatuses are the statuses accepted for an alert event
+var alertEventStatuses = []string{"firing", "resolved"}
+
+func isAlertEventStatus(status string) bool {
+	for _, allowed := range alertEventStatuses {
+		if status == allowed {
+			return true
+		}
+	}
+	return false
+}
+
 // CreateAlertEventTool creates alert events in incident.io
 type CreateAlertEventTool struct {
 	client *incidentio.Client
@@ -30,7 +44,7 @@ USAGE WORKFLOW:
 4. Alert will be routed according to configured alert routes
 
 PARAMETERS:
-- alert_source_id: Required. ID of the alert source (use list_alert_sources to find)
+- alert_source_id: Required. ID of the alert source (use list_alert_sources to find). alert_source_config_id is accepted as an alias
 - title: Required. Short title describing the alert
 - description: Optional. Detailed description of the alert
 - deduplication_key: Optional. Unique key to prevent duplicate alerts
@@ -40,7 +54,9 @@ PARAMETERS:
 EXAMPLES:
 - Create simple alert: {"alert_source_id": "01HXYZ...", "title": "API latency high"}
 - Create with deduplication: {"alert_source_id": "01HXYZ...", "title": "CPU threshold", "deduplication_key": "cpu-alert-123"}
-- Resolve existing alert: {"alert_source_id": "01HXYZ...", "title": "CPU threshold", "status": "resolved", "deduplication_key": "cpu-alert-123"}`
+- Resolve existing alert: {"alert_source_id": "01HXYZ...", "title": "CPU threshold", "status": "resolved", "deduplication_key": "cpu-alert-123"}
+
+Returns the created alert event, including its ID. Send a resolved event with the same deduplication_key to resolve a firing alert.`
 }
 
 func (t *CreateAlertEventTool) InputSchema() map[string]interface{} {
@@ -52,6 +68,10 @@ func (t *CreateAlertEventTool) InputSchema() map[string]interface{} {
 				"description": "ID of the alert source to send the event to",
 				"minLength":   1,
 			},
+			"alert_source_config_id": map[string]interface{}{
+				"type":        "string",
+				"description": "Alias for alert_source_id",
+			},
 			"title": map[string]interface{}{
 				"type":        "string",
 				"description": "Title of the alert event",
@@ -68,7 +88,7 @@ func (t *CreateAlertEventTool) InputSchema() map[string]interface{} {
 			"status": map[string]interface{}{
 				"type":        "string",
 				"description": "Status of the alert (firing or resolved)",
-				"enum":        []string{"firing", "resolved"},
+				"enum":        alertEventStatuses,
 				"default":     "firing",
 			},
 			"metadata": map[string]interface{}{
@@ -76,17 +96,20 @@ func (t *CreateAlertEventTool) InputSchema() map[string]interface{} {
 				"description": "Additional metadata for the alert",
 			},
 		},
-		"required":             []string{"alert_source_id", "title"},
+		"required":             []string{"title"},
 		"additionalProperties": false,
 	}
 }
 
-func (t *CreateAlertEventTool) Execute(args map[string]interface{}) (string, error) {
+func (t *CreateAlertEventTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
 	req := &incidentio.CreateAlertEventRequest{}
 
-	alertSourceID, ok := args["alert_source_id"].(string)
-	if !ok || alertSourceID == "" {
-		return "", fmt.Errorf("alert_source_id is required")
+	alertSourceID, _ := args["alert_source_id"].(string)
+	if alertSourceID == "" {
+		alertSourceID, _ = args["alert_source_config_id"].(string)
+	}
+	if alertSourceID == "" {
+		return "", fmt.Errorf("alert_source_id is required. Call list_alert_sources to find it, or get_alert_source to look a source up by name")
 	}
 	req.AlertSourceID = alertSourceID
 
@@ -104,22 +127,31 @@ func (t *CreateAlertEventTool) Execute(args map[string]interface{}) (string, err
 		req.DeduplicationKey = deduplicationKey
 	}
 
-	if status, ok := args["status"].(string); ok {
+	req.Status = "firing" // default
+	if status, ok := args["status"].(string); ok && status != "" {
+		if !isAlertEventStatus(status) {
+			return "", fmt.Errorf("invalid status '%s'. Allowed statuses: %s", status, strings.Join(alertEventStatuses, ", "))
+		}
 		req.Status = status
-	} else {
-		req.Status = "firing" // default
 	}
 
 	if metadata, ok := args["metadata"].(map[string]interface{}); ok {
 		req.Metadata = metadata
 	}
 
-	alertEvent, err := t.client.CreateAlertEvent(req)
+	alertEvent, err := t.client.CreateAlertEvent(ctx, req)
 	if err != nil {
+		if incidentio.IsNotFound(err) {
+			return "", fmt.Errorf("alert source %s was not found. Call list_alert_sources to see valid IDs, or get_alert_source to look one up by name: %w", alertSourceID, err)
+		}
 		return "", fmt.Errorf("failed to create alert event: %w", err)
 	}
 
-	output, err := json.MarshalIndent(alertEvent, "", "  ")
+	output, err := json.MarshalIndent(map[string]interface{}{
+		"message":        fmt.Sprintf("Created %s alert event %s", alertEvent.Status, alertEvent.ID),
+		"alert_event_id": alertEvent.ID,
+		"alert_event":    alertEvent,
+	}, "", "  ")
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal result: %w", err)
 	}
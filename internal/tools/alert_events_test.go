@@ -0,0 +1,149 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+// newAlertEventsTestClient echoes each alert event back with an ID and
+// records the request bodies it received
+func newAlertEventsTestClient(t *testing.T, received *[]incidentio.CreateAlertEventRequest) *incidentio.Client {
+	t.Helper()
+
+	return newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/alert_events/http" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		var req incidentio.CreateAlertEventRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode request body: %v", err)
+		}
+		*received = append(*received, req)
+
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"alert_event": incidentio.AlertEvent{
+				ID:               "ae_123",
+				AlertSourceID:    req.AlertSourceID,
+				DeduplicationKey: req.DeduplicationKey,
+				Status:           req.Status,
+				Title:            req.Title,
+			},
+		})
+	})
+}
+
+func TestCreateAlertEventTool_Execute(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     map[string]interface{}
+		expected incidentio.CreateAlertEventRequest
+	}{
+		{
+			name: "firing event with deduplication key",
+			args: map[string]interface{}{
+				"alert_source_id":   "as_123",
+				"title":             "API latency high",
+				"deduplication_key": "latency-api-prod",
+				"metadata":          map[string]interface{}{"service": "api"},
+			},
+			expected: incidentio.CreateAlertEventRequest{
+				AlertSourceID:    "as_123",
+				Title:            "API latency high",
+				DeduplicationKey: "latency-api-prod",
+				Status:           "firing",
+			},
+		},
+		{
+			name: "resolve event using alert_source_config_id",
+			args: map[string]interface{}{
+				"alert_source_config_id": "as_123",
+				"title":                  "API latency high",
+				"deduplication_key":      "latency-api-prod",
+				"status":                 "resolved",
+			},
+			expected: incidentio.CreateAlertEventRequest{
+				AlertSourceID:    "as_123",
+				Title:            "API latency high",
+				DeduplicationKey: "latency-api-prod",
+				Status:           "resolved",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var received []incidentio.CreateAlertEventRequest
+			tool := NewCreateAlertEventTool(newAlertEventsTestClient(t, &received))
+
+			result, err := tool.Execute(context.Background(), tt.args)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(received) != 1 {
+				t.Fatalf("expected 1 request, got %d", len(received))
+			}
+			got := received[0]
+			if got.AlertSourceID != tt.expected.AlertSourceID || got.Title != tt.expected.Title ||
+				got.DeduplicationKey != tt.expected.DeduplicationKey || got.Status != tt.expected.Status {
+				t.Errorf("expected request %+v, got %+v", tt.expected, got)
+			}
+
+			var response struct {
+				AlertEventID string `json:"alert_event_id"`
+			}
+			if err := json.Unmarshal([]byte(result), &response); err != nil {
+				t.Fatalf("failed to parse result: %v", err)
+			}
+			if response.AlertEventID != "ae_123" {
+				t.Errorf("expected alert_event_id ae_123, got %q", response.AlertEventID)
+			}
+		})
+	}
+}
+
+func TestCreateAlertEventTool_InvalidStatus(t *testing.T) {
+	var received []incidentio.CreateAlertEventRequest
+	tool := NewCreateAlertEventTool(newAlertEventsTestClient(t, &received))
+
+	_, err := tool.Execute(context.Background(), map[string]interface{}{
+		"alert_source_id": "as_123",
+		"title":           "API latency high",
+		"status":          "acknowledged",
+	})
+	if err == nil || !strings.Contains(err.Error(), "invalid status 'acknowledged'") || !strings.Contains(err.Error(), "firing, resolved") {
+		t.Fatalf("expected invalid status error, got: %v", err)
+	}
+	if len(received) != 0 {
+		t.Errorf("expected no requests, got %d", len(received))
+	}
+}
+
+func TestCreateAlertEventTool_UnknownAlertSource(t *testing.T) {
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"type":   "not_found",
+			"status": 404,
+			"errors": []map[string]interface{}{{"code": "not_found", "message": "Alert source not found"}},
+		})
+	})
+	tool := NewCreateAlertEventTool(client)
+
+	_, err := tool.Execute(context.Background(), map[string]interface{}{
+		"alert_source_id": "as_missing",
+		"title":           "API latency high",
+	})
+	if err == nil || !strings.Contains(err.Error(), "alert source as_missing was not found") || !strings.Contains(err.Error(), "list_alert_sources") {
+		t.Fatalf("expected alert source lookup hint, got: %v", err)
+	}
+}
@@ -1,8 +1,15 @@
 package tools
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
 )
@@ -51,12 +58,13 @@ func (t *ListAlertRoutesTool) InputSchema() map[string]interface{} {
 				"type":        "string",
 				"description": "Pagination cursor for next page",
 			},
+			"fields": fieldsProperty(),
 		},
 		"additionalProperties": false,
 	}
 }
 
-func (t *ListAlertRoutesTool) Execute(args map[string]interface{}) (string, error) {
+func (t *ListAlertRoutesTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
 	params := &incidentio.ListAlertRoutesParams{}
 
 	if pageSize, ok := args["page_size"].(float64); ok {
@@ -66,26 +74,139 @@ func (t *ListAlertRoutesTool) Execute(args map[string]interface{}) (string, erro
 		params.After = after
 	}
 
-	result, err := t.client.ListAlertRoutes(params)
+	result, err := t.client.ListAlertRoutes(ctx, params)
 	if err != nil {
 		return "", fmt.Errorf("failed to list alert routes: %w", err)
 	}
 
-	output, err := json.MarshalIndent(result, "", "  ")
-	if err != nil {
-		return "", fmt.Errorf("failed to marshal result: %w", err)
+	return formatJSONResult(result, args)
+}
+
+// alertRouteCacheTTL is how long the resolver trusts its cached route list
+// before listing the routes again
+const alertRouteCacheTTL = 60 * time.Second
+
+// alertRouteResolver resolves alert route names to IDs, caching the routes
+// so repeated lookups don't list every route again
+type alertRouteResolver struct {
+	client *incidentio.Client
+	now    func() time.Time
+
+	mu        sync.Mutex
+	ids       map[string]string
+	nameToIDs map[string][]string
+	names     []string
+	fetchedAt time.Time
+}
+
+func newAlertRouteResolver(client *incidentio.Client) *alertRouteResolver {
+	return &alertRouteResolver{client: client, now: time.Now}
+}
+
+// resolve returns the alert route ID for an identifier, which can be either an
+// alert route ID or a route name (case-insensitive). Both are checked against
+// the listed routes, so an unknown ID fails here with the available routes. A
+// name shared by several routes is rejected rather than picking one of them.
+func (r *alertRouteResolver) resolve(ctx context.Context, identifier string) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := strings.ToLower(strings.TrimSpace(identifier))
+	fresh := r.ids != nil && r.now().Sub(r.fetchedAt) < alertRouteCacheTTL
+	if fresh {
+		if id, ok, err := r.lookup(identifier, key); ok || err != nil {
+			return id, err
+		}
 	}
 
-	return string(output), nil
+	// Cache miss or expired - the route may have been created, renamed or
+	// deleted since the last lookup, so refresh the routes before giving up
+	if err := r.refresh(ctx); err != nil {
+		return "", err
+	}
+	if id, ok, err := r.lookup(identifier, key); ok || err != nil {
+		return id, err
+	}
+
+	return "", fmt.Errorf("no alert route found with ID or name '%s'. Available routes: %s. Call list_alert_routes to see all routes", identifier, strings.Join(r.names, ", "))
+}
+
+// lookup finds key among the cached route IDs, then names. It returns false
+// if nothing matches, and an error if the name matches more than one route.
+// Callers must hold r.mu.
+func (r *alertRouteResolver) lookup(identifier, key string) (string, bool, error) {
+	if id, ok := r.ids[key]; ok {
+		return id, true, nil
+	}
+	switch ids := r.nameToIDs[key]; len(ids) {
+	case 0:
+		return "", false, nil
+	case 1:
+		return ids[0], true, nil
+	default:
+		return "", false, fmt.Errorf("ambiguous alert route name '%s': it matches routes %s. Use the ID of the route you mean", identifier, strings.Join(ids, ", "))
+	}
+}
+
+// invalidate drops the cached routes, for when the API reports that a
+// resolved route no longer exists
+func (r *alertRouteResolver) invalidate() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.ids = nil
+	r.nameToIDs = nil
+	r.names = nil
+}
+
+// refresh lists all alert routes and rebuilds the ID and name maps. Routes
+// that share a name are all kept under it. Callers must hold r.mu.
+func (r *alertRouteResolver) refresh(ctx context.Context) error {
+	ids := make(map[string]string)
+	nameToIDs := make(map[string][]string)
+	var names []string
+
+	params := &incidentio.ListAlertRoutesParams{PageSize: 250}
+	maxPages := 10 // Safety limit
+	guard := incidentio.NewPaginationGuard("alert routes", "")
+	for page := 0; page < maxPages; page++ {
+		resp, err := r.client.ListAlertRoutes(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to list alert routes for name lookup: %w", err)
+		}
+
+		for _, route := range resp.AlertRoutes {
+			ids[strings.ToLower(route.ID)] = route.ID
+			name := strings.ToLower(route.Name)
+			nameToIDs[name] = append(nameToIDs[name], route.ID)
+			names = append(names, fmt.Sprintf("%s (ID: %s)", route.Name, route.ID))
+		}
+
+		if resp.Pagination.After == "" || len(resp.AlertRoutes) == 0 {
+			break
+		}
+		if err := guard.Next(resp.Pagination.After, len(resp.AlertRoutes)); err != nil {
+			return err
+		}
+		params.After = resp.Pagination.After
+	}
+
+	sort.Strings(names)
+	r.ids = ids
+	r.nameToIDs = nameToIDs
+	r.names = names
+	r.fetchedAt = r.now()
+	return nil
 }
 
 // GetAlertRouteTool gets details of a specific alert route
 type GetAlertRouteTool struct {
-	client *incidentio.Client
+	client   *incidentio.Client
+	resolver *alertRouteResolver
 }
 
 func NewGetAlertRouteTool(client *incidentio.Client) *GetAlertRouteTool {
-	return &GetAlertRouteTool{client: client}
+	return &GetAlertRouteTool{client: client, resolver: newAlertRouteResolver(client)}
 }
 
 func (t *GetAlertRouteTool) Name() string {
@@ -96,15 +217,16 @@ func (t *GetAlertRouteTool) Description() string {
 	return `Get detailed configuration of a specific alert route.
 
 USAGE WORKFLOW:
-1. Get route ID from list_alert_routes
+1. Get route ID or name from list_alert_routes
 2. Call this tool for complete route configuration
 3. Review conditions, escalations, and grouping settings
 
 PARAMETERS:
-- id: Required. The alert route ID to retrieve
+- id: Required. The alert route ID or name to retrieve (names are matched case-insensitively)
 
 EXAMPLES:
-- Get route: {"id": "route_123"}`
+- Get route: {"id": "route_123"}
+- Get route by name: {"id": "Production"}`
 }
 
 func (t *GetAlertRouteTool) InputSchema() map[string]interface{} {
@@ -113,32 +235,36 @@ func (t *GetAlertRouteTool) InputSchema() map[string]interface{} {
 		"properties": map[string]interface{}{
 			"id": map[string]interface{}{
 				"type":        "string",
-				"description": "The alert route ID",
+				"description": "The alert route ID or name (case-insensitive)",
 				"minLength":   1,
 			},
+			"fields": fieldsProperty(),
 		},
 		"required":             []string{"id"},
 		"additionalProperties": false,
 	}
 }
 
-func (t *GetAlertRouteTool) Execute(args map[string]interface{}) (string, error) {
-	id, ok := args["id"].(string)
-	if !ok || id == "" {
+func (t *GetAlertRouteTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	identifier, ok := args["id"].(string)
+	if !ok || identifier == "" {
 		return "", fmt.Errorf("alert route ID is required")
 	}
 
-	alertRoute, err := t.client.GetAlertRoute(id)
+	id, err := t.resolver.resolve(ctx, identifier)
 	if err != nil {
-		return "", fmt.Errorf("failed to get alert route: %w", err)
+		return "", err
 	}
 
-	output, err := json.MarshalIndent(alertRoute, "", "  ")
+	alertRoute, err := t.client.GetAlertRoute(ctx, id)
 	if err != nil {
-		return "", fmt.Errorf("failed to marshal result: %w", err)
+		if incidentio.IsNotFound(err) {
+			t.resolver.invalidate()
+		}
+		return "", fmt.Errorf("failed to get alert route: %w", err)
 	}
 
-	return string(output), nil
+	return formatJSONResult(alertRoute, args)
 }
 
 // CreateAlertRouteTool creates a new alert route
@@ -166,8 +292,8 @@ USAGE WORKFLOW:
 PARAMETERS:
 - name: Required. Name for the alert route
 - enabled: Optional. Whether route is active (default: true)
-- conditions: Required. Array of condition objects with field, operation, value
-- escalations: Required. Array of escalation bindings with id and level
+- conditions: Required. Array of condition objects with field, operation, value. Operation is one of equals, not_equals, contains, not_contains, starts_with, ends_with
+- escalations: Required. Array of escalation bindings with id and level. Get escalation IDs from list_escalations
 - grouping_keys: Optional. Array of field names to group alerts by
 - template: Optional. Incident template for auto-creating incidents
 
@@ -202,7 +328,8 @@ func (t *CreateAlertRouteTool) InputSchema() map[string]interface{} {
 						},
 						"operation": map[string]interface{}{
 							"type":        "string",
-							"description": "Operation to perform (equals, contains, etc)",
+							"description": "Operation to perform",
+							"enum":        alertConditionOperations,
 						},
 						"value": map[string]interface{}{
 							"type":        "string",
@@ -225,6 +352,7 @@ func (t *CreateAlertRouteTool) InputSchema() map[string]interface{} {
 						"level": map[string]interface{}{
 							"type":        "integer",
 							"description": "Escalation level",
+							"minimum":     1,
 						},
 					},
 					"required": []string{"id", "level"},
@@ -247,7 +375,7 @@ func (t *CreateAlertRouteTool) InputSchema() map[string]interface{} {
 	}
 }
 
-func (t *CreateAlertRouteTool) Execute(args map[string]interface{}) (string, error) {
+func (t *CreateAlertRouteTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
 	req := &incidentio.CreateAlertRouteRequest{}
 
 	name, ok := args["name"].(string)
@@ -264,29 +392,20 @@ func (t *CreateAlertRouteTool) Execute(args map[string]interface{}) (string, err
 
 	// Parse conditions
 	if conditions, ok := args["conditions"].([]interface{}); ok {
-		for _, c := range conditions {
-			if cond, ok := c.(map[string]interface{}); ok {
-				condition := incidentio.AlertCondition{
-					Field:     cond["field"].(string),
-					Operation: cond["operation"].(string),
-					Value:     cond["value"].(string),
-				}
-				req.Conditions = append(req.Conditions, condition)
-			}
+		parsed, err := parseAlertConditions(conditions)
+		if err != nil {
+			return "", err
 		}
+		req.Conditions = parsed
 	}
 
 	// Parse escalations
 	if escalations, ok := args["escalations"].([]interface{}); ok {
-		for _, e := range escalations {
-			if esc, ok := e.(map[string]interface{}); ok {
-				escalation := incidentio.EscalationBinding{
-					ID:    esc["id"].(string),
-					Level: int(esc["level"].(float64)),
-				}
-				req.Escalations = append(req.Escalations, escalation)
-			}
+		parsed, err := parseEscalationBindings(escalations)
+		if err != nil {
+			return "", err
 		}
+		req.Escalations = parsed
 	}
 
 	// Parse grouping keys
@@ -303,9 +422,9 @@ func (t *CreateAlertRouteTool) Execute(args map[string]interface{}) (string, err
 		req.Template = template
 	}
 
-	alertRoute, err := t.client.CreateAlertRoute(req)
+	alertRoute, err := t.client.CreateAlertRoute(ctx, req)
 	if err != nil {
-		return "", fmt.Errorf("failed to create alert route: %w", err)
+		return "", fmt.Errorf("failed to create alert route: %w", withEscalationHint(err))
 	}
 
 	output, err := json.MarshalIndent(alertRoute, "", "  ")
@@ -318,11 +437,12 @@ func (t *CreateAlertRouteTool) Execute(args map[string]interface{}) (string, err
 
 // UpdateAlertRouteTool updates an alert route
 type UpdateAlertRouteTool struct {
-	client *incidentio.Client
+	client   *incidentio.Client
+	resolver *alertRouteResolver
 }
 
 func NewUpdateAlertRouteTool(client *incidentio.Client) *UpdateAlertRouteTool {
-	return &UpdateAlertRouteTool{client: client}
+	return &UpdateAlertRouteTool{client: client, resolver: newAlertRouteResolver(client)}
 }
 
 func (t *UpdateAlertRouteTool) Name() string {
@@ -338,16 +458,17 @@ USAGE WORKFLOW:
 3. Call update with route ID and new configuration
 
 PARAMETERS:
-- id: Required. The alert route ID to update
+- id: Required. The alert route ID or name to update (names are matched case-insensitively)
 - name: Optional. New name for the route
 - enabled: Optional. Enable or disable the route
 - conditions: Optional. New array of routing conditions
-- escalations: Optional. New array of escalation bindings
+- escalations: Optional. New array of escalation bindings (IDs from list_escalations)
 - grouping_keys: Optional. New array of grouping keys
 - template: Optional. New incident template
 
 EXAMPLES:
 - Disable route: {"id": "route_123", "enabled": false}
+- Disable route by name: {"id": "Production", "enabled": false}
 - Update conditions: {"id": "route_123", "conditions": [{"field": "severity", "operation": "equals", "value": "high"}]}`
 }
 
@@ -357,7 +478,7 @@ func (t *UpdateAlertRouteTool) InputSchema() map[string]interface{} {
 		"properties": map[string]interface{}{
 			"id": map[string]interface{}{
 				"type":        "string",
-				"description": "The alert route ID to update",
+				"description": "The alert route ID or name (case-insensitive) to update",
 				"minLength":   1,
 			},
 			"name": map[string]interface{}{
@@ -381,6 +502,7 @@ func (t *UpdateAlertRouteTool) InputSchema() map[string]interface{} {
 						"operation": map[string]interface{}{
 							"type":        "string",
 							"description": "Operation to perform",
+							"enum":        alertConditionOperations,
 						},
 						"value": map[string]interface{}{
 							"type":        "string",
@@ -403,6 +525,7 @@ func (t *UpdateAlertRouteTool) InputSchema() map[string]interface{} {
 						"level": map[string]interface{}{
 							"type":        "integer",
 							"description": "Escalation level",
+							"minimum":     1,
 						},
 					},
 					"required": []string{"id", "level"},
@@ -425,12 +548,17 @@ func (t *UpdateAlertRouteTool) InputSchema() map[string]interface{} {
 	}
 }
 
-func (t *UpdateAlertRouteTool) Execute(args map[string]interface{}) (string, error) {
-	id, ok := args["id"].(string)
-	if !ok || id == "" {
+func (t *UpdateAlertRouteTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	identifier, ok := args["id"].(string)
+	if !ok || identifier == "" {
 		return "", fmt.Errorf("alert route ID is required")
 	}
 
+	id, err := t.resolver.resolve(ctx, identifier)
+	if err != nil {
+		return "", err
+	}
+
 	req := &incidentio.UpdateAlertRouteRequest{}
 
 	if name, ok := args["name"].(string); ok {
@@ -443,31 +571,20 @@ func (t *UpdateAlertRouteTool) Execute(args map[string]interface{}) (string, err
 
 	// Parse conditions
 	if conditions, ok := args["conditions"].([]interface{}); ok {
-		req.Conditions = []incidentio.AlertCondition{}
-		for _, c := range conditions {
-			if cond, ok := c.(map[string]interface{}); ok {
-				condition := incidentio.AlertCondition{
-					Field:     cond["field"].(string),
-					Operation: cond["operation"].(string),
-					Value:     cond["value"].(string),
-				}
-				req.Conditions = append(req.Conditions, condition)
-			}
+		parsed, err := parseAlertConditions(conditions)
+		if err != nil {
+			return "", err
 		}
+		req.Conditions = parsed
 	}
 
 	// Parse escalations
 	if escalations, ok := args["escalations"].([]interface{}); ok {
-		req.Escalations = []incidentio.EscalationBinding{}
-		for _, e := range escalations {
-			if esc, ok := e.(map[string]interface{}); ok {
-				escalation := incidentio.EscalationBinding{
-					ID:    esc["id"].(string),
-					Level: int(esc["level"].(float64)),
-				}
-				req.Escalations = append(req.Escalations, escalation)
-			}
+		parsed, err := parseEscalationBindings(escalations)
+		if err != nil {
+			return "", err
 		}
+		req.Escalations = parsed
 	}
 
 	// Parse grouping keys
@@ -485,9 +602,12 @@ func (t *UpdateAlertRouteTool) Execute(args map[string]interface{}) (string, err
 		req.Template = template
 	}
 
-	alertRoute, err := t.client.UpdateAlertRoute(id, req)
+	alertRoute, err := t.client.UpdateAlertRoute(ctx, id, req)
 	if err != nil {
-		return "", fmt.Errorf("failed to update alert route: %w", err)
+		if incidentio.IsNotFound(err) {
+			t.resolver.invalidate()
+		}
+		return "", fmt.Errorf("failed to update alert route: %w", withEscalationHint(err))
 	}
 
 	output, err := json.MarshalIndent(alertRoute, "", "  ")
@@ -497,3 +617,168 @@ func (t *UpdateAlertRouteTool) Execute(args map[string]interface{}) (string, err
 
 	return string(output), nil
 }
+
+// DeleteAlertRouteTool deletes an alert route
+type DeleteAlertRouteTool struct {
+	client   *incidentio.Client
+	resolver *alertRouteResolver
+}
+
+func NewDeleteAlertRouteTool(client *incidentio.Client) *DeleteAlertRouteTool {
+	return &DeleteAlertRouteTool{client: client, resolver: newAlertRouteResolver(client)}
+}
+
+func (t *DeleteAlertRouteTool) Name() string {
+	return "delete_alert_route"
+}
+
+func (t *DeleteAlertRouteTool) Description() string {
+	return `Delete an alert route. This cannot be undone.
+
+USAGE WORKFLOW:
+1. Get route ID or name from list_alert_routes
+2. Check the route with get_alert_route before deleting it
+3. Call this tool with the route ID or name
+
+PARAMETERS:
+- id: Required. The alert route ID or name to delete (names are matched case-insensitively)
+
+EXAMPLES:
+- Delete route: {"id": "01HXYZ..."}
+- Delete route by name: {"id": "Staging experiment"}`
+}
+
+func (t *DeleteAlertRouteTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"id": map[string]interface{}{
+				"type":        "string",
+				"description": "The alert route ID or name (case-insensitive)",
+				"minLength":   1,
+			},
+		},
+		"required":             []string{"id"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *DeleteAlertRouteTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	identifier, ok := args["id"].(string)
+	if !ok || identifier == "" {
+		return "", fmt.Errorf("alert route ID is required")
+	}
+
+	id, err := t.resolver.resolve(ctx, identifier)
+	if err != nil {
+		return "", err
+	}
+
+	if err := t.client.DeleteAlertRoute(ctx, id); err != nil {
+		switch {
+		case incidentio.IsNotFound(err):
+			t.resolver.invalidate()
+			return "", fmt.Errorf("alert route not found: %s. Call list_alert_routes to see all routes", identifier)
+		case incidentio.IsForbidden(err):
+			return "", fmt.Errorf("not allowed to delete alert route %s: the API key needs permission to manage alert routes", identifier)
+		}
+		return "", fmt.Errorf("failed to delete alert route: %w", err)
+	}
+
+	// The deleted route must not resolve from the cache any more
+	t.resolver.invalidate()
+	return fmt.Sprintf("Alert route %s deleted successfully", id), nil
+}
+
+// alertConditionOperations are the operations accepted in alert route conditions
+var alertConditionOperations = []string{"equals", "not_equals", "contains", "not_contains", "starts_with", "ends_with"}
+
+func isAlertConditionOperation(operation string) bool {
+	for _, allowed := range alertConditionOperations {
+		if operation == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// parseAlertConditions converts condition arguments into API conditions,
+// rejecting entries with missing fields or unknown operations
+func parseAlertConditions(raw []interface{}) ([]incidentio.AlertCondition, error) {
+	conditions := make([]incidentio.AlertCondition, 0, len(raw))
+	for i, c := range raw {
+		cond, ok := c.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("condition %d must be an object with field, operation and value", i+1)
+		}
+
+		values := make(map[string]string, 3)
+		for _, key := range []string{"field", "operation", "value"} {
+			value, ok := cond[key].(string)
+			if !ok || value == "" {
+				return nil, fmt.Errorf("condition %d is missing '%s': each condition needs a field, operation and value", i+1, key)
+			}
+			values[key] = value
+		}
+
+		if !isAlertConditionOperation(values["operation"]) {
+			return nil, fmt.Errorf("condition %d has invalid operation '%s'. Allowed operations: %s",
+				i+1, values["operation"], strings.Join(alertConditionOperations, ", "))
+		}
+
+		conditions = append(conditions, incidentio.AlertCondition{
+			Field:     values["field"],
+			Operation: values["operation"],
+			Value:     values["value"],
+		})
+	}
+	return conditions, nil
+}
+
+// parseEscalationBindings converts escalation arguments into API bindings,
+// rejecting entries without an ID or a positive whole-number level
+func parseEscalationBindings(raw []interface{}) ([]incidentio.EscalationBinding, error) {
+	escalations := make([]incidentio.EscalationBinding, 0, len(raw))
+	for i, e := range raw {
+		esc, ok := e.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("escalation %d must be an object with id and level", i+1)
+		}
+
+		id, ok := esc["id"].(string)
+		if !ok || id == "" {
+			return nil, fmt.Errorf("escalation %d is missing 'id'", i+1)
+		}
+
+		level, ok := esc["level"].(float64)
+		if !ok || level < 1 || level != math.Trunc(level) {
+			return nil, fmt.Errorf("escalation %d has invalid level %v: level must be a whole number of at least 1", i+1, esc["level"])
+		}
+
+		escalations = append(escalations, incidentio.EscalationBinding{
+			ID:    id,
+			Level: int(level),
+		})
+	}
+	return escalations, nil
+}
+
+// withEscalationHint points the caller at list_escalations when the API
+// rejected a request because of an escalation binding
+func withEscalationHint(err error) error {
+	var apiErr *incidentio.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode >= 500 {
+		return err
+	}
+
+	mentionsEscalation := strings.Contains(strings.ToLower(apiErr.Error()), "escalation")
+	for _, fieldErr := range apiErr.Errors {
+		if strings.Contains(strings.ToLower(fieldErr.String()), "escalation") {
+			mentionsEscalation = true
+		}
+	}
+	if !mentionsEscalation {
+		return err
+	}
+	return fmt.Errorf("%w. Call list_escalations to see valid escalation IDs", err)
+}
@@ -0,0 +1,317 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+const alertRoutesListResponse = `{
+	"alert_routes": [
+		{"id": "ar_staging", "name": "Staging", "enabled": true},
+		{"id": "ar_production", "name": "Production", "enabled": true}
+	],
+	"pagination_info": {"page_size": 250}
+}`
+
+// newAlertRoutesTestClient serves a fixed set of alert routes and records how
+// many times the list endpoint was called
+func newAlertRoutesTestClient(t *testing.T, listCalls *int) *incidentio.Client {
+	t.Helper()
+
+	return newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/alert_routes":
+			*listCalls++
+			fmt.Fprint(w, alertRoutesListResponse)
+		case strings.HasPrefix(r.URL.Path, "/alert_routes/"):
+			id := strings.TrimPrefix(r.URL.Path, "/alert_routes/")
+			enabled := r.Method != http.MethodPatch
+			fmt.Fprintf(w, `{"alert_route": {"id": %q, "name": "Production", "enabled": %t}}`, id, enabled)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+}
+
+func TestGetAlertRouteTool_ResolvesByName(t *testing.T) {
+	listCalls := 0
+	tool := NewGetAlertRouteTool(newAlertRoutesTestClient(t, &listCalls))
+
+	result, err := tool.Execute(context.Background(), map[string]interface{}{"id": "production"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(result, `"id": "ar_production"`) {
+		t.Errorf("expected route ar_production in result, got: %s", result)
+	}
+
+	// A second lookup should be served from the cached name→ID map
+	if _, err := tool.Execute(context.Background(), map[string]interface{}{"id": "PRODUCTION"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if listCalls != 1 {
+		t.Errorf("expected alert routes to be listed once, got %d", listCalls)
+	}
+}
+
+func TestUpdateAlertRouteTool_ResolvesByName(t *testing.T) {
+	listCalls := 0
+	tool := NewUpdateAlertRouteTool(newAlertRoutesTestClient(t, &listCalls))
+
+	result, err := tool.Execute(context.Background(), map[string]interface{}{
+		"id":      "Production",
+		"enabled": false,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(result, `"id": "ar_production"`) {
+		t.Errorf("expected route ar_production in result, got: %s", result)
+	}
+	if !strings.Contains(result, `"enabled": false`) {
+		t.Errorf("expected route to be disabled, got: %s", result)
+	}
+}
+
+func TestGetAlertRouteTool_UnknownName(t *testing.T) {
+	listCalls := 0
+	tool := NewGetAlertRouteTool(newAlertRoutesTestClient(t, &listCalls))
+
+	_, err := tool.Execute(context.Background(), map[string]interface{}{"id": "Development"})
+	if err == nil {
+		t.Fatal("expected error for unknown route name")
+	}
+
+	// The error should help the caller pick a valid route
+	for _, name := range []string{"Production", "Staging"} {
+		if !strings.Contains(err.Error(), name) {
+			t.Errorf("expected error to list route %q, got: %v", name, err)
+		}
+	}
+}
+
+func TestDeleteAlertRouteTool_Execute(t *testing.T) {
+	const unknownID = "01HXYZUNKNOWNROUTE000000000"
+
+	var deleted []string
+	listCalls := 0
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/alert_routes":
+			listCalls++
+			fmt.Fprint(w, alertRoutesListResponse)
+		case r.Method == http.MethodDelete && r.URL.Path == "/alert_routes/ar_production":
+			// Deleted elsewhere since the routes were listed
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"type": "not_found", "status": 404, "errors": [{"code": "not_found", "message": "Alert route not found"}]}`)
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/alert_routes/"):
+			deleted = append(deleted, strings.TrimPrefix(r.URL.Path, "/alert_routes/"))
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	tool := NewDeleteAlertRouteTool(client)
+
+	t.Run("deletes a route by name", func(t *testing.T) {
+		result, err := tool.Execute(context.Background(), map[string]interface{}{"id": "Staging"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(deleted) != 1 || deleted[0] != "ar_staging" {
+			t.Errorf("expected ar_staging to be deleted, got %v", deleted)
+		}
+		if result != "Alert route ar_staging deleted successfully" {
+			t.Errorf("unexpected result: %s", result)
+		}
+	})
+
+	t.Run("reports a missing route", func(t *testing.T) {
+		before := listCalls
+		_, err := tool.Execute(context.Background(), map[string]interface{}{"id": "ar_production"})
+		if err == nil {
+			t.Fatal("expected error for missing route")
+		}
+		if !strings.Contains(err.Error(), "alert route not found: ar_production") {
+			t.Errorf("expected not found error, got: %v", err)
+		}
+
+		// The 404 drops the cache, so the next lookup lists the routes again
+		if _, err := tool.Execute(context.Background(), map[string]interface{}{"id": "ar_production"}); err == nil {
+			t.Fatal("expected error for missing route")
+		}
+		if listCalls-before != 2 {
+			t.Errorf("expected the routes to be listed again after each 404, got %d lists", listCalls-before)
+		}
+	})
+
+	t.Run("rejects an unknown ID without calling the API", func(t *testing.T) {
+		_, err := tool.Execute(context.Background(), map[string]interface{}{"id": unknownID})
+		if err == nil || !strings.Contains(err.Error(), "no alert route found with ID or name '"+unknownID+"'") {
+			t.Errorf("expected unknown route error, got: %v", err)
+		}
+	})
+}
+
+func TestUpdateAlertRouteTool_AmbiguousName(t *testing.T) {
+	var updated []string
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/alert_routes":
+			fmt.Fprint(w, `{"alert_routes": [
+				{"id": "ar_prod_us", "name": "Production"},
+				{"id": "ar_prod_eu", "name": "production"},
+				{"id": "ar_staging", "name": "Staging"}
+			], "pagination_info": {}}`)
+		case r.Method == http.MethodPatch && strings.HasPrefix(r.URL.Path, "/alert_routes/"):
+			id := strings.TrimPrefix(r.URL.Path, "/alert_routes/")
+			updated = append(updated, id)
+			fmt.Fprintf(w, `{"alert_route": {"id": %q, "name": "Production", "enabled": false}}`, id)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	tool := NewUpdateAlertRouteTool(client)
+
+	_, err := tool.Execute(context.Background(), map[string]interface{}{"id": "Production", "enabled": false})
+	if err == nil {
+		t.Fatal("expected an error for a name shared by two routes")
+	}
+	for _, expected := range []string{"ambiguous alert route name 'Production'", "ar_prod_us", "ar_prod_eu"} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected error to contain %q, got: %v", expected, err)
+		}
+	}
+	if len(updated) != 0 {
+		t.Errorf("expected no route to be updated, got %v", updated)
+	}
+
+	// Either route can still be picked by its ID
+	if _, err := tool.Execute(context.Background(), map[string]interface{}{"id": "ar_prod_eu", "enabled": false}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(updated) != 1 || updated[0] != "ar_prod_eu" {
+		t.Errorf("expected ar_prod_eu to be updated, got %v", updated)
+	}
+}
+
+func TestAlertRouteResolver_RefreshesExpiredCache(t *testing.T) {
+	listCalls := 0
+	name := "Production"
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		listCalls++
+		fmt.Fprintf(w, `{"alert_routes": [{"id": "ar_production", "name": %q}], "pagination_info": {}}`, name)
+	})
+	resolver := newAlertRouteResolver(client)
+	now := time.Date(2024, 12, 1, 9, 0, 0, 0, time.UTC)
+	resolver.now = func() time.Time { return now }
+
+	if id, err := resolver.resolve(context.Background(), "production"); err != nil || id != "ar_production" {
+		t.Fatalf("expected ar_production, got %q (%v)", id, err)
+	}
+
+	// The route is renamed, but the old name still resolves until the cache
+	// expires
+	name = "Production EU"
+	if _, err := resolver.resolve(context.Background(), "production"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if listCalls != 1 {
+		t.Errorf("expected a cached lookup, got %d lists", listCalls)
+	}
+
+	now = now.Add(alertRouteCacheTTL)
+	if _, err := resolver.resolve(context.Background(), "production"); err == nil {
+		t.Error("expected the old name to stop resolving once the cache expired")
+	}
+	if id, err := resolver.resolve(context.Background(), "production eu"); err != nil || id != "ar_production" {
+		t.Errorf("expected ar_production for the new name, got %q (%v)", id, err)
+	}
+	if listCalls != 2 {
+		t.Errorf("expected the routes to be listed again once, got %d lists", listCalls)
+	}
+}
+
+func TestCreateAlertRouteTool_InvalidArguments(t *testing.T) {
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	tool := NewCreateAlertRouteTool(client)
+
+	validCondition := map[string]interface{}{"field": "severity", "operation": "equals", "value": "critical"}
+	validEscalation := map[string]interface{}{"id": "esc_123", "level": float64(1)}
+
+	tests := []struct {
+		name        string
+		conditions  []interface{}
+		escalations []interface{}
+		wantErr     string
+	}{
+		{
+			name:        "condition missing value",
+			conditions:  []interface{}{map[string]interface{}{"field": "severity", "operation": "equals"}},
+			escalations: []interface{}{validEscalation},
+			wantErr:     "condition 1 is missing 'value'",
+		},
+		{
+			name:        "unknown operation",
+			conditions:  []interface{}{map[string]interface{}{"field": "severity", "operation": "matches", "value": "critical"}},
+			escalations: []interface{}{validEscalation},
+			wantErr:     "invalid operation 'matches'. Allowed operations: equals, not_equals",
+		},
+		{
+			name:        "non-numeric level",
+			conditions:  []interface{}{validCondition},
+			escalations: []interface{}{map[string]interface{}{"id": "esc_123", "level": "high"}},
+			wantErr:     "escalation 1 has invalid level high",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tool.Execute(context.Background(), map[string]interface{}{
+				"name":        "Production alerts",
+				"conditions":  tt.conditions,
+				"escalations": tt.escalations,
+			})
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestCreateAlertRouteTool_RejectedEscalationSuggestsListing(t *testing.T) {
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{
+			"type": "validation_error",
+			"status": 422,
+			"errors": [{"code": "not_found", "message": "Escalation path not found", "source": {"field": "escalations.0.id"}}]
+		}`)
+	})
+
+	_, err := NewCreateAlertRouteTool(client).Execute(context.Background(), map[string]interface{}{
+		"name":        "Production alerts",
+		"conditions":  []interface{}{map[string]interface{}{"field": "severity", "operation": "equals", "value": "critical"}},
+		"escalations": []interface{}{map[string]interface{}{"id": "esc_missing", "level": float64(1)}},
+	})
+	if err == nil {
+		t.Fatal("expected error for rejected escalation")
+	}
+	if !strings.Contains(err.Error(), "Call list_escalations to see valid escalation IDs") {
+		t.Errorf("expected error to suggest list_escalations, got: %v", err)
+	}
+	if !strings.Contains(FormatError(err), "escalations.0.id: Escalation path not found") {
+		t.Errorf("expected field errors to still be reported, got: %s", FormatError(err))
+	}
+}
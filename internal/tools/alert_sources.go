@@ -1,8 +1,9 @@
 package tools
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
+	"strings"
 
 	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
 )
@@ -34,6 +35,7 @@ PARAMETERS:
 EXAMPLES:
 - List all sources: {}
 - List with pagination: {"page_size": 50, "after": "cursor_abc"}
+- IDs, names and types only: {"fields": "id,name,type"}
 
 IMPORTANT: Alert source IDs from this tool are required for the create_alert_event tool.`
 }
@@ -52,12 +54,13 @@ func (t *ListAlertSourcesTool) InputSchema() map[string]interface{} {
 				"type":        "string",
 				"description": "Pagination cursor for next page",
 			},
+			"fields": fieldsProperty(),
 		},
 		"additionalProperties": false,
 	}
 }
 
-func (t *ListAlertSourcesTool) Execute(args map[string]interface{}) (string, error) {
+func (t *ListAlertSourcesTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
 	params := &incidentio.ListAlertSourcesParams{}
 
 	if pageSize, ok := args["page_size"].(float64); ok {
@@ -67,15 +70,110 @@ func (t *ListAlertSourcesTool) Execute(args map[string]interface{}) (string, err
 		params.After = after
 	}
 
-	result, err := t.client.ListAlertSources(params)
+	result, err := t.client.ListAlertSources(ctx, params)
 	if err != nil {
 		return "", fmt.Errorf("failed to list alert sources: %w", err)
 	}
 
-	output, err := json.MarshalIndent(result, "", "  ")
+	return formatJSONResult(result, args)
+}
+
+// GetAlertSourceTool gets a single alert source by ID or name
+type GetAlertSourceTool struct {
+	client *incidentio.Client
+}
+
+func NewGetAlertSourceTool(client *incidentio.Client) *GetAlertSourceTool {
+	return &GetAlertSourceTool{client: client}
+}
+
+func (t *GetAlertSourceTool) Name() string {
+	return "get_alert_source"
+}
+
+func (t *GetAlertSourceTool) Description() string {
+	return `Get a single alert source, including its ID, name and source type.
+
+USAGE WORKFLOW:
+1. Look up the source by ID, or by name when you only know what it is called
+2. Use the returned ID as alert_source_id in create_alert_event, or in alert route configuration
+
+PARAMETERS:
+- id: Required. The alert source ID, or its name (case-insensitive)
+
+EXAMPLES:
+- By ID: {"id": "01HXYZ..."}
+- By name: {"id": "Datadog Production"}`
+}
+
+func (t *GetAlertSourceTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"id": map[string]interface{}{
+				"type":        "string",
+				"description": "The alert source ID or name",
+			},
+			"fields": fieldsProperty(),
+		},
+		"required":             []interface{}{"id"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *GetAlertSourceTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	identifier, _ := args["id"].(string)
+	identifier = strings.TrimSpace(identifier)
+	if identifier == "" {
+		return "", fmt.Errorf("id parameter is required")
+	}
+
+	source, err := t.client.GetAlertSource(ctx, identifier)
 	if err != nil {
-		return "", fmt.Errorf("failed to marshal result: %w", err)
+		if !incidentio.IsNotFound(err) {
+			return "", fmt.Errorf("failed to get alert source: %w", err)
+		}
+		// Not an ID, so try it as a name
+		source, err = findAlertSourceByName(ctx, t.client, identifier)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return formatJSONResult(source, args)
+}
+
+// findAlertSourceByName pages through the alert sources for one whose name
+// matches (case-insensitive). The error lists the available sources.
+func findAlertSourceByName(ctx context.Context, client *incidentio.Client, name string) (*incidentio.AlertSource, error) {
+	params := &incidentio.ListAlertSourcesParams{PageSize: 250}
+	guard := incidentio.NewPaginationGuard("alert sources", "")
+
+	var available []string
+	for {
+		resp, err := client.ListAlertSources(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list alert sources: %w", err)
+		}
+		for i, source := range resp.AlertSources {
+			if strings.EqualFold(source.Name, name) {
+				return &resp.AlertSources[i], nil
+			}
+			available = append(available, fmt.Sprintf("%s (id: %s, type: %s)", source.Name, source.ID, source.Type))
+		}
+
+		after := resp.Pagination.After
+		if after == "" || len(resp.AlertSources) == 0 {
+			break
+		}
+		if err := guard.Next(after, len(resp.AlertSources)); err != nil {
+			return nil, err
+		}
+		params.After = after
 	}
 
-	return string(output), nil
+	if len(available) == 0 {
+		return nil, fmt.Errorf("alert source not found: %s. No alert sources are configured", name)
+	}
+	return nil, fmt.Errorf("alert source not found: %s. Available alert sources: %s", name, strings.Join(available, ", "))
 }
@@ -0,0 +1,149 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+// newAlertSourcesTestClient serves the given alert sources from the list and
+// get endpoints, across two pages
+func newAlertSourcesTestClient(t *testing.T, sources []incidentio.AlertSource) *incidentio.Client {
+	t.Helper()
+
+	return newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		if r.URL.Path == "/alert_sources" {
+			page, after := sources, ""
+			if r.URL.Query().Get("after") == "" && len(sources) > 1 {
+				page, after = sources[:1], "page_2"
+			} else if len(sources) > 1 {
+				page = sources[1:]
+			}
+			resp := map[string]interface{}{
+				"alert_sources":   page,
+				"pagination_info": map[string]interface{}{"after": after, "page_size": 250},
+			}
+			json.NewEncoder(w).Encode(resp)
+			return
+		}
+
+		id := strings.TrimPrefix(r.URL.Path, "/alert_sources/")
+		for _, source := range sources {
+			if source.ID == id {
+				json.NewEncoder(w).Encode(map[string]interface{}{"alert_source": source})
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"type":   "not_found",
+			"status": 404,
+			"errors": []map[string]interface{}{{"code": "not_found", "message": "Not found"}},
+		})
+	})
+}
+
+var testAlertSources = []incidentio.AlertSource{
+	{ID: "01HALERTSOURCE000000000001", Name: "Datadog Production", Type: "datadog"},
+	{ID: "01HALERTSOURCE000000000002", Name: "Grafana", Type: "grafana"},
+}
+
+func TestListAlertSourcesTool_Execute(t *testing.T) {
+	client := newAlertSourcesTestClient(t, testAlertSources[:1])
+	tool := NewListAlertSourcesTool(client)
+
+	result, err := tool.Execute(context.Background(), map[string]interface{}{"fields": "id,name,type"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var resp struct {
+		AlertSources []map[string]interface{} `json:"alert_sources"`
+	}
+	if err := json.Unmarshal([]byte(result), &resp); err != nil {
+		t.Fatalf("failed to parse result: %v", err)
+	}
+	if len(resp.AlertSources) != 1 {
+		t.Fatalf("expected 1 alert source, got %d", len(resp.AlertSources))
+	}
+	source := resp.AlertSources[0]
+	if source["id"] != "01HALERTSOURCE000000000001" || source["name"] != "Datadog Production" || source["type"] != "datadog" {
+		t.Errorf("unexpected alert source: %v", source)
+	}
+	if _, ok := source["created_at"]; ok {
+		t.Errorf("expected created_at to be filtered out, got %v", source)
+	}
+}
+
+func TestGetAlertSourceTool_Execute(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		expectedID string
+		wantErr    []string
+	}{
+		{
+			name:       "by ID",
+			id:         "01HALERTSOURCE000000000002",
+			expectedID: "01HALERTSOURCE000000000002",
+		},
+		{
+			name:       "by name on a later page",
+			id:         "grafana",
+			expectedID: "01HALERTSOURCE000000000002",
+		},
+		{
+			name: "not found lists available sources",
+			id:   "Pingdom",
+			wantErr: []string{
+				"alert source not found: Pingdom",
+				"Datadog Production (id: 01HALERTSOURCE000000000001, type: datadog)",
+				"Grafana (id: 01HALERTSOURCE000000000002, type: grafana)",
+			},
+		},
+		{
+			name:    "missing id",
+			wantErr: []string{"id parameter is required"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tool := NewGetAlertSourceTool(newAlertSourcesTestClient(t, testAlertSources))
+
+			result, err := tool.Execute(context.Background(), map[string]interface{}{"id": tt.id})
+			if len(tt.wantErr) > 0 {
+				if err == nil {
+					t.Fatalf("expected error, got result %s", result)
+				}
+				for _, want := range tt.wantErr {
+					if !strings.Contains(err.Error(), want) {
+						t.Errorf("expected error to contain %q, got %v", want, err)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var source incidentio.AlertSource
+			if err := json.Unmarshal([]byte(result), &source); err != nil {
+				t.Fatalf("failed to parse result: %v", err)
+			}
+			if source.ID != tt.expectedID {
+				t.Errorf("expected alert source %s, got %s", tt.expectedID, source.ID)
+			}
+		})
+	}
+}
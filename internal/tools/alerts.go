@@ -1,7 +1,7 @@
 package tools
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 
 	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
@@ -36,12 +36,15 @@ PARAMETERS:
   * Top-level: "id,title,status,source"
   * Nested: "incident.id,incident.name"
   * Omit to return all fields
+- output_format: "json" (default) or "csv". csv returns a header row plus one row per alert,
+  with the fields as columns (default: id, title, status, source, created_at, updated_at)
 
 EXAMPLES:
 - List all alerts: {}
 - List firing alerts: {"status": ["firing"]}
 - List resolved alerts: {"status": ["resolved"]}
-- List with selected fields: {"fields": "id,title,status,incident.id"}`
+- List with selected fields: {"fields": "id,title,status,incident.id"}
+- Firing alerts for a spreadsheet: {"status": ["firing"], "output_format": "csv"}`
 }
 
 func (t *ListAlertsTool) InputSchema() map[string]interface{} {
@@ -62,11 +65,21 @@ func (t *ListAlertsTool) InputSchema() map[string]interface{} {
 				"type":        "string",
 				"description": GetAlertFieldsDescription(),
 			},
+			"output_format": outputFormatProperty(),
 		},
 	}
 }
 
-func (t *ListAlertsTool) Execute(args map[string]interface{}) (string, error) {
+// defaultAlertCSVColumns are the columns list_alerts writes as CSV when no
+// fields are given
+var defaultAlertCSVColumns = []string{"id", "title", "status", "source", "created_at", "updated_at"}
+
+func (t *ListAlertsTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	outputFormat, err := parseOutputFormat(args)
+	if err != nil {
+		return "", err
+	}
+
 	opts := &incidentio.ListAlertsOptions{}
 
 	if pageSize, ok := args["page_size"].(float64); ok {
@@ -81,14 +94,18 @@ func (t *ListAlertsTool) Execute(args map[string]interface{}) (string, error) {
 		}
 	}
 
-	resp, err := t.client.ListAlerts(opts)
+	resp, err := t.client.ListAlerts(ctx, opts)
 	if err != nil {
 		return "", err
 	}
 
+	if outputFormat == "csv" {
+		fieldsStr, _ := args["fields"].(string)
+		return formatCSVResult(resp, "alerts", fieldsStr, defaultAlertCSVColumns)
+	}
+
 	// Apply field filtering if requested
-	fieldsStr, _ := args["fields"].(string)
-	return FilterFields(resp, fieldsStr)
+	return formatJSONResult(resp, args)
 }
 
 // GetAlertTool retrieves a specific alert
@@ -142,20 +159,19 @@ func (t *GetAlertTool) InputSchema() map[string]interface{} {
 	}
 }
 
-func (t *GetAlertTool) Execute(args map[string]interface{}) (string, error) {
+func (t *GetAlertTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
 	id, ok := args["id"].(string)
 	if !ok {
 		return "", fmt.Errorf("id parameter is required")
 	}
 
-	alert, err := t.client.GetAlert(id)
+	alert, err := t.client.GetAlert(ctx, id)
 	if err != nil {
 		return "", err
 	}
 
 	// Apply field filtering if requested
-	fieldsStr, _ := args["fields"].(string)
-	return FilterFields(alert, fieldsStr)
+	return formatJSONResult(alert, args)
 }
 
 // ListAlertsForIncidentTool lists alerts for a specific incident
@@ -176,16 +192,21 @@ func (t *ListAlertsForIncidentTool) Description() string {
 
 USAGE WORKFLOW:
 1. Get incident ID from list_incidents or get_incident
-2. Call this tool to see all alerts linked to that incident
-3. Review alert details to understand what triggered the incident
+2. Call this tool to see the alerts linked to that incident
+3. If pagination_meta.after is set, call again with it as after, or use auto_paginate
+4. Review alert details to understand what triggered the incident
 
 PARAMETERS:
 - incident_id: Required. The incident ID to list alerts for
-- page_size: Number of results (default 25, max 250)
+- page_size: Number of results per page (default and max 50)
+- after: Pagination cursor from a previous response's pagination_meta.after
+- auto_paginate: Set to true to follow pagination internally and return every alert in one response
+  * Stops after 5000 alerts and sets truncated=true if there were more
 
 EXAMPLES:
-- List alerts for incident: {"incident_id": "01HXYZ..."}
-- List with pagination: {"incident_id": "01HXYZ...", "page_size": 50}`
+- First page of alerts: {"incident_id": "01HXYZ..."}
+- Next page: {"incident_id": "01HXYZ...", "after": "01HABC..."}
+- All alerts: {"incident_id": "01HXYZ...", "auto_paginate": true}`
 }
 
 func (t *ListAlertsForIncidentTool) InputSchema() map[string]interface{} {
@@ -198,34 +219,47 @@ func (t *ListAlertsForIncidentTool) InputSchema() map[string]interface{} {
 			},
 			"page_size": map[string]interface{}{
 				"type":        "integer",
-				"description": "Number of results per page (max 250)",
-				"default":     25,
+				"description": "Number of results per page (max 50)",
+				"default":     50,
+				"minimum":     1,
+				"maximum":     50,
 			},
+			"after": map[string]interface{}{
+				"type":        "string",
+				"description": "Pagination cursor for the next page",
+			},
+			"auto_paginate": map[string]interface{}{
+				"type":        "boolean",
+				"description": "Follow pagination internally and return all alerts (up to 5000) in a single response. Sets truncated=true if the cap was hit.",
+				"default":     false,
+			},
+			"fields": fieldsProperty(),
 		},
 		"required": []interface{}{"incident_id"},
 	}
 }
 
-func (t *ListAlertsForIncidentTool) Execute(args map[string]interface{}) (string, error) {
+func (t *ListAlertsForIncidentTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
 	incidentID, ok := args["incident_id"].(string)
 	if !ok || incidentID == "" {
 		return "", fmt.Errorf("incident_id parameter is required")
 	}
 
-	opts := &incidentio.ListAlertsOptions{}
+	opts := &incidentio.ListAlertsForIncidentOptions{}
 	if pageSize, ok := args["page_size"].(float64); ok {
 		opts.PageSize = int(pageSize)
 	}
-
-	resp, err := t.client.ListAlertsForIncident(incidentID, opts)
-	if err != nil {
-		return "", err
+	if after, ok := args["after"].(string); ok {
+		opts.After = after
+	}
+	if autoPaginate, ok := args["auto_paginate"].(bool); ok {
+		opts.AutoPaginate = autoPaginate
 	}
 
-	result, err := json.MarshalIndent(resp, "", "  ")
+	resp, err := t.client.ListAlertsForIncident(ctx, incidentID, opts)
 	if err != nil {
-		return "", fmt.Errorf("failed to format response: %w", err)
+		return "", err
 	}
 
-	return string(result), nil
+	return formatJSONResult(resp, args)
 }
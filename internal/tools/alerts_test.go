@@ -0,0 +1,161 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+func TestListAlertsTool_Fields(t *testing.T) {
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/alerts" {
+			t.Errorf("unexpected request: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `{
+			"alerts": [
+				{"id": "alert_1", "title": "High CPU", "status": "firing", "alert_source_id": "src_1"},
+				{"id": "alert_2", "title": "Disk full", "status": "resolved", "alert_source_id": "src_2"}
+			],
+			"pagination_meta": {"page_size": 25}
+		}`)
+	})
+
+	result, err := NewListAlertsTool(client).Execute(context.Background(), map[string]interface{}{
+		"fields": "-alert_source_id,-status",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var parsed struct {
+		Alerts         []map[string]interface{} `json:"alerts"`
+		PaginationMeta map[string]interface{}   `json:"pagination_meta"`
+	}
+	if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+		t.Fatalf("failed to parse result: %v", err)
+	}
+	if len(parsed.Alerts) != 2 {
+		t.Fatalf("expected 2 alerts, got %d", len(parsed.Alerts))
+	}
+	for _, alert := range parsed.Alerts {
+		if _, exists := alert["alert_source_id"]; exists {
+			t.Errorf("expected alert_source_id to be dropped, got %v", alert)
+		}
+		if _, exists := alert["status"]; exists {
+			t.Errorf("expected status to be dropped, got %v", alert)
+		}
+		if alert["id"] == nil || alert["title"] == nil {
+			t.Errorf("expected id and title to be kept, got %v", alert)
+		}
+	}
+	if parsed.PaginationMeta == nil {
+		t.Error("expected pagination_meta to be preserved")
+	}
+}
+
+// newIncidentAlertsClient serves two pages of alerts for incident inc_1 and
+// records the query of each request
+func newIncidentAlertsClient(t *testing.T, queries *[]url.Values) *incidentio.Client {
+	t.Helper()
+
+	return newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		*queries = append(*queries, query)
+		if r.URL.Path != "/alerts" || query.Get("incident_id") != "inc_1" {
+			t.Errorf("unexpected request: %s?%s", r.URL.Path, r.URL.RawQuery)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		switch query.Get("after") {
+		case "":
+			fmt.Fprint(w, `{
+				"alerts": [{"id": "alert_1", "title": "High CPU"}, {"id": "alert_2", "title": "Disk full"}],
+				"pagination_meta": {"after": "alert_2", "page_size": 2}
+			}`)
+		case "alert_2":
+			fmt.Fprint(w, `{
+				"alerts": [{"id": "alert_3", "title": "Memory pressure"}],
+				"pagination_meta": {"page_size": 2}
+			}`)
+		default:
+			t.Errorf("unexpected cursor: %s", query.Get("after"))
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+}
+
+func TestListAlertsForIncidentTool_Pagination(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		expectedIDs   []string
+		expectedAfter string
+		expectedPages int
+	}{
+		{
+			name:          "first page",
+			args:          map[string]interface{}{"page_size": float64(2)},
+			expectedIDs:   []string{"alert_1", "alert_2"},
+			expectedAfter: "alert_2",
+			expectedPages: 1,
+		},
+		{
+			name:          "next page",
+			args:          map[string]interface{}{"after": "alert_2"},
+			expectedIDs:   []string{"alert_3"},
+			expectedPages: 1,
+		},
+		{
+			name:          "auto_paginate collects every page",
+			args:          map[string]interface{}{"auto_paginate": true},
+			expectedIDs:   []string{"alert_1", "alert_2", "alert_3"},
+			expectedPages: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var queries []url.Values
+			tool := NewListAlertsForIncidentTool(newIncidentAlertsClient(t, &queries))
+
+			tt.args["incident_id"] = "inc_1"
+			result, err := tool.Execute(context.Background(), tt.args)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var parsed incidentio.ListAlertsResponse
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to parse result: %v", err)
+			}
+			ids := make([]string, len(parsed.Alerts))
+			for i, alert := range parsed.Alerts {
+				ids[i] = alert.ID
+			}
+			if !reflect.DeepEqual(ids, tt.expectedIDs) {
+				t.Errorf("expected alerts %v, got %v", tt.expectedIDs, ids)
+			}
+			if parsed.PaginationMeta.After != tt.expectedAfter {
+				t.Errorf("expected after %q, got %q", tt.expectedAfter, parsed.PaginationMeta.After)
+			}
+			if parsed.Truncated {
+				t.Error("expected the result not to be truncated")
+			}
+			if len(queries) != tt.expectedPages {
+				t.Errorf("expected %d requests, got %d", tt.expectedPages, len(queries))
+			}
+			if pageSize := queries[0].Get("page_size"); tt.args["page_size"] != nil && pageSize != "2" {
+				t.Errorf("expected page_size 2, got %s", pageSize)
+			}
+		})
+	}
+}
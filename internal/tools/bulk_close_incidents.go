@@ -0,0 +1,340 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+const (
+	// bulkCloseConcurrency bounds the number of incidents being closed at once
+	bulkCloseConcurrency = 5
+	// maxBulkCloseIncidents caps the number of incidents closed in one call
+	maxBulkCloseIncidents = 100
+)
+
+// olderThan matches the filter's older_than argument, a number of minutes,
+// hours, days or weeks such as "30d"
+var olderThan = regexp.MustCompile(`^(\d+)([mhdw])$`)
+
+// defaultBulkCloseStatuses are the status categories a filter matches when it
+// does not name any
+var defaultBulkCloseStatuses = []string{"triage", "live"}
+
+// BulkCloseIncidentsTool closes many incidents at once, such as abandoned test
+// incidents
+type BulkCloseIncidentsTool struct {
+	client  *incidentio.Client
+	lister  *ListIncidentsTool
+	fetcher *GetIncidentsTool
+}
+
+func NewBulkCloseIncidentsTool(client *incidentio.Client) *BulkCloseIncidentsTool {
+	return &BulkCloseIncidentsTool{
+		client:  client,
+		lister:  newListIncidentsTool(client),
+		fetcher: NewGetIncidentsTool(client),
+	}
+}
+
+func (t *BulkCloseIncidentsTool) Name() string {
+	return "bulk_close_incidents"
+}
+
+func (t *BulkCloseIncidentsTool) Description() string {
+	return `Close many incidents in one call, such as stale or abandoned test incidents.
+
+Select the incidents either by listing them in incident_ids or with a filter. Incidents are closed concurrently, at most 5 at a time, and a failure for one incident does not stop the others.
+
+USAGE WORKFLOW:
+1. Call without confirm to see which incidents would be closed. Nothing is changed and the result lists them under "would_close", with "preview": true
+2. Check the list, then call again with "confirm": true
+3. Check "errors" for any incidents that could not be closed
+
+PARAMETERS:
+- incident_ids: Array of incident identifiers (IDs, references such as "INC-123", or Slack channels), max 100
+- filter: Object selecting incidents instead of incident_ids:
+  - status: Status categories to match (default triage and live)
+  - older_than: Only incidents created longer ago than this, e.g. "30d", "12h" or "2w"
+  - mode: Incident modes to match (standard, retrospective, tutorial)
+- confirm: Required to close anything. Must be true
+
+EXAMPLES:
+- Preview: {"filter": {"status": ["triage"], "older_than": "30d"}}
+- Close stale triage incidents: {"filter": {"status": ["triage"], "older_than": "30d"}, "confirm": true}
+- Close old tutorial incidents: {"filter": {"mode": ["tutorial"], "older_than": "7d"}, "confirm": true}
+- Close listed incidents: {"incident_ids": ["INC-101", "INC-102"], "confirm": true}
+
+Returns {"closed": {identifier: incident}, "skipped": {identifier: reason}, "errors": {identifier: message}}. Incidents that are already closed are skipped. A filter matching more than 100 incidents is rejected; narrow it and run it again.
+
+IMPORTANT: Custom fields required before closure are not checked. incident.io rejects closing incidents that are missing them, and those incidents are reported in "errors".`
+}
+
+func (t *BulkCloseIncidentsTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"incident_ids": map[string]interface{}{
+				"type":        "array",
+				"items":       map[string]interface{}{"type": "string"},
+				"description": "Incident identifiers: IDs, references (INC-123 or 123), Slack channel IDs or Slack channel names",
+				"minItems":    1,
+				"maxItems":    maxBulkCloseIncidents,
+			},
+			"filter": map[string]interface{}{
+				"type":        "object",
+				"description": "Select incidents to close instead of listing them in incident_ids",
+				"properties": map[string]interface{}{
+					"status": map[string]interface{}{
+						"type":        "array",
+						"items":       map[string]interface{}{"type": "string"},
+						"description": "Status categories to match (default triage and live)",
+					},
+					"older_than": map[string]interface{}{
+						"type":        "string",
+						"description": "Only incidents created longer ago than this, as a number of minutes, hours, days or weeks (e.g. \"30d\")",
+						"pattern":     olderThan.String(),
+					},
+					"mode": map[string]interface{}{
+						"type":        "array",
+						"items":       map[string]interface{}{"type": "string"},
+						"description": "Incident modes to match: standard, retrospective or tutorial",
+					},
+				},
+				"additionalProperties": false,
+			},
+			"confirm": map[string]interface{}{
+				"type":        "boolean",
+				"description": "Must be true to close the incidents. Without it the matching incidents are listed and nothing is changed",
+				"default":     false,
+			},
+		},
+		"additionalProperties": false,
+	}
+}
+
+// bulkCloseTarget is an incident selected for closing, keyed by the
+// identifier it is reported under
+type bulkCloseTarget struct {
+	key      string
+	incident *incidentio.Incident
+}
+
+func (t *BulkCloseIncidentsTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	rawIDs, hasIDs := args["incident_ids"].([]interface{})
+	filter, hasFilter := args["filter"].(map[string]interface{})
+	if hasIDs == hasFilter {
+		return "", fmt.Errorf("exactly one of incident_ids or filter is required")
+	}
+
+	var (
+		targets []bulkCloseTarget
+		failed  = make(map[string]string)
+		err     error
+	)
+	if hasIDs {
+		targets, failed, err = t.resolveIDs(ctx, rawIDs)
+	} else {
+		targets, err = t.resolveFilter(ctx, filter)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	skipped := make(map[string]string)
+	var open []bulkCloseTarget
+	for _, target := range targets {
+		if target.incident.IncidentStatus.Category == "closed" {
+			skipped[target.key] = "already closed"
+			continue
+		}
+		open = append(open, target)
+	}
+
+	// Without confirm, report what would be closed. This is a normal result
+	// rather than an error, so clients don't show or retry it as a failure.
+	if confirm, _ := args["confirm"].(bool); !confirm {
+		wouldClose := make(map[string]interface{}, len(open))
+		for _, target := range open {
+			wouldClose[target.key] = bulkCloseSummary(target.incident)
+		}
+		result, err := json.MarshalIndent(map[string]interface{}{
+			"preview":     true,
+			"message":     fmt.Sprintf("Nothing was changed. Call again with confirm: true to close %d incident(s)", len(open)),
+			"would_close": wouldClose,
+			"skipped":     skipped,
+			"errors":      failed,
+		}, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("failed to format response: %w", err)
+		}
+		return string(result), nil
+	}
+
+	closed := make(map[string]interface{})
+	if len(open) > 0 {
+		closedStatusID, err := closedIncidentStatusID(ctx, t.client)
+		if err != nil {
+			return "", err
+		}
+		incidents, errs := t.closeAll(ctx, open, closedStatusID)
+		for key, incident := range incidents {
+			closed[key] = bulkCloseSummary(incident)
+		}
+		for key, err := range errs {
+			failed[key] = err.Error()
+		}
+	}
+
+	result, err := json.MarshalIndent(map[string]interface{}{
+		"closed":  closed,
+		"skipped": skipped,
+		"errors":  failed,
+	}, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to format response: %w", err)
+	}
+
+	return string(result), nil
+}
+
+// bulkCloseSummary is how an incident is reported in the tool's result
+func bulkCloseSummary(incident *incidentio.Incident) map[string]interface{} {
+	return map[string]interface{}{
+		"id":              incident.ID,
+		"reference":       incident.Reference,
+		"name":            incident.Name,
+		"incident_status": incident.IncidentStatus.Name,
+	}
+}
+
+// resolveIDs fetches each incident in incident_ids, returning the incidents
+// found and the errors for the rest
+func (t *BulkCloseIncidentsTool) resolveIDs(ctx context.Context, rawIDs []interface{}) ([]bulkCloseTarget, map[string]string, error) {
+	var identifiers []string
+	seen := make(map[string]bool)
+	for _, raw := range rawIDs {
+		identifier, ok := raw.(string)
+		if !ok || identifier == "" {
+			return nil, nil, fmt.Errorf("incident_ids must only contain non-empty strings, got %v", raw)
+		}
+		if !seen[identifier] {
+			seen[identifier] = true
+			identifiers = append(identifiers, identifier)
+		}
+	}
+	if len(identifiers) == 0 {
+		return nil, nil, fmt.Errorf("incident_ids must not be empty")
+	}
+	if len(identifiers) > maxBulkCloseIncidents {
+		return nil, nil, fmt.Errorf("at most %d incidents can be closed at once, got %d", maxBulkCloseIncidents, len(identifiers))
+	}
+
+	incidents, errs := t.fetcher.fetchAll(ctx, identifiers)
+
+	targets := make([]bulkCloseTarget, 0, len(incidents))
+	for _, identifier := range identifiers {
+		if incident, ok := incidents[identifier]; ok {
+			targets = append(targets, bulkCloseTarget{key: identifier, incident: incident})
+		}
+	}
+	failed := make(map[string]string, len(errs))
+	for identifier, err := range errs {
+		failed[identifier] = err.Error()
+	}
+	return targets, failed, nil
+}
+
+// resolveFilter lists the incidents matching filter, keyed by reference. It
+// fails if more than maxBulkCloseIncidents match.
+func (t *BulkCloseIncidentsTool) resolveFilter(ctx context.Context, filter map[string]interface{}) ([]bulkCloseTarget, error) {
+	if len(filter) == 0 {
+		return nil, fmt.Errorf("filter must set at least one of status, older_than or mode")
+	}
+
+	// The filter is translated into list_incidents arguments, which take
+	// lists as comma-separated strings
+	filterArgs := map[string]interface{}{
+		"status": strings.Join(defaultBulkCloseStatuses, ","),
+	}
+	if status := stringListArgument(filter["status"]); len(status) > 0 {
+		filterArgs["status"] = strings.Join(status, ",")
+	}
+	if mode := stringListArgument(filter["mode"]); len(mode) > 0 {
+		filterArgs["mode"] = strings.Join(mode, ",")
+	}
+
+	age, _ := filter["older_than"].(string)
+	age = strings.ToLower(strings.TrimSpace(age))
+	if age != "" {
+		if !olderThan.MatchString(age) {
+			return nil, fmt.Errorf("invalid older_than '%s': use a number of minutes, hours, days or weeks such as 30m, 12h, 30d or 2w", age)
+		}
+		filterArgs["created_at_lte"] = "now-" + age
+	}
+
+	opts := &incidentio.ListIncidentsOptions{PageSize: 250}
+	if err := t.lister.applyFilters(ctx, filterArgs, opts); err != nil {
+		return nil, err
+	}
+	resp, truncated, err := t.lister.autoPaginate(ctx, opts, maxBulkCloseIncidents)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list incidents: %w", err)
+	}
+	if truncated {
+		return nil, fmt.Errorf("filter matches more than %d incidents. Narrow it, e.g. with a longer older_than, and run it again", maxBulkCloseIncidents)
+	}
+
+	targets := make([]bulkCloseTarget, len(resp.Incidents))
+	for i := range resp.Incidents {
+		incident := &resp.Incidents[i]
+		key := incident.Reference
+		if key == "" {
+			key = incident.ID
+		}
+		targets[i] = bulkCloseTarget{key: key, incident: incident}
+	}
+	return targets, nil
+}
+
+// closeAll closes each target with a bounded worker pool, returning the
+// closed incidents and the errors for the rest
+func (t *BulkCloseIncidentsTool) closeAll(ctx context.Context, targets []bulkCloseTarget, closedStatusID string) (map[string]*incidentio.Incident, map[string]error) {
+	var (
+		mu        sync.Mutex
+		wg        sync.WaitGroup
+		incidents = make(map[string]*incidentio.Incident)
+		errs      = make(map[string]error)
+	)
+	sem := make(chan struct{}, bulkCloseConcurrency)
+
+	for _, target := range targets {
+		wg.Add(1)
+		go func(target bulkCloseTarget) {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			incident, err := t.client.UpdateIncident(ctx, target.incident.ID, &incidentio.UpdateIncidentRequest{
+				IncidentStatusID: closedStatusID,
+			})
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs[target.key] = err
+				return
+			}
+			incidents[target.key] = incident
+		}(target)
+	}
+	wg.Wait()
+
+	return incidents, errs
+}
@@ -0,0 +1,188 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const bulkCloseStatuses = `{"incident_statuses": [
+	{"id": "st_triage", "name": "Triage", "category": "triage"},
+	{"id": "st_live", "name": "Investigating", "category": "live"},
+	{"id": "st_closed", "name": "Closed", "category": "closed"}
+]}`
+
+// bulkCloseServer serves the incidents in incidents and records the IDs of
+// the incidents it is asked to close. Closing an incident in failIDs fails.
+type bulkCloseServer struct {
+	t         *testing.T
+	incidents string
+	failIDs   map[string]bool
+
+	mu      sync.Mutex
+	queries []string
+	closed  []string
+}
+
+func (s *bulkCloseServer) handle(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == "/v1/incident_statuses":
+		fmt.Fprint(w, bulkCloseStatuses)
+	case r.URL.Path == "/incidents":
+		s.mu.Lock()
+		s.queries = append(s.queries, r.URL.RawQuery)
+		s.mu.Unlock()
+		fmt.Fprint(w, s.incidents)
+	case strings.HasSuffix(r.URL.Path, "/actions/edit"):
+		id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/incidents/"), "/actions/edit")
+		var body struct {
+			Incident map[string]string `json:"incident"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body.Incident["incident_status_id"] != "st_closed" {
+			s.t.Errorf("expected incident %s to be moved to st_closed, got %v", id, body.Incident)
+		}
+		if s.failIDs[id] {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprint(w, `{"type": "validation_error", "errors": [{"message": "required custom fields are not set"}]}`)
+			return
+		}
+		s.mu.Lock()
+		s.closed = append(s.closed, id)
+		s.mu.Unlock()
+		fmt.Fprintf(w, `{"incident": {"id": %q, "name": "Closed incident", "incident_status": {"id": "st_closed", "name": "Closed", "category": "closed"}}}`, id)
+	default:
+		s.t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestBulkCloseIncidentsTool_Filter(t *testing.T) {
+	server := &bulkCloseServer{
+		t: t,
+		incidents: `{"incidents": [
+			{"id": "inc_1", "reference": "INC-1", "name": "Test one", "incident_status": {"category": "triage"}},
+			{"id": "inc_2", "reference": "INC-2", "name": "Test two", "incident_status": {"category": "live"}},
+			{"id": "inc_3", "reference": "INC-3", "name": "Test three", "incident_status": {"category": "triage"}}
+		], "pagination_meta": {"page_size": 250, "total_record_count": 3}}`,
+		failIDs: map[string]bool{"inc_3": true},
+	}
+	tool := NewBulkCloseIncidentsTool(newMockServerClient(t, server.handle))
+	tool.lister.now = func() time.Time { return time.Date(2024, 12, 31, 12, 0, 0, 0, time.UTC) }
+
+	result, err := tool.Execute(context.Background(), map[string]interface{}{
+		"filter":  map[string]interface{}{"older_than": "30d"},
+		"confirm": true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(server.queries) != 1 {
+		t.Fatalf("expected 1 list request, got %v", server.queries)
+	}
+	for _, expected := range []string{
+		"created_at%5Blte%5D=2024-12-01T12%3A00%3A00Z",
+		"status_category%5Bone_of%5D=triage",
+		"status_category%5Bone_of%5D=live",
+	} {
+		if !strings.Contains(server.queries[0], expected) {
+			t.Errorf("expected query to contain %s, got: %s", expected, server.queries[0])
+		}
+	}
+
+	var response struct {
+		Closed  map[string]map[string]string `json:"closed"`
+		Skipped map[string]string            `json:"skipped"`
+		Errors  map[string]string            `json:"errors"`
+	}
+	if err := json.Unmarshal([]byte(result), &response); err != nil {
+		t.Fatalf("failed to parse result: %v\n%s", err, result)
+	}
+	if len(response.Closed) != 2 || response.Closed["INC-1"]["id"] != "inc_1" || response.Closed["INC-2"]["id"] != "inc_2" {
+		t.Errorf("expected INC-1 and INC-2 to be closed, got: %v", response.Closed)
+	}
+	if !strings.Contains(response.Errors["INC-3"], "required custom fields") || len(response.Errors) != 1 {
+		t.Errorf("expected INC-3 to fail, got: %v", response.Errors)
+	}
+}
+
+func TestBulkCloseIncidentsTool_RequiresConfirm(t *testing.T) {
+	server := &bulkCloseServer{
+		t: t,
+		incidents: `{"incidents": [
+			{"id": "inc_1", "reference": "INC-1", "name": "Test one", "incident_status": {"category": "triage"}},
+			{"id": "inc_2", "reference": "INC-2", "name": "Already done", "incident_status": {"category": "closed"}}
+		], "pagination_meta": {"page_size": 250, "total_record_count": 2}}`,
+	}
+	tool := NewBulkCloseIncidentsTool(newMockServerClient(t, server.handle))
+
+	for _, confirm := range []interface{}{nil, false} {
+		args := map[string]interface{}{
+			"filter": map[string]interface{}{"status": []interface{}{"triage", "closed"}},
+		}
+		if confirm != nil {
+			args["confirm"] = confirm
+		}
+
+		result, err := tool.Execute(context.Background(), args)
+		if err != nil {
+			t.Fatalf("expected the preview as a result, got error: %v", err)
+		}
+		var preview struct {
+			Preview    bool                              `json:"preview"`
+			WouldClose map[string]map[string]interface{} `json:"would_close"`
+			Skipped    map[string]string                 `json:"skipped"`
+		}
+		if err := json.Unmarshal([]byte(result), &preview); err != nil {
+			t.Fatalf("failed to parse result: %v", err)
+		}
+		if !preview.Preview {
+			t.Errorf("expected the result to be marked as a preview, got: %s", result)
+		}
+		if len(preview.WouldClose) != 1 || preview.WouldClose["INC-1"]["name"] != "Test one" {
+			t.Errorf("expected only the open incident in would_close, got: %s", result)
+		}
+		if preview.Skipped["INC-2"] != "already closed" {
+			t.Errorf("expected the closed incident to be skipped, got: %s", result)
+		}
+	}
+	if len(server.closed) != 0 {
+		t.Errorf("expected nothing to be closed, got %v", server.closed)
+	}
+}
+
+func TestBulkCloseIncidentsTool_InvalidArguments(t *testing.T) {
+	tool := NewBulkCloseIncidentsTool(newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+	}))
+
+	tests := []struct {
+		name    string
+		args    map[string]interface{}
+		wantErr string
+	}{
+		{name: "no selection", args: map[string]interface{}{"confirm": true}, wantErr: "exactly one of incident_ids or filter"},
+		{
+			name:    "both selections",
+			args:    map[string]interface{}{"incident_ids": []interface{}{"INC-1"}, "filter": map[string]interface{}{"older_than": "1d"}},
+			wantErr: "exactly one of incident_ids or filter",
+		},
+		{name: "empty filter", args: map[string]interface{}{"filter": map[string]interface{}{}}, wantErr: "at least one of status, older_than or mode"},
+		{name: "invalid older_than", args: map[string]interface{}{"filter": map[string]interface{}{"older_than": "a month"}}, wantErr: "invalid older_than"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tool.Execute(context.Background(), tt.args)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
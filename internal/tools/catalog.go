@@ -1,6 +1,7 @@
 package tools
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -26,40 +27,55 @@ func (t *ListCatalogTypesTool) Description() string {
 	return `List available catalog types in incident.io (automatically filtered to Custom* types only).
 
 USAGE WORKFLOW:
-1. Call to see all custom catalog types configured in your organization
-2. Review type IDs, names, and attributes for each catalog type
-3. Use catalog type IDs with list_catalog_entries to see entries
+1. Call to see custom catalog types configured in your organization
+2. If pagination_meta.after is set in the response, call again with that value as 'after' to get the next page
+3. Review type IDs, names, and attributes for each catalog type
+4. Use catalog type IDs with list_catalog_entries to see entries
 
 PARAMETERS:
-- None required
+- page_size: Optional. Number of catalog types to fetch per page (max 250)
+- after: Optional. Pagination cursor from a previous response
 
 EXAMPLES:
-- List all custom catalog types: {}
+- List custom catalog types: {}
+- Next page: {"after": "01HABC..."}
 
-IMPORTANT: This tool automatically filters to show only catalog types with TypeName starting with 'Custom' (case-insensitive). This filtering helps focus on user-defined catalogs rather than system catalogs.`
+IMPORTANT: This tool automatically filters to show only catalog types with TypeName starting with 'Custom' (case-insensitive). Filtering is applied to each page, so a page may contain fewer types than page_size while more pages remain.`
 }
 
 func (t *ListCatalogTypesTool) InputSchema() map[string]interface{} {
 	return map[string]interface{}{
-		"type":                 "object",
-		"properties":           map[string]interface{}{},
+		"type": "object",
+		"properties": map[string]interface{}{
+			"page_size": map[string]interface{}{
+				"type":        "integer",
+				"description": "Number of catalog types to fetch per page (max 250)",
+			},
+			"after": map[string]interface{}{
+				"type":        "string",
+				"description": "Pagination cursor from a previous response's pagination_meta.after",
+			},
+			"fields": fieldsProperty(),
+		},
 		"additionalProperties": false,
 	}
 }
 
-func (t *ListCatalogTypesTool) Execute(args map[string]interface{}) (string, error) {
-	result, err := t.client.ListCatalogTypes()
+func (t *ListCatalogTypesTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	opts := incidentio.ListCatalogTypesOptions{}
+	if pageSize, ok := args["page_size"].(float64); ok {
+		opts.PageSize = int(pageSize)
+	}
+	if after, ok := args["after"].(string); ok {
+		opts.After = after
+	}
+
+	result, err := t.client.ListCatalogTypes(ctx, opts)
 	if err != nil {
 		return "", fmt.Errorf("failed to list catalog types: %w", err)
 	}
 
-	// Filter catalog types to only include those with TypeName starting with "Custom" (case-insensitive)
-	var filteredTypes []incidentio.CatalogType
-	for _, catalogType := range result.CatalogTypes {
-		if strings.HasPrefix(strings.ToLower(catalogType.TypeName), "custom") {
-			filteredTypes = append(filteredTypes, catalogType)
-		}
-	}
+	filteredTypes := filterCustomCatalogTypes(result.CatalogTypes)
 
 	output := fmt.Sprintf("Found %d catalog types (filtered for Custom* names):\n\n", len(filteredTypes))
 
@@ -87,17 +103,28 @@ func (t *ListCatalogTypesTool) Execute(args map[string]interface{}) (string, err
 		output += "\n"
 	}
 
+	if result.PaginationMeta.After != "" {
+		output += fmt.Sprintf("More catalog types are available. Call again with after=%q to fetch the next page.\n", result.PaginationMeta.After)
+	}
+
 	// Also return the raw JSON (only filtered types)
 	filteredResult := &incidentio.ListCatalogTypesResponse{
 		CatalogTypes: filteredTypes,
 		ListResponse: result.ListResponse,
 	}
-	jsonOutput, err := json.MarshalIndent(filteredResult, "", "  ")
-	if err != nil {
-		return output, nil
-	}
+	return withRawJSON(output, filteredResult, args)
+}
 
-	return output + "\nRaw JSON:\n" + string(jsonOutput), nil
+// filterCustomCatalogTypes keeps only catalog types with a TypeName starting
+// with "Custom" (case-insensitive)
+func filterCustomCatalogTypes(catalogTypes []incidentio.CatalogType) []incidentio.CatalogType {
+	var filteredTypes []incidentio.CatalogType
+	for _, catalogType := range catalogTypes {
+		if strings.HasPrefix(strings.ToLower(catalogType.TypeName), "custom") {
+			filteredTypes = append(filteredTypes, catalogType)
+		}
+	}
+	return filteredTypes
 }
 
 // ListCatalogEntriesTool lists catalog entries for a given type
@@ -154,13 +181,14 @@ func (t *ListCatalogEntriesTool) InputSchema() map[string]interface{} {
 				"type":        "string",
 				"description": "Filter by identifier",
 			},
+			"fields": fieldsProperty(),
 		},
 		"required":             []interface{}{"catalog_type_id"},
 		"additionalProperties": false,
 	}
 }
 
-func (t *ListCatalogEntriesTool) Execute(args map[string]interface{}) (string, error) {
+func (t *ListCatalogEntriesTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
 	catalogTypeID, ok := args["catalog_type_id"].(string)
 	if !ok || catalogTypeID == "" {
 		return "", fmt.Errorf("catalog_type_id parameter is required")
@@ -188,7 +216,7 @@ func (t *ListCatalogEntriesTool) Execute(args map[string]interface{}) (string, e
 		opts.Identifier = identifier
 	}
 
-	result, err := t.client.ListCatalogEntries(opts)
+	result, err := t.client.ListCatalogEntries(ctx, opts)
 	if err != nil {
 		return "", fmt.Errorf("failed to list catalog entries: %w", err)
 	}
@@ -196,43 +224,7 @@ func (t *ListCatalogEntriesTool) Execute(args map[string]interface{}) (string, e
 	output := fmt.Sprintf("Found %d catalog entries for type %s:\n\n", len(result.CatalogEntries), catalogTypeID)
 
 	for _, entry := range result.CatalogEntries {
-		output += fmt.Sprintf("ID: %s\n", entry.ID)
-		output += fmt.Sprintf("Name: %s\n", entry.Name)
-		if len(entry.Aliases) > 0 {
-			output += fmt.Sprintf("Aliases: %v\n", entry.Aliases)
-		}
-		if entry.ExternalID != "" {
-			output += fmt.Sprintf("External ID: %s\n", entry.ExternalID)
-		}
-		output += fmt.Sprintf("Rank: %d\n", entry.Rank)
-		if len(entry.AttributeValues) > 0 {
-			output += "Attributes:\n"
-			for key, value := range entry.AttributeValues {
-				if value.Value != nil {
-					if value.Value.Literal != "" {
-						output += fmt.Sprintf("  %s: %s\n", key, value.Value.Literal)
-					} else if value.Value.ID != "" {
-						output += fmt.Sprintf("  %s: %s (ID)\n", key, value.Value.ID)
-					}
-				}
-				if len(value.ArrayValue) > 0 {
-					output += fmt.Sprintf("  %s: [", key)
-					for i, v := range value.ArrayValue {
-						if i > 0 {
-							output += ", "
-						}
-						if v.Literal != "" {
-							output += v.Literal
-						} else if v.ID != "" {
-							output += v.ID + " (ID)"
-						}
-					}
-					output += "]\n"
-				}
-			}
-		}
-		output += fmt.Sprintf("Created: %s\n", entry.CreatedAt.Format("2006-01-02 15:04:05"))
-		output += fmt.Sprintf("Updated: %s\n", entry.UpdatedAt.Format("2006-01-02 15:04:05"))
+		output += formatCatalogEntry(entry)
 		output += "\n"
 	}
 
@@ -242,13 +234,7 @@ func (t *ListCatalogEntriesTool) Execute(args map[string]interface{}) (string, e
 	}
 	output += fmt.Sprintf("Total entries: %d\n", result.PaginationMeta.TotalRecordCount)
 
-	// Also return the raw JSON
-	jsonOutput, err := json.MarshalIndent(result, "", "  ")
-	if err != nil {
-		return output, nil
-	}
-
-	return output + "\nRaw JSON:\n" + string(jsonOutput), nil
+	return withRawJSON(output, result, args)
 }
 
 // UpdateCatalogEntryTool updates a catalog entry
@@ -332,7 +318,7 @@ func (t *UpdateCatalogEntryTool) InputSchema() map[string]interface{} {
 	}
 }
 
-func (t *UpdateCatalogEntryTool) Execute(args map[string]interface{}) (string, error) {
+func (t *UpdateCatalogEntryTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
 	id, ok := args["id"].(string)
 	if !ok || id == "" {
 		return "", fmt.Errorf("id parameter is required")
@@ -368,40 +354,7 @@ func (t *UpdateCatalogEntryTool) Execute(args map[string]interface{}) (string, e
 	}
 
 	if attrValues, ok := args["attribute_values"].(map[string]interface{}); ok {
-		req.AttributeValues = make(map[string]incidentio.CatalogEntryAttributeValue)
-		for key, value := range attrValues {
-			if valueMap, ok := value.(map[string]interface{}); ok {
-				attrValue := incidentio.CatalogEntryAttributeValue{}
-
-				// Handle single value
-				if v, ok := valueMap["value"].(map[string]interface{}); ok {
-					attrValue.Value = &incidentio.CatalogEntryAttributeValueItem{}
-					if literal, ok := v["literal"].(string); ok {
-						attrValue.Value.Literal = literal
-					}
-					if id, ok := v["id"].(string); ok {
-						attrValue.Value.ID = id
-					}
-				}
-
-				// Handle array value
-				if arrayValue, ok := valueMap["array_value"].([]interface{}); ok {
-					attrValue.ArrayValue = make([]incidentio.CatalogEntryAttributeValueItem, len(arrayValue))
-					for i, item := range arrayValue {
-						if itemMap, ok := item.(map[string]interface{}); ok {
-							if literal, ok := itemMap["literal"].(string); ok {
-								attrValue.ArrayValue[i].Literal = literal
-							}
-							if id, ok := itemMap["id"].(string); ok {
-								attrValue.ArrayValue[i].ID = id
-							}
-						}
-					}
-				}
-
-				req.AttributeValues[key] = attrValue
-			}
-		}
+		req.AttributeValues = parseCatalogAttributeValues(attrValues)
 	}
 
 	if updateAttrs, ok := args["update_attributes"].([]interface{}); ok {
@@ -413,7 +366,7 @@ func (t *UpdateCatalogEntryTool) Execute(args map[string]interface{}) (string, e
 		}
 	}
 
-	result, err := t.client.UpdateCatalogEntry(id, req)
+	result, err := t.client.UpdateCatalogEntry(ctx, id, req)
 	if err != nil {
 		return "", fmt.Errorf("failed to update catalog entry: %w", err)
 	}
@@ -439,3 +392,333 @@ func (t *UpdateCatalogEntryTool) Execute(args map[string]interface{}) (string, e
 
 	return output + "\nRaw JSON:\n" + string(jsonOutput), nil
 }
+
+// parseCatalogAttributeValues converts attribute_values tool arguments into
+// catalog entry attribute values. Each attribute may have a single "value" or
+// an "array_value", and each item may be a literal or a reference by ID.
+func parseCatalogAttributeValues(attrValues map[string]interface{}) map[string]incidentio.CatalogEntryAttributeValue {
+	result := make(map[string]incidentio.CatalogEntryAttributeValue)
+	for key, value := range attrValues {
+		valueMap, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		attrValue := incidentio.CatalogEntryAttributeValue{}
+
+		// Handle single value
+		if v, ok := valueMap["value"].(map[string]interface{}); ok {
+			item := parseCatalogAttributeValueItem(v)
+			attrValue.Value = &item
+		}
+
+		// Handle array value
+		if arrayValue, ok := valueMap["array_value"].([]interface{}); ok {
+			attrValue.ArrayValue = make([]incidentio.CatalogEntryAttributeValueItem, len(arrayValue))
+			for i, item := range arrayValue {
+				if itemMap, ok := item.(map[string]interface{}); ok {
+					attrValue.ArrayValue[i] = parseCatalogAttributeValueItem(itemMap)
+				}
+			}
+		}
+
+		result[key] = attrValue
+	}
+	return result
+}
+
+func parseCatalogAttributeValueItem(v map[string]interface{}) incidentio.CatalogEntryAttributeValueItem {
+	item := incidentio.CatalogEntryAttributeValueItem{}
+	if literal, ok := v["literal"].(string); ok {
+		item.Literal = literal
+	}
+	if id, ok := v["id"].(string); ok {
+		item.ID = id
+	}
+	return item
+}
+
+// CreateCatalogEntryTool creates a catalog entry
+type CreateCatalogEntryTool struct {
+	client *incidentio.Client
+}
+
+func NewCreateCatalogEntryTool(client *incidentio.Client) *CreateCatalogEntryTool {
+	return &CreateCatalogEntryTool{client: client}
+}
+
+func (t *CreateCatalogEntryTool) Name() string {
+	return "create_catalog_entry"
+}
+
+func (t *CreateCatalogEntryTool) Description() string {
+	return `Create a new entry in a catalog type, e.g. to onboard a new service or team.
+
+USAGE WORKFLOW:
+1. First call 'list_catalog_types' to find the catalog type ID and its attribute IDs
+2. Prepare the entry name and any attribute values
+3. Call this tool to create the entry
+
+PARAMETERS:
+- catalog_type_id: Required. The catalog type to create the entry in
+- name: Required. Name of the new entry
+- aliases: Optional. Array of alias strings
+- external_id: Optional. External system ID
+- rank: Optional. Sort order/rank integer
+- attribute_values: Optional. Object mapping attribute IDs to values, in the same shape as update_catalog_entry
+
+EXAMPLES:
+- Minimal: {"catalog_type_id": "type_123", "name": "payments-api"}
+- With attributes: {"catalog_type_id": "type_123", "name": "payments-api", "external_id": "svc-42", "attribute_values": {"attr_tier": {"value": {"literal": "tier-1"}}, "attr_owners": {"array_value": [{"id": "team_payments"}]}}}`
+}
+
+func (t *CreateCatalogEntryTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"catalog_type_id": map[string]interface{}{
+				"type":        "string",
+				"description": "The catalog type ID to create the entry in",
+			},
+			"name": map[string]interface{}{
+				"type":        "string",
+				"description": "Name of the catalog entry",
+			},
+			"aliases": map[string]interface{}{
+				"type":        "array",
+				"description": "List of aliases for the catalog entry",
+				"items": map[string]interface{}{
+					"type": "string",
+				},
+			},
+			"external_id": map[string]interface{}{
+				"type":        "string",
+				"description": "External ID for the catalog entry",
+			},
+			"rank": map[string]interface{}{
+				"type":        "integer",
+				"description": "Rank/order of the catalog entry",
+			},
+			"attribute_values": map[string]interface{}{
+				"type":        "object",
+				"description": "Attribute values as a JSON object",
+			},
+		},
+		"required":             []interface{}{"catalog_type_id", "name"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *CreateCatalogEntryTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	catalogTypeID, ok := args["catalog_type_id"].(string)
+	if !ok || catalogTypeID == "" {
+		return "", fmt.Errorf("catalog_type_id parameter is required")
+	}
+
+	name, ok := args["name"].(string)
+	if !ok || name == "" {
+		return "", fmt.Errorf("name parameter is required")
+	}
+
+	req := incidentio.CreateCatalogEntryRequest{
+		CatalogTypeID: catalogTypeID,
+		Name:          name,
+	}
+
+	if aliases, ok := args["aliases"].([]interface{}); ok {
+		for _, alias := range aliases {
+			if s, ok := alias.(string); ok {
+				req.Aliases = append(req.Aliases, s)
+			}
+		}
+	}
+
+	if externalID, ok := args["external_id"].(string); ok {
+		req.ExternalID = externalID
+	}
+
+	if rank, ok := args["rank"].(float64); ok {
+		req.Rank = int(rank)
+	}
+
+	if attrValues, ok := args["attribute_values"].(map[string]interface{}); ok {
+		req.AttributeValues = parseCatalogAttributeValues(attrValues)
+	}
+
+	result, err := t.client.CreateCatalogEntry(ctx, req)
+	if err != nil {
+		return "", fmt.Errorf("failed to create catalog entry: %w", err)
+	}
+
+	output := "Created catalog entry:\n\n"
+	output += fmt.Sprintf("ID: %s\n", result.ID)
+	output += fmt.Sprintf("Name: %s\n", result.Name)
+	output += fmt.Sprintf("Catalog Type ID: %s\n", result.CatalogTypeID)
+
+	jsonOutput, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return output, nil
+	}
+
+	return output + "\nRaw JSON:\n" + string(jsonOutput), nil
+}
+
+// DeleteCatalogEntryTool deletes a catalog entry
+type DeleteCatalogEntryTool struct {
+	client *incidentio.Client
+}
+
+func NewDeleteCatalogEntryTool(client *incidentio.Client) *DeleteCatalogEntryTool {
+	return &DeleteCatalogEntryTool{client: client}
+}
+
+func (t *DeleteCatalogEntryTool) Name() string {
+	return "delete_catalog_entry"
+}
+
+func (t *DeleteCatalogEntryTool) Description() string {
+	return `Delete a catalog entry. This cannot be undone.
+
+USAGE WORKFLOW:
+1. First call 'list_catalog_entries' to find the entry you want to delete
+2. Call this tool with the entry ID
+
+PARAMETERS:
+- id: Required. The catalog entry ID to delete
+
+EXAMPLES:
+- Delete entry: {"id": "entry_123"}`
+}
+
+func (t *DeleteCatalogEntryTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"id": map[string]interface{}{
+				"type":        "string",
+				"description": "The catalog entry ID to delete",
+			},
+		},
+		"required":             []interface{}{"id"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *DeleteCatalogEntryTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	id, ok := args["id"].(string)
+	if !ok || id == "" {
+		return "", fmt.Errorf("id parameter is required")
+	}
+
+	if err := t.client.DeleteCatalogEntry(ctx, id); err != nil {
+		if incidentio.IsNotFound(err) {
+			return "", fmt.Errorf("catalog entry not found: %s", id)
+		}
+		return "", fmt.Errorf("failed to delete catalog entry: %w", err)
+	}
+
+	return fmt.Sprintf("Catalog entry %s deleted successfully", id), nil
+}
+
+// formatCatalogEntry renders a catalog entry and its attribute values as
+// human-readable text
+func formatCatalogEntry(entry incidentio.CatalogEntry) string {
+	output := fmt.Sprintf("ID: %s\n", entry.ID)
+	output += fmt.Sprintf("Name: %s\n", entry.Name)
+	if len(entry.Aliases) > 0 {
+		output += fmt.Sprintf("Aliases: %v\n", entry.Aliases)
+	}
+	if entry.ExternalID != "" {
+		output += fmt.Sprintf("External ID: %s\n", entry.ExternalID)
+	}
+	output += fmt.Sprintf("Rank: %d\n", entry.Rank)
+	if len(entry.AttributeValues) > 0 {
+		output += "Attributes:\n"
+		for key, value := range entry.AttributeValues {
+			if value.Value != nil {
+				if value.Value.Literal != "" {
+					output += fmt.Sprintf("  %s: %s\n", key, value.Value.Literal)
+				} else if value.Value.ID != "" {
+					output += fmt.Sprintf("  %s: %s (ID)\n", key, value.Value.ID)
+				}
+			}
+			if len(value.ArrayValue) > 0 {
+				output += fmt.Sprintf("  %s: [", key)
+				for i, v := range value.ArrayValue {
+					if i > 0 {
+						output += ", "
+					}
+					if v.Literal != "" {
+						output += v.Literal
+					} else if v.ID != "" {
+						output += v.ID + " (ID)"
+					}
+				}
+				output += "]\n"
+			}
+		}
+	}
+	output += fmt.Sprintf("Created: %s\n", entry.CreatedAt.Format("2006-01-02 15:04:05"))
+	output += fmt.Sprintf("Updated: %s\n", entry.UpdatedAt.Format("2006-01-02 15:04:05"))
+	return output
+}
+
+// GetCatalogEntryTool retrieves a single catalog entry
+type GetCatalogEntryTool struct {
+	client *incidentio.Client
+}
+
+func NewGetCatalogEntryTool(client *incidentio.Client) *GetCatalogEntryTool {
+	return &GetCatalogEntryTool{client: client}
+}
+
+func (t *GetCatalogEntryTool) Name() string {
+	return "get_catalog_entry"
+}
+
+func (t *GetCatalogEntryTool) Description() string {
+	return `Get a single catalog entry by ID, including all of its attribute values.
+
+USAGE WORKFLOW:
+1. Get the entry ID from list_catalog_entries or an incident's custom field values
+2. Call this tool to read the full entry
+
+PARAMETERS:
+- id: Required. The catalog entry ID to retrieve
+
+EXAMPLES:
+- Get entry: {"id": "entry_123"}`
+}
+
+func (t *GetCatalogEntryTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"id": map[string]interface{}{
+				"type":        "string",
+				"description": "The catalog entry ID to retrieve",
+			},
+			"fields": fieldsProperty(),
+		},
+		"required":             []interface{}{"id"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *GetCatalogEntryTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	id, ok := args["id"].(string)
+	if !ok || id == "" {
+		return "", fmt.Errorf("id parameter is required")
+	}
+
+	entry, err := t.client.GetCatalogEntry(ctx, id)
+	if err != nil {
+		if incidentio.IsNotFound(err) {
+			return "", fmt.Errorf("catalog entry not found: %s", id)
+		}
+		return "", fmt.Errorf("failed to get catalog entry: %w", err)
+	}
+
+	output := "Catalog entry:\n\n" + formatCatalogEntry(*entry)
+	return withRawJSON(output, entry, args)
+}
@@ -0,0 +1,115 @@
+package tools
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+func TestParseCatalogAttributeValues(t *testing.T) {
+	got := parseCatalogAttributeValues(map[string]interface{}{
+		"attr_tier": map[string]interface{}{
+			"value": map[string]interface{}{"literal": "tier-1"},
+		},
+		"attr_owners": map[string]interface{}{
+			"array_value": []interface{}{
+				map[string]interface{}{"id": "team_payments"},
+				map[string]interface{}{"literal": "platform"},
+			},
+		},
+		"attr_invalid": "not an object",
+	})
+
+	expected := map[string]incidentio.CatalogEntryAttributeValue{
+		"attr_tier": {Value: &incidentio.CatalogEntryAttributeValueItem{Literal: "tier-1"}},
+		"attr_owners": {ArrayValue: []incidentio.CatalogEntryAttributeValueItem{
+			{ID: "team_payments"},
+			{Literal: "platform"},
+		}},
+	}
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestCreateCatalogEntryTool_RequiredParameters(t *testing.T) {
+	tool := &CreateCatalogEntryTool{}
+
+	tests := []struct {
+		name string
+		args map[string]interface{}
+	}{
+		{name: "missing catalog_type_id", args: map[string]interface{}{"name": "payments-api"}},
+		{name: "missing name", args: map[string]interface{}{"catalog_type_id": "type_123"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tool.Execute(context.Background(), tt.args); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestDeleteCatalogEntryTool_RequiresID(t *testing.T) {
+	tool := &DeleteCatalogEntryTool{}
+
+	for _, args := range []map[string]interface{}{{}, {"id": ""}} {
+		if _, err := tool.Execute(context.Background(), args); err == nil {
+			t.Errorf("expected error for args %v", args)
+		}
+	}
+}
+
+func TestFilterCustomCatalogTypes(t *testing.T) {
+	pages := [][]incidentio.CatalogType{
+		{
+			{ID: "type_1", TypeName: `Custom["Service"]`},
+			{ID: "type_2", TypeName: "GitHubRepository"},
+		},
+		{
+			{ID: "type_3", TypeName: "Schedule"},
+			{ID: "type_4", TypeName: `custom["Team"]`},
+		},
+	}
+	expected := [][]string{{"type_1"}, {"type_4"}}
+
+	for i, page := range pages {
+		var ids []string
+		for _, catalogType := range filterCustomCatalogTypes(page) {
+			ids = append(ids, catalogType.ID)
+		}
+		if !reflect.DeepEqual(expected[i], ids) {
+			t.Errorf("page %d: expected %v, got %v", i+1, expected[i], ids)
+		}
+	}
+}
+
+func TestFormatCatalogEntry_ArrayAttributes(t *testing.T) {
+	output := formatCatalogEntry(incidentio.CatalogEntry{
+		ID:   "entry_123",
+		Name: "payments-api",
+		AttributeValues: map[string]incidentio.CatalogEntryAttributeValue{
+			"attr_owners": {ArrayValue: []incidentio.CatalogEntryAttributeValueItem{
+				{ID: "team_payments"},
+				{Literal: "platform"},
+			}},
+		},
+	})
+
+	for _, expected := range []string{"ID: entry_123", "Name: payments-api", "attr_owners: [team_payments (ID), platform]"} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("expected output to contain %q, got:\n%s", expected, output)
+		}
+	}
+}
+
+func TestGetCatalogEntryTool_RequiresID(t *testing.T) {
+	if _, err := (&GetCatalogEntryTool{}).Execute(context.Background(), map[string]interface{}{}); err == nil {
+		t.Fatal("expected error for missing id")
+	}
+}
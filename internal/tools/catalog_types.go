@@ -0,0 +1,432 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+// catalogPrimitiveTypes are the attribute types that hold a plain value
+// rather than a reference to another catalog type, keyed by lower-case name
+var catalogPrimitiveTypes = map[string]string{
+	"string": "String",
+	"text":   "Text",
+	"number": "Number",
+	"bool":   "Bool",
+}
+
+// customCatalogTypeName matches the type_name of a catalog type managed
+// through the API, e.g. Custom["Service"]
+var customCatalogTypeName = regexp.MustCompile(`^Custom\["[^"]+"\]$`)
+
+// catalogAttributesProperty is the input schema of the attributes argument
+func catalogAttributesProperty(description string) map[string]interface{} {
+	return map[string]interface{}{
+		"type":        "array",
+		"description": description,
+		"items": map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of an existing attribute to keep (update only)",
+				},
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Attribute name",
+				},
+				"type": map[string]interface{}{
+					"type":        "string",
+					"description": "String, Text, Number, Bool, or the type_name of a catalog type such as Custom[\"Team\"]",
+				},
+				"array": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Whether the attribute holds a list of values",
+				},
+			},
+			"required":             []interface{}{"name", "type"},
+			"additionalProperties": false,
+		},
+	}
+}
+
+// parseCatalogAttributes reads the attributes argument. Names must be unique
+// and primitive types are normalized, e.g. "string" becomes "String"; the
+// returned flag is true if any attribute references another catalog type.
+func parseCatalogAttributes(raw interface{}) ([]incidentio.CatalogAttribute, bool, error) {
+	items, ok := raw.([]interface{})
+	if !ok {
+		return nil, false, fmt.Errorf("attributes must be an array of objects")
+	}
+
+	attributes := make([]incidentio.CatalogAttribute, 0, len(items))
+	seen := map[string]bool{}
+	hasReferences := false
+	for i, item := range items {
+		obj, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, false, fmt.Errorf("attributes[%d] must be an object", i)
+		}
+
+		name, _ := obj["name"].(string)
+		name = strings.TrimSpace(name)
+		if name == "" {
+			return nil, false, fmt.Errorf("attributes[%d].name is required", i)
+		}
+		if seen[strings.ToLower(name)] {
+			return nil, false, fmt.Errorf("attribute %q is listed more than once", name)
+		}
+		seen[strings.ToLower(name)] = true
+
+		attrType, _ := obj["type"].(string)
+		attrType = strings.TrimSpace(attrType)
+		if attrType == "" {
+			return nil, false, fmt.Errorf("attribute %q needs a type: one of String, Text, Number, Bool, or the type_name of a catalog type", name)
+		}
+		if primitive, ok := catalogPrimitiveTypes[strings.ToLower(attrType)]; ok {
+			attrType = primitive
+		} else {
+			hasReferences = true
+		}
+
+		attribute := incidentio.CatalogAttribute{Name: name, Type: attrType}
+		attribute.ID, _ = obj["id"].(string)
+		attribute.Array, _ = obj["array"].(bool)
+		attributes = append(attributes, attribute)
+	}
+
+	return attributes, hasReferences, nil
+}
+
+// validateCatalogAttributeTypes checks that every attribute that is not a
+// primitive references an existing catalog type, or selfTypeName (a type may
+// reference itself). The catalog types are only fetched when needed.
+func validateCatalogAttributeTypes(ctx context.Context, client *incidentio.Client, attributes []incidentio.CatalogAttribute, hasReferences bool, selfTypeName string) error {
+	if !hasReferences {
+		return nil
+	}
+
+	known := map[string]bool{}
+	if selfTypeName != "" {
+		known[selfTypeName] = true
+	}
+	opts := incidentio.ListCatalogTypesOptions{PageSize: 250}
+	guard := incidentio.NewPaginationGuard("catalog types", "")
+	for {
+		resp, err := client.ListCatalogTypes(ctx, opts)
+		if err != nil {
+			return fmt.Errorf("failed to list catalog types to check attribute types: %w", err)
+		}
+		for _, catalogType := range resp.CatalogTypes {
+			known[catalogType.TypeName] = true
+		}
+
+		if resp.PaginationMeta.After == "" || len(resp.CatalogTypes) == 0 {
+			break
+		}
+		if err := guard.Next(resp.PaginationMeta.After, len(resp.CatalogTypes)); err != nil {
+			return err
+		}
+		opts.After = resp.PaginationMeta.After
+	}
+
+	for _, attribute := range attributes {
+		if _, ok := catalogPrimitiveTypes[strings.ToLower(attribute.Type)]; ok || known[attribute.Type] {
+			continue
+		}
+		return fmt.Errorf("attribute %q has invalid type %q: must be String, Text, Number, Bool, or the type_name of an existing catalog type (use list_catalog_types to find it)", attribute.Name, attribute.Type)
+	}
+	return nil
+}
+
+// formatCatalogType formats a catalog type for display
+func formatCatalogType(catalogType *incidentio.CatalogType) string {
+	output := fmt.Sprintf("ID: %s\n", catalogType.ID)
+	output += fmt.Sprintf("Name: %s\n", catalogType.Name)
+	output += fmt.Sprintf("Type Name: %s\n", catalogType.TypeName)
+	if catalogType.Description != "" {
+		output += fmt.Sprintf("Description: %s\n", catalogType.Description)
+	}
+
+	attributes := catalogType.Attributes
+	if catalogType.Schema != nil && len(catalogType.Schema.Attributes) > 0 {
+		attributes = catalogType.Schema.Attributes
+	}
+	if len(attributes) > 0 {
+		output += fmt.Sprintf("Attributes (%d):\n", len(attributes))
+		for _, attr := range attributes {
+			attrType := attr.Type
+			if attr.Array {
+				attrType += ", array"
+			}
+			output += fmt.Sprintf("  - %s (%s): %s\n", attr.Name, attrType, attr.ID)
+		}
+	}
+	return output
+}
+
+// CreateCatalogTypeTool creates a catalog type and its attributes
+type CreateCatalogTypeTool struct {
+	client *incidentio.Client
+}
+
+func NewCreateCatalogTypeTool(client *incidentio.Client) *CreateCatalogTypeTool {
+	return &CreateCatalogTypeTool{client: client}
+}
+
+func (t *CreateCatalogTypeTool) Name() string {
+	return "create_catalog_type"
+}
+
+func (t *CreateCatalogTypeTool) Description() string {
+	return `Create a new catalog type, optionally with attributes.
+
+USAGE WORKFLOW:
+1. Call 'list_catalog_types' to check the type does not already exist, and to find the type_name of any type an attribute should reference
+2. Call this tool with a name, description and attributes
+3. Use the returned type ID with create_catalog_entry to add entries
+
+PARAMETERS:
+- name: Required. Human readable name, e.g. "Service"
+- description: Required. What the catalog type represents
+- type_name: Optional. Name used to reference this type from attributes, in the form Custom["Service"]. Cannot be changed later
+- attributes: Optional. Array of {name, type, array} objects. type is String, Text, Number, Bool, or the type_name of a catalog type
+
+EXAMPLES:
+- Simple type: {"name": "Service", "description": "Services we run"}
+- With attributes: {"name": "Service", "description": "Services we run", "type_name": "Custom[\"Service\"]", "attributes": [{"name": "Tier", "type": "Number"}, {"name": "Owners", "type": "Custom[\"Team\"]", "array": true}]}`
+}
+
+func (t *CreateCatalogTypeTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"name": map[string]interface{}{
+				"type":        "string",
+				"description": "Name of the catalog type",
+			},
+			"description": map[string]interface{}{
+				"type":        "string",
+				"description": "Description of the catalog type",
+			},
+			"type_name": map[string]interface{}{
+				"type":        "string",
+				"description": "Name used to reference this type from attributes, e.g. Custom[\"Service\"]",
+			},
+			"attributes": catalogAttributesProperty("Attributes of the catalog type"),
+		},
+		"required":             []interface{}{"name", "description"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *CreateCatalogTypeTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	name, ok := args["name"].(string)
+	if !ok || name == "" {
+		return "", fmt.Errorf("name parameter is required")
+	}
+
+	description, ok := args["description"].(string)
+	if !ok || description == "" {
+		return "", fmt.Errorf("description parameter is required")
+	}
+
+	req := incidentio.CreateCatalogTypeRequest{Name: name, Description: description}
+	if typeName, ok := args["type_name"].(string); ok && typeName != "" {
+		if !customCatalogTypeName.MatchString(typeName) {
+			return "", fmt.Errorf("type_name must be in the form Custom[\"Name\"], got %q", typeName)
+		}
+		req.TypeName = typeName
+	}
+
+	var attributes []incidentio.CatalogAttribute
+	if raw, ok := args["attributes"]; ok {
+		var hasReferences bool
+		var err error
+		attributes, hasReferences, err = parseCatalogAttributes(raw)
+		if err != nil {
+			return "", err
+		}
+		for _, attribute := range attributes {
+			if attribute.ID != "" {
+				return "", fmt.Errorf("attribute %q: id can only be set when updating a catalog type", attribute.Name)
+			}
+		}
+		// Check before creating the type, so a bad attribute does not leave
+		// a type behind without its attributes
+		if err := validateCatalogAttributeTypes(ctx, t.client, attributes, hasReferences, req.TypeName); err != nil {
+			return "", err
+		}
+	}
+
+	result, err := t.client.CreateCatalogType(ctx, req)
+	if err != nil {
+		return "", fmt.Errorf("failed to create catalog type: %w", err)
+	}
+
+	if len(attributes) > 0 {
+		version := 0
+		if result.Schema != nil {
+			version = result.Schema.Version
+		}
+		result, err = t.client.UpdateCatalogTypeSchema(ctx, result.ID, incidentio.UpdateCatalogTypeSchemaRequest{
+			Version:    version,
+			Attributes: attributes,
+		})
+		if err != nil {
+			return "", fmt.Errorf("created catalog type but failed to add its attributes: %w", err)
+		}
+	}
+
+	return withRawJSON("Created catalog type:\n\n"+formatCatalogType(result), result, args)
+}
+
+// UpdateCatalogTypeTool updates a catalog type's details and attributes
+type UpdateCatalogTypeTool struct {
+	client *incidentio.Client
+}
+
+func NewUpdateCatalogTypeTool(client *incidentio.Client) *UpdateCatalogTypeTool {
+	return &UpdateCatalogTypeTool{client: client}
+}
+
+func (t *UpdateCatalogTypeTool) Name() string {
+	return "update_catalog_type"
+}
+
+func (t *UpdateCatalogTypeTool) Description() string {
+	return `Update an existing catalog type's name, description or attributes.
+
+USAGE WORKFLOW:
+1. Call 'list_catalog_types' to find the type ID and its current attributes
+2. Call this tool with the ID and the values to change. Values you leave out are kept
+
+PARAMETERS:
+- id: Required. The catalog type ID to update
+- name: Optional. New name
+- description: Optional. New description
+- attributes: Optional. The complete new list of attributes, as {name, type, array} objects. Attributes left out are REMOVED. An attribute keeps its existing values if it has the same name as an existing one, or its id is given
+
+The type_name of a catalog type cannot be changed.
+
+EXAMPLES:
+- Update description: {"id": "01HXYZ...", "description": "Services we run in production"}
+- Add an attribute: {"id": "01HXYZ...", "attributes": [{"name": "Tier", "type": "Number"}, {"name": "Runbook", "type": "Text"}]}`
+}
+
+func (t *UpdateCatalogTypeTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"id": map[string]interface{}{
+				"type":        "string",
+				"description": "The catalog type ID to update",
+			},
+			"name": map[string]interface{}{
+				"type":        "string",
+				"description": "New name for the catalog type",
+			},
+			"description": map[string]interface{}{
+				"type":        "string",
+				"description": "New description for the catalog type",
+			},
+			"attributes": catalogAttributesProperty("The complete new list of attributes. Attributes left out are removed"),
+		},
+		"required":             []interface{}{"id"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *UpdateCatalogTypeTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	id, ok := args["id"].(string)
+	if !ok || id == "" {
+		return "", fmt.Errorf("id parameter is required")
+	}
+
+	name, _ := args["name"].(string)
+	description, _ := args["description"].(string)
+	rawAttributes, hasAttributes := args["attributes"]
+	if name == "" && description == "" && !hasAttributes {
+		return "", fmt.Errorf("at least one of name, description or attributes must be provided")
+	}
+
+	var attributes []incidentio.CatalogAttribute
+	var hasReferences bool
+	if hasAttributes {
+		var err error
+		attributes, hasReferences, err = parseCatalogAttributes(rawAttributes)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	// The API replaces every detail of the type, so start from its current
+	// values. The schema version is needed to change attributes as well.
+	current, err := t.client.GetCatalogType(ctx, id)
+	if err != nil {
+		return "", fmt.Errorf("failed to get catalog type: %w", err)
+	}
+
+	if hasAttributes {
+		if err := validateCatalogAttributeTypes(ctx, t.client, attributes, hasReferences, current.TypeName); err != nil {
+			return "", err
+		}
+	}
+
+	result := current
+	if name != "" || description != "" {
+		req := incidentio.UpdateCatalogTypeRequest{
+			Name:          current.Name,
+			Description:   current.Description,
+			Color:         current.Color,
+			Icon:          current.Icon,
+			Annotations:   current.Annotations,
+			Categories:    current.Categories,
+			Ranked:        current.Ranked,
+			SourceRepoURL: current.SourceRepoURL,
+		}
+		if name != "" {
+			req.Name = name
+		}
+		if description != "" {
+			req.Description = description
+		}
+
+		result, err = t.client.UpdateCatalogType(ctx, id, req)
+		if err != nil {
+			return "", fmt.Errorf("failed to update catalog type: %w", err)
+		}
+	}
+
+	if hasAttributes {
+		schema := current.Schema
+		if schema == nil {
+			schema = &incidentio.CatalogTypeSchema{}
+		}
+
+		// Keep the values of attributes that are being kept, which the API
+		// identifies by ID
+		existingIDs := map[string]string{}
+		for _, attribute := range schema.Attributes {
+			existingIDs[strings.ToLower(attribute.Name)] = attribute.ID
+		}
+		for i := range attributes {
+			if attributes[i].ID == "" {
+				attributes[i].ID = existingIDs[strings.ToLower(attributes[i].Name)]
+			}
+		}
+
+		result, err = t.client.UpdateCatalogTypeSchema(ctx, id, incidentio.UpdateCatalogTypeSchemaRequest{
+			Version:    schema.Version,
+			Attributes: attributes,
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to update catalog type attributes: %w", err)
+		}
+	}
+
+	return withRawJSON("Updated catalog type:\n\n"+formatCatalogType(result), result, args)
+}
@@ -0,0 +1,231 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+func TestCreateCatalogTypeTool_WithAttributes(t *testing.T) {
+	var created incidentio.CreateCatalogTypeRequest
+	var schema incidentio.UpdateCatalogTypeSchemaRequest
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v3/catalog_types":
+			fmt.Fprint(w, `{"catalog_types": [{"id": "type_team", "name": "Team", "type_name": "Custom[\"Team\"]"}], "pagination_meta": {}}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/v3/catalog_types":
+			if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+				t.Fatalf("failed to decode request: %v", err)
+			}
+			fmt.Fprint(w, `{"catalog_type": {"id": "type_service", "name": "Service", "type_name": "Custom[\"Service\"]", "schema": {"version": 1, "attributes": []}}}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/v3/catalog_types/type_service/actions/update_schema":
+			if err := json.NewDecoder(r.Body).Decode(&schema); err != nil {
+				t.Fatalf("failed to decode request: %v", err)
+			}
+			fmt.Fprint(w, `{"catalog_type": {"id": "type_service", "name": "Service", "type_name": "Custom[\"Service\"]", "schema": {"version": 2, "attributes": [
+				{"id": "attr_tier", "name": "Tier", "type": "Number", "array": false},
+				{"id": "attr_owners", "name": "Owners", "type": "Custom[\"Team\"]", "array": true}
+			]}}}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	result, err := NewCreateCatalogTypeTool(client).Execute(context.Background(), map[string]interface{}{
+		"name":        "Service",
+		"description": "Services we run",
+		"type_name":   `Custom["Service"]`,
+		"attributes": []interface{}{
+			map[string]interface{}{"name": "Tier", "type": "number"},
+			map[string]interface{}{"name": "Owners", "type": `Custom["Team"]`, "array": true},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectedType := incidentio.CreateCatalogTypeRequest{Name: "Service", Description: "Services we run", TypeName: `Custom["Service"]`}
+	if created != expectedType {
+		t.Errorf("expected create request %+v, got %+v", expectedType, created)
+	}
+	expectedSchema := incidentio.UpdateCatalogTypeSchemaRequest{
+		Version: 1,
+		Attributes: []incidentio.CatalogAttribute{
+			{Name: "Tier", Type: "Number"},
+			{Name: "Owners", Type: `Custom["Team"]`, Array: true},
+		},
+	}
+	if !reflect.DeepEqual(schema, expectedSchema) {
+		t.Errorf("expected schema request %+v, got %+v", expectedSchema, schema)
+	}
+	if !strings.Contains(result, "Attributes (2):") || !strings.Contains(result, `Owners (Custom["Team"], array): attr_owners`) {
+		t.Errorf("expected both attributes in the result, got %s", result)
+	}
+}
+
+func TestCreateCatalogTypeTool_InvalidAttributeTypes(t *testing.T) {
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/v3/catalog_types" {
+			t.Errorf("expected no request other than listing catalog types, got %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprint(w, `{"catalog_types": [{"id": "type_team", "name": "Team", "type_name": "Custom[\"Team\"]"}], "pagination_meta": {}}`)
+	})
+	tool := NewCreateCatalogTypeTool(client)
+
+	tests := []struct {
+		name       string
+		attributes []interface{}
+		wantErr    string
+	}{
+		{
+			name:       "misspelled primitive",
+			attributes: []interface{}{map[string]interface{}{"name": "Tier", "type": "Integer"}},
+			wantErr:    `attribute "Tier" has invalid type "Integer"`,
+		},
+		{
+			name:       "unknown catalog type",
+			attributes: []interface{}{map[string]interface{}{"name": "Owners", "type": `Custom["Squad"]`}},
+			wantErr:    `attribute "Owners" has invalid type "Custom[\"Squad\"]"`,
+		},
+		{
+			name:       "missing type",
+			attributes: []interface{}{map[string]interface{}{"name": "Tier"}},
+			wantErr:    `attribute "Tier" needs a type`,
+		},
+		{
+			name: "duplicate name",
+			attributes: []interface{}{
+				map[string]interface{}{"name": "Tier", "type": "Number"},
+				map[string]interface{}{"name": "tier", "type": "String"},
+			},
+			wantErr: `attribute "tier" is listed more than once`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tool.Execute(context.Background(), map[string]interface{}{
+				"name":        "Service",
+				"description": "Services we run",
+				"attributes":  tt.attributes,
+			})
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	if _, err := tool.Execute(context.Background(), map[string]interface{}{
+		"name":        "Service",
+		"description": "Services we run",
+		"type_name":   "Service",
+	}); err == nil || !strings.Contains(err.Error(), `type_name must be in the form Custom["Name"]`) {
+		t.Errorf("expected a type_name error, got %v", err)
+	}
+}
+
+func TestUpdateCatalogTypeTool_Description(t *testing.T) {
+	var updated incidentio.UpdateCatalogTypeRequest
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v3/catalog_types/type_service":
+			fmt.Fprint(w, `{"catalog_type": {"id": "type_service", "name": "Service", "description": "Services",
+				"type_name": "Custom[\"Service\"]", "color": "blue", "icon": "box", "ranked": true,
+				"schema": {"version": 2, "attributes": [{"id": "attr_tier", "name": "Tier", "type": "Number"}]}}}`)
+		case r.Method == http.MethodPut && r.URL.Path == "/v3/catalog_types/type_service":
+			if err := json.NewDecoder(r.Body).Decode(&updated); err != nil {
+				t.Fatalf("failed to decode request: %v", err)
+			}
+			fmt.Fprintf(w, `{"catalog_type": {"id": "type_service", "name": "Service", "description": %q, "type_name": "Custom[\"Service\"]"}}`, updated.Description)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	result, err := NewUpdateCatalogTypeTool(client).Execute(context.Background(), map[string]interface{}{
+		"id":          "type_service",
+		"description": "Services we run in production",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Values that were not changed are sent back as they were
+	expected := incidentio.UpdateCatalogTypeRequest{
+		Name:        "Service",
+		Description: "Services we run in production",
+		Color:       "blue",
+		Icon:        "box",
+		Ranked:      true,
+	}
+	if !reflect.DeepEqual(updated, expected) {
+		t.Errorf("expected update request %+v, got %+v", expected, updated)
+	}
+	if !strings.Contains(result, "Description: Services we run in production") {
+		t.Errorf("expected the new description in the result, got %s", result)
+	}
+}
+
+func TestUpdateCatalogTypeTool_AttributesKeepExistingIDs(t *testing.T) {
+	var schema incidentio.UpdateCatalogTypeSchemaRequest
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v3/catalog_types/type_service":
+			fmt.Fprint(w, `{"catalog_type": {"id": "type_service", "name": "Service", "type_name": "Custom[\"Service\"]",
+				"schema": {"version": 2, "attributes": [{"id": "attr_tier", "name": "Tier", "type": "Number"}]}}}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/v3/catalog_types/type_service/actions/update_schema":
+			if err := json.NewDecoder(r.Body).Decode(&schema); err != nil {
+				t.Fatalf("failed to decode request: %v", err)
+			}
+			fmt.Fprint(w, `{"catalog_type": {"id": "type_service", "name": "Service"}}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	_, err := NewUpdateCatalogTypeTool(client).Execute(context.Background(), map[string]interface{}{
+		"id": "type_service",
+		"attributes": []interface{}{
+			map[string]interface{}{"name": "tier", "type": "Number"},
+			map[string]interface{}{"name": "Runbook", "type": "Text"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := incidentio.UpdateCatalogTypeSchemaRequest{
+		Version: 2,
+		Attributes: []incidentio.CatalogAttribute{
+			{ID: "attr_tier", Name: "tier", Type: "Number"},
+			{Name: "Runbook", Type: "Text"},
+		},
+	}
+	if !reflect.DeepEqual(schema, expected) {
+		t.Errorf("expected schema request %+v, got %+v", expected, schema)
+	}
+}
+
+func TestUpdateCatalogTypeTool_RequiresChanges(t *testing.T) {
+	tool := &UpdateCatalogTypeTool{}
+
+	for _, args := range []map[string]interface{}{
+		{"description": "Services"},
+		{"id": "type_service"},
+	} {
+		if _, err := tool.Execute(context.Background(), args); err == nil {
+			t.Errorf("expected error for %v", args)
+		}
+	}
+}
@@ -1,8 +1,11 @@
 package tools
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"strings"
 
 	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
 )
@@ -27,13 +30,24 @@ USAGE WORKFLOW:
 1. Get incident ID from list_incidents or get_incident
 2. Call this tool with the incident ID
 3. Tool checks if already closed to avoid errors
-4. Attempts direct closure or provides guidance if workflow restrictions apply
+4. Tool checks that every custom field required before closure is set, and refuses to close if any are missing
+5. Posts the closing message as an incident update, if one is given
+6. Attempts direct closure or provides guidance if workflow restrictions apply
 
 PARAMETERS:
 - id: Required. The incident ID to close
+- force: Optional. Set to true to skip the required custom field check and let incident.io decide
+- message: Optional. A closing note, posted as an incident update before the incident is closed
+- postmortem_document_url: Optional. Link to the postmortem document, saved on the incident as it is closed
 
 EXAMPLES:
 - Close incident: {"id": "01HXYZ..."}
+- Close without checking custom fields: {"id": "01HXYZ...", "force": true}
+- Close with a note and postmortem: {"id": "01HXYZ...", "message": "Fix deployed and verified, closing.", "postmortem_document_url": "https://docs.example.com/postmortems/inc-123"}
+
+If the closing message is posted but the incident cannot be closed, the update is deleted again so the incident is left as it was. If that also fails, the result says which update is still there.
+
+If required custom fields are missing, the error names each field and its available options. Set them with update_incident, or use check_closure_readiness to see what is missing before closing.
 
 IMPORTANT: incident.io may require incidents to go through specific status transitions before closing (e.g., Triage → Active → Monitoring → Closed). This tool attempts direct closure and provides helpful guidance if workflow restrictions prevent it.`
 }
@@ -46,41 +60,109 @@ func (t *CloseIncidentTool) InputSchema() map[string]interface{} {
 				"type":        "string",
 				"description": "The incident ID to close",
 			},
+			"force": map[string]interface{}{
+				"type":        "boolean",
+				"description": "Skip the check for custom fields required before closure",
+				"default":     false,
+			},
+			"message": map[string]interface{}{
+				"type":        "string",
+				"description": "Closing note, posted as an incident update before closing",
+			},
+			"postmortem_document_url": map[string]interface{}{
+				"type":        "string",
+				"description": "URL of the postmortem document to link to the incident",
+			},
 		},
 		"required": []string{"id"},
 	}
 }
 
-func (t *CloseIncidentTool) Execute(args map[string]interface{}) (string, error) {
+func (t *CloseIncidentTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
 	id, ok := args["id"].(string)
 	if !ok {
 		return "", fmt.Errorf("id parameter is required")
 	}
 
+	message, _ := args["message"].(string)
+	message = strings.TrimSpace(message)
+	postmortemURL, _ := args["postmortem_document_url"].(string)
+	postmortemURL = strings.TrimSpace(postmortemURL)
+	if postmortemURL != "" {
+		if err := validateDocumentURL(postmortemURL); err != nil {
+			return "", err
+		}
+	}
+
 	// Get the current incident first
-	incident, err := t.client.GetIncident(id)
+	incident, err := t.client.GetIncident(ctx, id)
 	if err != nil {
 		return "", fmt.Errorf("failed to get incident: %w", err)
 	}
 
 	// Check if it's already closed
 	if incident.IncidentStatus.Category == "closed" {
-		return fmt.Sprintf("Incident %s (%s) is already closed with status: %s",
-			incident.ID, incident.Name, incident.IncidentStatus.Name), nil
+		result := fmt.Sprintf("Incident %s (%s) is already closed with status: %s",
+			incident.ID, incident.Name, incident.IncidentStatus.Name)
+		if message != "" || postmortemURL != "" {
+			result += ". The message and postmortem_document_url were not applied; use create_incident_update or update_incident instead"
+		}
+		return result, nil
+	}
+
+	if force, _ := args["force"].(bool); !force {
+		customFields, err := t.client.ListCustomFields(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to list custom fields: %w", err)
+		}
+
+		if missing := missingClosureFields(incident, customFields.CustomFields); len(missing) > 0 {
+			return "", missingClosureFieldsError(incident, missing)
+		}
+	}
+
+	// Status IDs differ between organisations, so look up the closed one
+	// before posting anything
+	closedStatusID, err := closedIncidentStatusID(ctx, t.client)
+	if err != nil {
+		return "", err
+	}
+
+	// Post the closing message first, so it appears in the incident's
+	// updates before the closure
+	var closingUpdate *incidentio.IncidentUpdate
+	if message != "" {
+		closingUpdate, err = t.client.CreateIncidentUpdate(ctx, &incidentio.CreateIncidentUpdateRequest{
+			IncidentID: incident.ID,
+			Message:    message,
+		})
+		if err != nil {
+			if _, ok := incidentio.AsDryRun(err); ok {
+				return "", err
+			}
+			return "", fmt.Errorf("failed to post closing message, the incident was not closed: %w", err)
+		}
 	}
 
 	// Try to close the incident using the update API
 	// incident.io has workflow restrictions, so we might need to go through intermediate steps
-	closedStatusID := "01JAR1BCBHSK633DVJSFC16RPY"
-
 	req := &incidentio.UpdateIncidentRequest{
 		IncidentStatusID: closedStatusID,
 	}
+	if postmortemURL != "" {
+		req.RetrospectiveIncidentOptions = &incidentio.RetrospectiveIncidentOptionsRequest{
+			PostmortemDocumentURL: postmortemURL,
+		}
+	}
 
-	updatedIncident, err := t.client.UpdateIncident(id, req)
+	updatedIncident, err := t.client.UpdateIncident(ctx, id, req)
 	if err != nil {
+		if _, ok := incidentio.AsDryRun(err); ok {
+			return "", err
+		}
+
 		// If direct closure fails, provide helpful guidance
-		return fmt.Sprintf(`Failed to close incident directly: %v
+		return t.rollbackClosingUpdate(ctx, closingUpdate) + fmt.Sprintf(`Failed to close incident directly: %v
 
 This might be due to workflow restrictions. incident.io often requires incidents to go through specific states before closing.
 
@@ -103,11 +185,15 @@ Use the update_incident tool with incident_status_id: %s`,
 	}
 
 	// Success! Return the updated incident
-	result, err := json.MarshalIndent(map[string]interface{}{
+	response := map[string]interface{}{
 		"message": fmt.Sprintf("Successfully updated incident %s to status: %s",
 			updatedIncident.Name, updatedIncident.IncidentStatus.Name),
 		"incident": updatedIncident,
-	}, "", "  ")
+	}
+	if closingUpdate != nil {
+		response["incident_update"] = closingUpdate
+	}
+	result, err := json.MarshalIndent(response, "", "  ")
 
 	if err != nil {
 		return "", fmt.Errorf("failed to format response: %w", err)
@@ -115,3 +201,60 @@ Use the update_incident tool with incident_status_id: %s`,
 
 	return string(result), nil
 }
+
+// closedIncidentStatusID returns the ID of the organisation's closed incident
+// status
+func closedIncidentStatusID(ctx context.Context, client *incidentio.Client) (string, error) {
+	statuses, err := client.ListIncidentStatuses(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to list incident statuses: %w", err)
+	}
+	for _, status := range statuses.IncidentStatuses {
+		if status.Category == "closed" {
+			return status.ID, nil
+		}
+	}
+	return "", fmt.Errorf("no incident status with the closed category was found")
+}
+
+// rollbackClosingUpdate deletes a closing message that was posted before a
+// failed close, and describes what happened so the caller knows whether the
+// update is still on the incident
+func (t *CloseIncidentTool) rollbackClosingUpdate(ctx context.Context, update *incidentio.IncidentUpdate) string {
+	if update == nil {
+		return ""
+	}
+	if err := t.client.DeleteIncidentUpdate(ctx, update.ID); err != nil {
+		return fmt.Sprintf("The closing message was posted as incident update %s, but could not be removed after the close failed (%v). Delete it with delete_incident_update if it should not stay.\n\n", update.ID, err)
+	}
+	return "The closing message was posted and then removed again because the incident could not be closed.\n\n"
+}
+
+// validateDocumentURL checks that a document link is an absolute http(s) URL
+func validateDocumentURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("postmortem_document_url must be an absolute http or https URL, got '%s'", raw)
+	}
+	return nil
+}
+
+// missingClosureFieldsError describes the custom fields that must be set
+// before an incident can be closed, including the options for select fields
+func missingClosureFieldsError(incident *incidentio.Incident, missing []incidentio.CustomField) error {
+	var lines []string
+	for _, field := range missing {
+		line := fmt.Sprintf("- %s (%s, id: %s)", field.Name, field.FieldType, field.ID)
+		if len(field.Options) > 0 {
+			options := make([]string, len(field.Options))
+			for i, option := range field.Options {
+				options[i] = fmt.Sprintf("%s (id: %s)", option.Value, option.ID)
+			}
+			line += ". Options: " + strings.Join(options, ", ")
+		}
+		lines = append(lines, line)
+	}
+
+	return fmt.Errorf("cannot close incident %s: %d custom field(s) required before closure are not set:\n%s\n\nSet them with update_incident, or pass force=true to attempt closure anyway",
+		incident.Reference, len(missing), strings.Join(lines, "\n"))
+}
@@ -0,0 +1,293 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const closeIncidentCustomFields = `{
+	"custom_fields": [
+		{"id": "cf_root_cause", "name": "Root Cause", "field_type": "single_select", "required": "before_closure",
+			"options": [
+				{"id": "opt_deploy", "custom_field_id": "cf_root_cause", "value": "Bad deploy"},
+				{"id": "opt_capacity", "custom_field_id": "cf_root_cause", "value": "Capacity"}
+			]},
+		{"id": "cf_notes", "name": "Notes", "field_type": "text", "required": "never"}
+	]
+}`
+
+func TestCloseIncidentTool_RequiredCustomFields(t *testing.T) {
+	emptyRootCause := `{"incident": {
+		"id": "inc_1",
+		"reference": "INC-1",
+		"name": "Database outage",
+		"incident_status": {"id": "st_live", "name": "Investigating", "category": "live"},
+		"custom_field_entries": [
+			{"custom_field": {"id": "cf_root_cause", "name": "Root Cause"}, "values": []}
+		]
+	}}`
+	populatedRootCause := `{"incident": {
+		"id": "inc_1",
+		"reference": "INC-1",
+		"name": "Database outage",
+		"incident_status": {"id": "st_live", "name": "Investigating", "category": "live"},
+		"custom_field_entries": [
+			{"custom_field": {"id": "cf_root_cause", "name": "Root Cause"}, "values": [{"value_option": {"id": "opt_deploy", "value": "Bad deploy"}}]}
+		]
+	}}`
+
+	tests := []struct {
+		name          string
+		incident      string
+		force         bool
+		expectClose   bool
+		errorContains []string
+	}{
+		{
+			name:          "empty before-closure field blocks closing",
+			incident:      emptyRootCause,
+			expectClose:   false,
+			errorContains: []string{"Root Cause", "cf_root_cause", "Bad deploy (id: opt_deploy)", "Capacity (id: opt_capacity)", "force"},
+		},
+		{
+			name:        "populated before-closure field allows closing",
+			incident:    populatedRootCause,
+			expectClose: true,
+		},
+		{
+			name:        "force skips the check",
+			incident:    emptyRootCause,
+			force:       true,
+			expectClose: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			closeRequests := 0
+			client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/incidents/inc_1":
+					fmt.Fprint(w, tt.incident)
+				case "/custom_fields":
+					fmt.Fprint(w, closeIncidentCustomFields)
+				case "/v1/incident_statuses":
+					fmt.Fprint(w, bulkCloseStatuses)
+				case "/incidents/inc_1/actions/edit":
+					closeRequests++
+					fmt.Fprint(w, `{"incident": {"id": "inc_1", "name": "Database outage", "incident_status": {"id": "st_closed", "name": "Closed", "category": "closed"}}}`)
+				default:
+					t.Errorf("unexpected request: %s", r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+
+			args := map[string]interface{}{"id": "inc_1"}
+			if tt.force {
+				args["force"] = true
+			}
+
+			result, err := NewCloseIncidentTool(client).Execute(context.Background(), args)
+
+			if tt.expectClose {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if closeRequests != 1 {
+					t.Errorf("expected 1 close request, got %d", closeRequests)
+				}
+				if !strings.Contains(result, "Successfully updated incident") {
+					t.Errorf("expected success message, got: %s", result)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error, got result: %s", result)
+			}
+			for _, expected := range tt.errorContains {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("expected error to contain %q, got: %v", expected, err)
+				}
+			}
+			if closeRequests != 0 {
+				t.Errorf("expected no close requests, got %d", closeRequests)
+			}
+		})
+	}
+}
+
+const closeIncidentLive = `{"incident": {
+	"id": "inc_1",
+	"reference": "INC-1",
+	"name": "Database outage",
+	"permalink": "https://app.incident.io/incidents/1",
+	"incident_status": {"id": "st_live", "name": "Investigating", "category": "live"}
+}}`
+
+func TestCloseIncidentTool_MessageAndPostmortem(t *testing.T) {
+	var calls []string
+	var updateBody, editBody map[string]interface{}
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		switch r.URL.Path {
+		case "/incidents/inc_1":
+			fmt.Fprint(w, closeIncidentLive)
+		case "/custom_fields":
+			fmt.Fprint(w, `{"custom_fields": []}`)
+		case "/v1/incident_statuses":
+			fmt.Fprint(w, bulkCloseStatuses)
+		case "/incident_updates":
+			json.NewDecoder(r.Body).Decode(&updateBody)
+			fmt.Fprint(w, `{"incident_update": {"id": "upd_1", "incident_id": "inc_1", "message": "Fix verified, closing."}}`)
+		case "/incidents/inc_1/actions/edit":
+			json.NewDecoder(r.Body).Decode(&editBody)
+			fmt.Fprint(w, `{"incident": {"id": "inc_1", "name": "Database outage", "incident_status": {"id": "st_closed", "name": "Closed", "category": "closed"},
+				"retrospective_incident_options": {"postmortem_document_url": "https://docs.example.com/pm/inc-1"}}}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	result, err := NewCloseIncidentTool(client).Execute(context.Background(), map[string]interface{}{
+		"id":                      "inc_1",
+		"message":                 "  Fix verified, closing.  ",
+		"postmortem_document_url": "https://docs.example.com/pm/inc-1",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantCalls := []string{"GET /incidents/inc_1", "GET /custom_fields", "GET /v1/incident_statuses", "POST /incident_updates", "POST /incidents/inc_1/actions/edit"}
+	if !reflect.DeepEqual(calls, wantCalls) {
+		t.Errorf("expected calls %v, got %v", wantCalls, calls)
+	}
+	if updateBody["incident_id"] != "inc_1" || updateBody["message"] != "Fix verified, closing." {
+		t.Errorf("unexpected update body: %v", updateBody)
+	}
+	incident, _ := editBody["incident"].(map[string]interface{})
+	if incident["incident_status_id"] != "st_closed" {
+		t.Errorf("expected the organisation's closed status in the edit request, got: %v", editBody)
+	}
+	options, _ := incident["retrospective_incident_options"].(map[string]interface{})
+	if options["postmortem_document_url"] != "https://docs.example.com/pm/inc-1" {
+		t.Errorf("expected the postmortem URL in the edit request, got: %v", editBody)
+	}
+	for _, expected := range []string{"Successfully updated incident", `"incident_update"`, `"id": "upd_1"`} {
+		if !strings.Contains(result, expected) {
+			t.Errorf("expected result to contain %q, got: %s", expected, result)
+		}
+	}
+}
+
+func TestCloseIncidentTool_CloseFailsAfterMessage(t *testing.T) {
+	tests := []struct {
+		name           string
+		deleteStatus   int
+		resultContains string
+	}{
+		{
+			name:           "update is removed",
+			deleteStatus:   http.StatusNoContent,
+			resultContains: "posted and then removed again",
+		},
+		{
+			name:           "update cannot be removed",
+			deleteStatus:   http.StatusForbidden,
+			resultContains: "posted as incident update upd_1, but could not be removed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls []string
+			client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+				calls = append(calls, r.Method+" "+r.URL.Path)
+				switch r.URL.Path {
+				case "/incidents/inc_1":
+					fmt.Fprint(w, closeIncidentLive)
+				case "/custom_fields":
+					fmt.Fprint(w, `{"custom_fields": []}`)
+				case "/v1/incident_statuses":
+					fmt.Fprint(w, bulkCloseStatuses)
+				case "/incident_updates":
+					fmt.Fprint(w, `{"incident_update": {"id": "upd_1", "incident_id": "inc_1", "message": "Closing."}}`)
+				case "/incidents/inc_1/actions/edit":
+					w.WriteHeader(http.StatusUnprocessableEntity)
+					fmt.Fprint(w, `{"type": "validation_error", "errors": [{"message": "cannot transition to closed"}]}`)
+				case "/incident_updates/upd_1":
+					w.WriteHeader(tt.deleteStatus)
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+
+			result, err := NewCloseIncidentTool(client).Execute(context.Background(), map[string]interface{}{
+				"id":      "inc_1",
+				"message": "Closing.",
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			wantCalls := []string{"GET /incidents/inc_1", "GET /custom_fields", "GET /v1/incident_statuses", "POST /incident_updates", "POST /incidents/inc_1/actions/edit", "DELETE /incident_updates/upd_1"}
+			if !reflect.DeepEqual(calls, wantCalls) {
+				t.Errorf("expected calls %v, got %v", wantCalls, calls)
+			}
+			for _, expected := range []string{tt.resultContains, "Failed to close incident directly"} {
+				if !strings.Contains(result, expected) {
+					t.Errorf("expected result to contain %q, got: %s", expected, result)
+				}
+			}
+		})
+	}
+}
+
+func TestCloseIncidentTool_MessageFailureSkipsClose(t *testing.T) {
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/incidents/inc_1":
+			fmt.Fprint(w, closeIncidentLive)
+		case "/v1/incident_statuses":
+			fmt.Fprint(w, bulkCloseStatuses)
+		case "/incident_updates":
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, `{"type": "internal_error"}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	_, err := NewCloseIncidentTool(client).Execute(context.Background(), map[string]interface{}{
+		"id":      "inc_1",
+		"force":   true,
+		"message": "Closing.",
+	})
+	if err == nil || !strings.Contains(err.Error(), "the incident was not closed") {
+		t.Errorf("expected the close to be skipped, got: %v", err)
+	}
+}
+
+func TestCloseIncidentTool_InvalidPostmortemURL(t *testing.T) {
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+	})
+
+	for _, raw := range []string{"docs.example.com/pm", "ftp://docs.example.com/pm", "https://"} {
+		_, err := NewCloseIncidentTool(client).Execute(context.Background(), map[string]interface{}{
+			"id":                      "inc_1",
+			"postmortem_document_url": raw,
+		})
+		if err == nil || !strings.Contains(err.Error(), "absolute http or https URL") {
+			t.Errorf("expected %q to be rejected, got: %v", raw, err)
+		}
+	}
+}
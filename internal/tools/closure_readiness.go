@@ -0,0 +1,136 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+// CheckClosureReadinessTool reports custom fields that must be set before an incident can be closed
+type CheckClosureReadinessTool struct {
+	client *incidentio.Client
+}
+
+func NewCheckClosureReadinessTool(client *incidentio.Client) *CheckClosureReadinessTool {
+	return &CheckClosureReadinessTool{client: client}
+}
+
+func (t *CheckClosureReadinessTool) Name() string {
+	return "check_closure_readiness"
+}
+
+func (t *CheckClosureReadinessTool) Description() string {
+	return `Check whether an incident is ready to be closed by finding required custom fields that are still unset.
+
+USAGE WORKFLOW:
+1. Call this tool with the incident identifier BEFORE calling close_incident
+2. If ready_to_close is false, fill in the fields listed in missing_fields using update_incident
+3. Call close_incident once ready_to_close is true
+
+IDENTIFIER FORMATS SUPPORTED:
+1. Full incident ID: "01FDAG4SAP5TYPT98WGR2N7"
+2. Incident reference: "INC-123" or just "123"
+3. Slack channel ID: "C123456789"
+4. Slack channel name: "20251020-aws-outage-ci-impaired"
+
+PARAMETERS:
+- incident_id: Required. The incident to check (any of the formats above)
+
+EXAMPLES:
+- Check by reference: {"incident_id": "INC-123"}
+
+IMPORTANT: Custom fields configured as required "before_closure" or "always" must have a value before incident.io will allow the incident to be closed.`
+}
+
+func (t *CheckClosureReadinessTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"incident_id": map[string]interface{}{
+				"type":        "string",
+				"description": "Incident identifier: full ID, reference (INC-123 or 123), Slack channel ID, or Slack channel name",
+			},
+		},
+		"required":             []interface{}{"incident_id"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *CheckClosureReadinessTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	identifier, ok := args["incident_id"].(string)
+	if !ok || identifier == "" {
+		return "", fmt.Errorf("incident_id parameter is required and must be a non-empty string")
+	}
+
+	getIncidentTool := NewGetIncidentTool(t.client)
+	incidentID, err := getIncidentTool.ResolveIncidentIdentifier(ctx, identifier)
+	if err != nil {
+		return "", err
+	}
+
+	incident, err := t.client.GetIncident(ctx, incidentID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get incident: %w", err)
+	}
+
+	customFields, err := t.client.ListCustomFields(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to list custom fields: %w", err)
+	}
+
+	missing := missingClosureFields(incident, customFields.CustomFields)
+
+	missingFields := make([]map[string]interface{}, 0, len(missing))
+	var missingNames []string
+	for _, field := range missing {
+		missingFields = append(missingFields, map[string]interface{}{
+			"id":          field.ID,
+			"name":        field.Name,
+			"description": field.Description,
+			"field_type":  field.FieldType,
+			"required":    field.Required,
+		})
+		missingNames = append(missingNames, field.Name)
+	}
+
+	message := fmt.Sprintf("Incident %s is ready to close: all required custom fields are set", incident.Reference)
+	if len(missing) > 0 {
+		message = fmt.Sprintf("Incident %s is NOT ready to close. Set these custom fields first: %s", incident.Reference, strings.Join(missingNames, ", "))
+	}
+
+	result, err := json.MarshalIndent(map[string]interface{}{
+		"incident_id":    incident.ID,
+		"reference":      incident.Reference,
+		"name":           incident.Name,
+		"ready_to_close": len(missing) == 0,
+		"missing_fields": missingFields,
+		"message":        message,
+	}, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to format response: %w", err)
+	}
+
+	return string(result), nil
+}
+
+// missingClosureFields returns the custom fields that are required before closure
+// but have no value on the incident
+func missingClosureFields(incident *incidentio.Incident, customFields []incidentio.CustomField) []incidentio.CustomField {
+	setFields := make(map[string]bool)
+	for _, entry := range incident.CustomFieldEntries {
+		if len(entry.Values) > 0 {
+			setFields[entry.CustomField.ID] = true
+		}
+	}
+
+	var missing []incidentio.CustomField
+	for _, field := range customFields {
+		if field.RequiredBeforeClosure() && !setFields[field.ID] {
+			missing = append(missing, field)
+		}
+	}
+	return missing
+}
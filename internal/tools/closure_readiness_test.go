@@ -0,0 +1,100 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+const closureReadinessCustomFields = `{
+	"custom_fields": [
+		{"id": "cf_root_cause", "name": "Root Cause", "field_type": "text", "required": "before_closure", "show_before_closure": true},
+		{"id": "cf_team", "name": "Affected Team", "field_type": "single_select", "required": "always"},
+		{"id": "cf_notes", "name": "Notes", "field_type": "text", "required": "never"}
+	]
+}`
+
+func TestCheckClosureReadinessTool_Execute(t *testing.T) {
+	tests := []struct {
+		name            string
+		incident        string
+		expectedReady   bool
+		expectedMissing []string
+	}{
+		{
+			name: "missing before-closure field",
+			incident: `{"incident": {
+				"id": "01HXYZ1234567890ABCDEFGH",
+				"reference": "INC-123",
+				"name": "Database outage",
+				"custom_field_entries": [
+					{"custom_field": {"id": "cf_team", "name": "Affected Team"}, "values": [{"value_option": {"value": "Payments"}}]},
+					{"custom_field": {"id": "cf_root_cause", "name": "Root Cause"}, "values": []}
+				]
+			}}`,
+			expectedReady:   false,
+			expectedMissing: []string{"cf_root_cause"},
+		},
+		{
+			name: "all required fields set",
+			incident: `{"incident": {
+				"id": "01HXYZ1234567890ABCDEFGH",
+				"reference": "INC-123",
+				"name": "Database outage",
+				"custom_field_entries": [
+					{"custom_field": {"id": "cf_team", "name": "Affected Team"}, "values": [{"value_option": {"value": "Payments"}}]},
+					{"custom_field": {"id": "cf_root_cause", "name": "Root Cause"}, "values": [{"value_text": "Bad deploy"}]}
+				]
+			}}`,
+			expectedReady:   true,
+			expectedMissing: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/incidents/123":
+					fmt.Fprint(w, tt.incident)
+				case "/custom_fields":
+					fmt.Fprint(w, closureReadinessCustomFields)
+				default:
+					t.Errorf("unexpected request: %s", r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+
+			result, err := NewCheckClosureReadinessTool(client).Execute(context.Background(), map[string]interface{}{
+				"incident_id": "INC-123",
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var response struct {
+				ReadyToClose  bool `json:"ready_to_close"`
+				MissingFields []struct {
+					ID string `json:"id"`
+				} `json:"missing_fields"`
+			}
+			if err := json.Unmarshal([]byte(result), &response); err != nil {
+				t.Fatalf("failed to parse result: %v", err)
+			}
+
+			if response.ReadyToClose != tt.expectedReady {
+				t.Errorf("expected ready_to_close=%v, got %v", tt.expectedReady, response.ReadyToClose)
+			}
+			if len(response.MissingFields) != len(tt.expectedMissing) {
+				t.Fatalf("expected %d missing fields, got %d: %s", len(tt.expectedMissing), len(response.MissingFields), result)
+			}
+			for i, id := range tt.expectedMissing {
+				if response.MissingFields[i].ID != id {
+					t.Errorf("expected missing field %s, got %s", id, response.MissingFields[i].ID)
+				}
+			}
+		})
+	}
+}
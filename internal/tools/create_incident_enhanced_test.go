@@ -1,6 +1,7 @@
 package tools
 
 import (
+	"context"
 	"strings"
 	"testing"
 )
@@ -14,7 +15,7 @@ func TestCreateIncidentEnhancedTool_Execute(t *testing.T) {
 			"summary": "Test Summary",
 		}
 
-		_, err := tool.Execute(args)
+		_, err := tool.Execute(context.Background(), args)
 		if err == nil {
 			t.Error("Expected error for missing name parameter")
 		}
@@ -29,7 +30,7 @@ func TestCreateIncidentEnhancedTool_Execute(t *testing.T) {
 			"name": 123, // Not a string
 		}
 
-		_, err := tool.Execute(args)
+		_, err := tool.Execute(context.Background(), args)
 		if err == nil {
 			t.Error("Expected error for wrong type name parameter")
 		}
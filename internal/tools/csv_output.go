@@ -0,0 +1,158 @@
+package tools
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// listOutputFormats are the values accepted by the output_format argument of
+// list tools
+var listOutputFormats = []string{"json", "csv"}
+
+// outputFormatProperty is the input schema for the output_format argument
+// shared by list tools
+func outputFormatProperty() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "string",
+		"description": "Output format: json (default) returns the API response; csv returns a header row plus one row per item, " +
+			"with the fields as columns. CSV omits pagination_meta, so use json to page manually.",
+		"enum":    listOutputFormats,
+		"default": "json",
+	}
+}
+
+// parseOutputFormat reads the output_format argument, defaulting to json
+func parseOutputFormat(args map[string]interface{}) (string, error) {
+	format, _ := args["output_format"].(string)
+	format = strings.ToLower(strings.TrimSpace(format))
+	if format == "" {
+		return "json", nil
+	}
+	for _, f := range listOutputFormats {
+		if f == format {
+			return format, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported output_format '%s'. Supported formats: %s", format, strings.Join(listOutputFormats, ", "))
+}
+
+// formatCSVResult renders the items under collectionKey in data as CSV. The
+// columns are the comma-separated fields, in the order given, or
+// defaultColumns when fieldsStr is empty.
+func formatCSVResult(data interface{}, collectionKey, fieldsStr string, defaultColumns []string) (string, error) {
+	columns := defaultColumns
+	if fieldsStr != "" {
+		var err error
+		if columns, err = csvColumns(fieldsStr); err != nil {
+			return "", err
+		}
+	}
+
+	jsonBytes, err := json.Marshal(data)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal data: %w", err)
+	}
+	var response map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(jsonBytes))
+	decoder.UseNumber()
+	if err := decoder.Decode(&response); err != nil {
+		return "", fmt.Errorf("failed to unmarshal data: %w", err)
+	}
+	items, _ := response[collectionKey].([]interface{})
+
+	var b strings.Builder
+	w := csv.NewWriter(&b)
+	if err := w.Write(columns); err != nil {
+		return "", fmt.Errorf("failed to write CSV: %w", err)
+	}
+	for _, item := range items {
+		row := make([]string, len(columns))
+		for i, column := range columns {
+			row[i] = csvCell(lookupPath(item, strings.Split(column, ".")))
+		}
+		if err := w.Write(row); err != nil {
+			return "", fmt.Errorf("failed to write CSV: %w", err)
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return "", fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return b.String(), nil
+}
+
+// csvColumns splits a fields argument into CSV columns. Exclusions and
+// wildcards have no fixed column set, so they are rejected.
+func csvColumns(fieldsStr string) ([]string, error) {
+	var columns []string
+	seen := make(map[string]bool)
+	for _, field := range strings.Split(fieldsStr, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" || seen[field] {
+			continue
+		}
+		if strings.HasPrefix(field, "-") || strings.Contains(field, "*") {
+			return nil, fmt.Errorf("field '%s' cannot be used with output_format csv: list the columns to include", field)
+		}
+		seen[field] = true
+		columns = append(columns, field)
+	}
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("fields must name at least one column for output_format csv")
+	}
+	return columns, nil
+}
+
+// lookupPath follows a dotted field path into decoded JSON. Arrays along the
+// path yield the value for each element.
+func lookupPath(value interface{}, path []string) interface{} {
+	if len(path) == 0 {
+		return value
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return lookupPath(v[path[0]], path[1:])
+	case []interface{}:
+		values := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			if found := lookupPath(item, path); found != nil {
+				values = append(values, found)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+// csvCell renders a JSON value as a single CSV cell. Lists of scalars are
+// joined with "; " and objects are written as compact JSON.
+func csvCell(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	case []interface{}:
+		parts := make([]string, len(v))
+		for i, item := range v {
+			if _, isObject := item.(map[string]interface{}); isObject {
+				encoded, _ := json.Marshal(v)
+				return string(encoded)
+			}
+			parts[i] = csvCell(item)
+		}
+		return strings.Join(parts, "; ")
+	default:
+		encoded, _ := json.Marshal(v)
+		return string(encoded)
+	}
+}
@@ -0,0 +1,186 @@
+package tools
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// parseCSV reads a CSV result back into rows, failing the test if it is not
+// valid CSV
+func parseCSV(t *testing.T, result string) [][]string {
+	t.Helper()
+
+	rows, err := csv.NewReader(strings.NewReader(result)).ReadAll()
+	if err != nil {
+		t.Fatalf("invalid CSV: %v\n%s", err, result)
+	}
+	return rows
+}
+
+func TestFormatCSVResult_Escaping(t *testing.T) {
+	data := map[string]interface{}{
+		"incidents": []interface{}{
+			map[string]interface{}{
+				"id":       "inc_1",
+				"name":     `Checkout "5xx" errors, EU`,
+				"summary":  "First line\nSecond line",
+				"severity": map[string]interface{}{"name": "Critical", "rank": 1},
+			},
+			map[string]interface{}{"id": "inc_2", "name": "Plain"},
+		},
+		"pagination_meta": map[string]interface{}{"page_size": 25},
+	}
+
+	result, err := formatCSVResult(data, "incidents", "id,name,summary,severity.name,severity.rank", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantRaw := "id,name,summary,severity.name,severity.rank\n" +
+		`inc_1,"Checkout ""5xx"" errors, EU","First line` + "\n" + `Second line",Critical,1` + "\n" +
+		"inc_2,Plain,,,\n"
+	if result != wantRaw {
+		t.Errorf("unexpected CSV:\n%s\nwant:\n%s", result, wantRaw)
+	}
+
+	want := [][]string{
+		{"id", "name", "summary", "severity.name", "severity.rank"},
+		{"inc_1", `Checkout "5xx" errors, EU`, "First line\nSecond line", "Critical", "1"},
+		{"inc_2", "Plain", "", "", ""},
+	}
+	if rows := parseCSV(t, result); !reflect.DeepEqual(rows, want) {
+		t.Errorf("expected rows %q, got %q", want, rows)
+	}
+}
+
+func TestFormatCSVResult_Columns(t *testing.T) {
+	data := map[string]interface{}{
+		"actions": []interface{}{
+			map[string]interface{}{
+				"id":        "act_1",
+				"assignees": []interface{}{map[string]interface{}{"name": "Ada"}, map[string]interface{}{"name": "Grace"}},
+				"labels":    []interface{}{"db", "eu"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		fields  string
+		want    [][]string
+		wantErr string
+	}{
+		{
+			name:   "fields in the order given",
+			fields: "labels, id,assignees.name,id",
+			want:   [][]string{{"labels", "id", "assignees.name"}, {"db; eu", "act_1", "Ada; Grace"}},
+		},
+		{
+			name:   "default columns",
+			fields: "",
+			want:   [][]string{{"id", "status"}, {"act_1", ""}},
+		},
+		{name: "exclusion", fields: "-labels", wantErr: "cannot be used with output_format csv"},
+		{name: "wildcard", fields: "id,*", wantErr: "cannot be used with output_format csv"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := formatCSVResult(data, "actions", tt.fields, []string{"id", "status"})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got: %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if rows := parseCSV(t, result); !reflect.DeepEqual(rows, tt.want) {
+				t.Errorf("expected rows %q, got %q", tt.want, rows)
+			}
+		})
+	}
+}
+
+func TestListTools_CSVOutput(t *testing.T) {
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/incidents":
+			fmt.Fprint(w, `{"incidents": [
+				{"id": "inc_1", "reference": "INC-1", "name": "Database, primary down", "severity": {"name": "Critical"}}
+			], "pagination_meta": {"page_size": 25, "total_record_count": 1}}`)
+		case "/alerts":
+			fmt.Fprint(w, `{"alerts": [
+				{"id": "alert_1", "title": "CPU \"high\"", "status": "firing", "source": "datadog", "created_at": "2024-12-01T09:00:00Z", "updated_at": "2024-12-01T09:05:00Z"}
+			], "pagination_meta": {"page_size": 50}}`)
+		case "/actions":
+			fmt.Fprint(w, `{"actions": [
+				{"id": "act_1", "incident_id": "inc_1", "status": "completed", "description": "Fail over", "assignee": {"name": "Ada"}, "created_at": "2024-12-01T09:10:00Z", "completed_at": "2024-12-01T09:20:00Z"}
+			], "pagination_meta": {"page_size": 250}}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	tests := []struct {
+		name string
+		tool Tool
+		args map[string]interface{}
+		want [][]string
+	}{
+		{
+			name: "list_incidents with selected fields",
+			tool: NewListIncidentsTool(client),
+			args: map[string]interface{}{"page_size": float64(25), "fields": "reference,name,severity.name"},
+			want: [][]string{
+				{"reference", "name", "severity.name"},
+				{"INC-1", "Database, primary down", "Critical"},
+			},
+		},
+		{
+			name: "list_alerts with default columns",
+			tool: NewListAlertsTool(client),
+			args: map[string]interface{}{},
+			want: [][]string{
+				{"id", "title", "status", "source", "created_at", "updated_at"},
+				{"alert_1", `CPU "high"`, "firing", "datadog", "2024-12-01T09:00:00Z", "2024-12-01T09:05:00Z"},
+			},
+		},
+		{
+			name: "list_actions with default columns",
+			tool: NewListActionsTool(client),
+			args: map[string]interface{}{},
+			want: [][]string{
+				{"id", "incident_id", "status", "description", "assignee.name", "created_at", "completed_at"},
+				{"act_1", "inc_1", "completed", "Fail over", "Ada", "2024-12-01T09:10:00Z", "2024-12-01T09:20:00Z"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.args["output_format"] = "csv"
+			result, err := tt.tool.Execute(context.Background(), tt.args)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if rows := parseCSV(t, result); !reflect.DeepEqual(rows, tt.want) {
+				t.Errorf("expected rows %q, got %q", tt.want, rows)
+			}
+		})
+	}
+
+	t.Run("rejects an unknown format", func(t *testing.T) {
+		_, err := NewListAlertsTool(client).Execute(context.Background(), map[string]interface{}{"output_format": "xlsx"})
+		if err == nil || !strings.Contains(err.Error(), "unsupported output_format 'xlsx'") {
+			t.Errorf("expected an unsupported format error, got: %v", err)
+		}
+	})
+}
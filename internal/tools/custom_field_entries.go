@@ -0,0 +1,148 @@
+package tools
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+// buildCustomFieldEntries translates a map of custom field ID or name to
+// values into custom field entries for create and update requests. Select
+// fields take option IDs (or option values, which are resolved to IDs); text,
+// numeric and link fields take literal values. An empty array clears the
+// field.
+func buildCustomFieldEntries(customFields []incidentio.CustomField, input map[string]interface{}) ([]incidentio.CustomFieldEntryRequest, error) {
+	// Iterate in a stable order so errors and requests are deterministic
+	refs := make([]string, 0, len(input))
+	for ref := range input {
+		refs = append(refs, ref)
+	}
+	sort.Strings(refs)
+
+	entries := make([]incidentio.CustomFieldEntryRequest, 0, len(refs))
+	seen := make(map[string]string, len(refs))
+	for _, ref := range refs {
+		field, err := findCustomField(customFields, ref)
+		if err != nil {
+			return nil, err
+		}
+		if previous, ok := seen[field.ID]; ok {
+			return nil, fmt.Errorf("custom field %s (%s) is set twice, as '%s' and '%s'", field.Name, field.ID, previous, ref)
+		}
+		seen[field.ID] = ref
+
+		rawValues, err := customFieldInputValues(input[ref])
+		if err != nil {
+			return nil, fmt.Errorf("invalid values for custom field %s (%s): %w", field.Name, field.ID, err)
+		}
+
+		values := make([]interface{}, 0, len(rawValues))
+		for _, raw := range rawValues {
+			value, err := customFieldEntryValue(field, raw)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+
+		if field.FieldType == "single_select" && len(values) > 1 {
+			return nil, fmt.Errorf("custom field %s (%s) is single_select and accepts at most one value, got %d", field.Name, field.ID, len(values))
+		}
+
+		entries = append(entries, incidentio.CustomFieldEntryRequest{
+			CustomFieldID: field.ID,
+			Values:        values,
+		})
+	}
+
+	return entries, nil
+}
+
+// customFieldInputValues accepts either an array of values or a single value
+func customFieldInputValues(raw interface{}) ([]interface{}, error) {
+	switch v := raw.(type) {
+	case []interface{}:
+		return v, nil
+	case string, float64:
+		return []interface{}{v}, nil
+	default:
+		return nil, fmt.Errorf("expected an array of values, got %T", raw)
+	}
+}
+
+// customFieldEntryValue converts a single input value into the request shape
+// the API expects for the field's type
+func customFieldEntryValue(field incidentio.CustomField, raw interface{}) (map[string]interface{}, error) {
+	switch field.FieldType {
+	case "single_select", "multi_select":
+		value, ok := raw.(string)
+		if !ok {
+			return nil, fmt.Errorf("custom field %s (%s) expects option IDs, got %v", field.Name, field.ID, raw)
+		}
+		optionID, err := resolveCustomFieldOption(field, value)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"value_option_id": optionID}, nil
+	case "numeric":
+		switch v := raw.(type) {
+		case float64:
+			return map[string]interface{}{"value_numeric": strconv.FormatFloat(v, 'f', -1, 64)}, nil
+		case string:
+			if _, err := strconv.ParseFloat(v, 64); err != nil {
+				return nil, fmt.Errorf("custom field %s (%s) expects a number, got '%s'", field.Name, field.ID, v)
+			}
+			return map[string]interface{}{"value_numeric": v}, nil
+		}
+		return nil, fmt.Errorf("custom field %s (%s) expects a number, got %v", field.Name, field.ID, raw)
+	case "link":
+		value, ok := raw.(string)
+		if !ok {
+			return nil, fmt.Errorf("custom field %s (%s) expects a URL, got %v", field.Name, field.ID, raw)
+		}
+		return map[string]interface{}{"value_link": value}, nil
+	default:
+		value, ok := raw.(string)
+		if !ok {
+			return nil, fmt.Errorf("custom field %s (%s) expects text, got %v", field.Name, field.ID, raw)
+		}
+		return map[string]interface{}{"value_text": value}, nil
+	}
+}
+
+// resolveCustomFieldOption matches an option by ID, falling back to a
+// case-insensitive match on the option value
+func resolveCustomFieldOption(field incidentio.CustomField, value string) (string, error) {
+	for _, option := range field.Options {
+		if option.ID == value {
+			return option.ID, nil
+		}
+	}
+	for _, option := range field.Options {
+		if strings.EqualFold(option.Value, value) {
+			return option.ID, nil
+		}
+	}
+
+	options := make([]string, len(field.Options))
+	for i, option := range field.Options {
+		options[i] = fmt.Sprintf("%s (%s)", option.ID, option.Value)
+	}
+	return "", fmt.Errorf("invalid option '%s' for custom field %s (%s). Valid option IDs: %s",
+		value, field.Name, field.ID, strings.Join(options, ", "))
+}
+
+func formatAvailableCustomFields(customFields []incidentio.CustomField) string {
+	if len(customFields) == 0 {
+		return "none"
+	}
+
+	fields := make([]string, len(customFields))
+	for i, field := range customFields {
+		fields[i] = fmt.Sprintf("%s (%s)", field.ID, field.Name)
+	}
+	return strings.Join(fields, ", ")
+}
@@ -0,0 +1,367 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+const (
+	// maxCustomFieldOptions caps the number of options created in one call
+	maxCustomFieldOptions = 50
+	// customFieldOptionSortKeyStep is the gap left between the sort keys of
+	// new options, so options can later be inserted between them
+	customFieldOptionSortKeyStep = 10
+)
+
+// BulkCreateCustomFieldOptionsTool adds several options to a select custom
+// field in one call
+type BulkCreateCustomFieldOptionsTool struct {
+	client *incidentio.Client
+}
+
+func NewBulkCreateCustomFieldOptionsTool(client *incidentio.Client) *BulkCreateCustomFieldOptionsTool {
+	return &BulkCreateCustomFieldOptionsTool{client: client}
+}
+
+func (t *BulkCreateCustomFieldOptionsTool) Name() string {
+	return "create_custom_field_options"
+}
+
+func (t *BulkCreateCustomFieldOptionsTool) Description() string {
+	return `Add several options to a single_select or multi_select custom field in one call.
+
+Options are created one at a time in the order given, and sorted after the field's existing options in that same order.
+
+USAGE WORKFLOW:
+1. Pick the custom field by ID or name
+2. List the new option values in the order they should appear
+3. Check "errors" for any values that could not be created
+
+PARAMETERS:
+- custom_field_id: Required. Custom field ID or name (e.g. "Affected Team")
+- options: Required. Array of option values to create, in display order (max 50)
+- sort_key_start: Optional. Sort key for the first new option. Defaults to just after the field's last existing option. Each following option is 10 higher.
+
+EXAMPLES:
+- Add three teams: {"custom_field_id": "Affected Team", "options": ["Payments", "Search", "Billing"]}
+
+Returns {"created": [option, ...], "errors": {value: message}}. Values that duplicate an existing option, case-insensitively, are reported in "errors" without being sent. A failure for one value does not stop the others.`
+}
+
+func (t *BulkCreateCustomFieldOptionsTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"custom_field_id": map[string]interface{}{
+				"type":        "string",
+				"description": "ID or name of a single_select or multi_select custom field",
+			},
+			"options": map[string]interface{}{
+				"type":        "array",
+				"items":       map[string]interface{}{"type": "string"},
+				"description": "Option values to create, in display order",
+				"minItems":    1,
+				"maxItems":    maxCustomFieldOptions,
+			},
+			"sort_key_start": map[string]interface{}{
+				"type":        "integer",
+				"description": "Sort key for the first new option. Defaults to just after the field's last existing option.",
+			},
+		},
+		"required":             []interface{}{"custom_field_id", "options"},
+		"additionalProperties": false,
+	}
+}
+
+// bulkCustomFieldOptionsResult is the result of create_custom_field_options
+type bulkCustomFieldOptionsResult struct {
+	CustomFieldID string                         `json:"custom_field_id"`
+	Created       []incidentio.CustomFieldOption `json:"created"`
+	Errors        map[string]string              `json:"errors"`
+}
+
+func (t *BulkCreateCustomFieldOptionsTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	fieldRef, _ := args["custom_field_id"].(string)
+	if fieldRef == "" {
+		return "", fmt.Errorf("custom_field_id parameter is required")
+	}
+
+	rawOptions, ok := args["options"].([]interface{})
+	if !ok || len(rawOptions) == 0 {
+		return "", fmt.Errorf("options parameter is required and must be a non-empty array")
+	}
+	if len(rawOptions) > maxCustomFieldOptions {
+		return "", fmt.Errorf("at most %d options can be created at once, got %d", maxCustomFieldOptions, len(rawOptions))
+	}
+	values := make([]string, len(rawOptions))
+	for i, raw := range rawOptions {
+		value, ok := raw.(string)
+		if !ok || strings.TrimSpace(value) == "" {
+			return "", fmt.Errorf("options must only contain non-empty strings, got %v", raw)
+		}
+		values[i] = strings.TrimSpace(value)
+	}
+
+	customFields, err := t.client.ListCustomFields(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to list custom fields: %w", err)
+	}
+	field, err := findCustomField(customFields.CustomFields, fieldRef)
+	if err != nil {
+		return "", err
+	}
+	if field.FieldType != "single_select" && field.FieldType != "multi_select" {
+		return "", fmt.Errorf("custom field %s (%s) is %s; options can only be added to single_select or multi_select fields", field.Name, field.ID, field.FieldType)
+	}
+
+	sortKey := nextCustomFieldOptionSortKey(field.Options)
+	if value, ok := args["sort_key_start"].(float64); ok {
+		sortKey = int(value)
+	}
+
+	existing := make(map[string]bool, len(field.Options)+len(values))
+	for _, option := range field.Options {
+		existing[strings.ToLower(option.Value)] = true
+	}
+
+	result := bulkCustomFieldOptionsResult{
+		CustomFieldID: field.ID,
+		Created:       []incidentio.CustomFieldOption{},
+		Errors:        map[string]string{},
+	}
+	for _, value := range values {
+		if existing[strings.ToLower(value)] {
+			result.Errors[value] = fmt.Sprintf("custom field %s already has an option '%s'", field.Name, value)
+			continue
+		}
+
+		option, err := t.client.CreateCustomFieldOption(ctx, &incidentio.CreateCustomFieldOptionRequest{
+			CustomFieldID: field.ID,
+			Value:         value,
+			SortKey:       sortKey,
+		})
+		if err != nil {
+			// A dry run previews the first request rather than failing each option
+			if _, ok := incidentio.AsDryRun(err); ok {
+				return "", err
+			}
+			result.Errors[value] = err.Error()
+			continue
+		}
+
+		existing[strings.ToLower(value)] = true
+		result.Created = append(result.Created, *option)
+		sortKey += customFieldOptionSortKeyStep
+	}
+
+	output, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to format response: %w", err)
+	}
+
+	return string(output), nil
+}
+
+// findCustomField matches a custom field by ID, falling back to a
+// case-insensitive match on its name
+func findCustomField(customFields []incidentio.CustomField, ref string) (incidentio.CustomField, error) {
+	for _, field := range customFields {
+		if field.ID == ref {
+			return field, nil
+		}
+	}
+	for _, field := range customFields {
+		if strings.EqualFold(field.Name, ref) {
+			return field, nil
+		}
+	}
+	return incidentio.CustomField{}, fmt.Errorf("unknown custom field '%s'. Available custom fields: %s", ref, formatAvailableCustomFields(customFields))
+}
+
+// nextCustomFieldOptionSortKey returns a sort key that places a new option
+// after all of options
+func nextCustomFieldOptionSortKey(options []incidentio.CustomFieldOption) int {
+	next := customFieldOptionSortKeyStep
+	for _, option := range options {
+		if option.SortKey+customFieldOptionSortKeyStep > next {
+			next = option.SortKey + customFieldOptionSortKeyStep
+		}
+	}
+	return next
+}
+
+// UpdateCustomFieldOptionTool renames or reorders a custom field option
+type UpdateCustomFieldOptionTool struct {
+	client *incidentio.Client
+}
+
+func NewUpdateCustomFieldOptionTool(client *incidentio.Client) *UpdateCustomFieldOptionTool {
+	return &UpdateCustomFieldOptionTool{client: client}
+}
+
+func (t *UpdateCustomFieldOptionTool) Name() string {
+	return "update_custom_field_option"
+}
+
+func (t *UpdateCustomFieldOptionTool) Description() string {
+	return `Rename or reorder an option of a single_select or multi_select custom field.
+
+USAGE WORKFLOW:
+1. Find the option ID in the "options" of the custom field, e.g. from create_custom_field_options
+2. Call this tool with the new value, the new sort_key, or both
+
+PARAMETERS:
+- id: Required. The custom field option ID to update
+- value: Optional. New display value for the option
+- sort_key: Optional. New sort key; options are listed in ascending sort_key order
+
+At least one of value or sort_key is required. Fields that are not given keep their current values.
+
+EXAMPLES:
+- Fix a typo: {"id": "01HXYZ...", "value": "Payments"}
+- Move an option first: {"id": "01HXYZ...", "sort_key": 0}`
+}
+
+func (t *UpdateCustomFieldOptionTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"id": map[string]interface{}{
+				"type":        "string",
+				"description": "The custom field option ID to update",
+				"minLength":   1,
+			},
+			"value": map[string]interface{}{
+				"type":        "string",
+				"description": "New display value for the option",
+				"minLength":   1,
+			},
+			"sort_key": map[string]interface{}{
+				"type":        "integer",
+				"description": "New sort key; options are listed in ascending sort_key order",
+			},
+		},
+		"required":             []interface{}{"id"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *UpdateCustomFieldOptionTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	id, ok := args["id"].(string)
+	if !ok || id == "" {
+		return "", fmt.Errorf("id parameter is required")
+	}
+
+	value, hasValue := args["value"].(string)
+	value = strings.TrimSpace(value)
+	if hasValue && value == "" {
+		return "", fmt.Errorf("value cannot be empty")
+	}
+	sortKey, hasSortKey := args["sort_key"].(float64)
+	if !hasValue && !hasSortKey {
+		return "", fmt.Errorf("at least one of value or sort_key must be provided")
+	}
+
+	// The API replaces both fields, so fill in whichever wasn't given
+	req := &incidentio.UpdateCustomFieldOptionRequest{Value: value, SortKey: int(sortKey)}
+	if !hasValue || !hasSortKey {
+		current, err := t.client.GetCustomFieldOption(ctx, id)
+		if err != nil {
+			if incidentio.IsNotFound(err) {
+				return "", fmt.Errorf("custom field option not found: %s", id)
+			}
+			return "", fmt.Errorf("failed to get custom field option: %w", err)
+		}
+		if !hasValue {
+			req.Value = current.Value
+		}
+		if !hasSortKey {
+			req.SortKey = current.SortKey
+		}
+	}
+
+	option, err := t.client.UpdateCustomFieldOption(ctx, id, req)
+	if err != nil {
+		if incidentio.IsNotFound(err) {
+			return "", fmt.Errorf("custom field option not found: %s", id)
+		}
+		return "", fmt.Errorf("failed to update custom field option: %w", err)
+	}
+
+	output, err := json.MarshalIndent(option, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to format response: %w", err)
+	}
+
+	return string(output), nil
+}
+
+// DeleteCustomFieldOptionTool removes an option from a select custom field
+type DeleteCustomFieldOptionTool struct {
+	client *incidentio.Client
+}
+
+func NewDeleteCustomFieldOptionTool(client *incidentio.Client) *DeleteCustomFieldOptionTool {
+	return &DeleteCustomFieldOptionTool{client: client}
+}
+
+func (t *DeleteCustomFieldOptionTool) Name() string {
+	return "delete_custom_field_option"
+}
+
+func (t *DeleteCustomFieldOptionTool) Description() string {
+	return `Delete an option from a single_select or multi_select custom field. This cannot be undone.
+
+USAGE WORKFLOW:
+1. Find the option ID in the "options" of the custom field, e.g. from get_incident or create_custom_field_options
+2. Call this tool with the option ID
+
+PARAMETERS:
+- id: Required. The custom field option ID to delete
+
+EXAMPLES:
+- Delete option: {"id": "01HXYZ..."}
+
+IMPORTANT: The API may refuse to delete an option that is still set on incidents; change those incidents' custom field values first.`
+}
+
+func (t *DeleteCustomFieldOptionTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"id": map[string]interface{}{
+				"type":        "string",
+				"description": "The custom field option ID to delete",
+				"minLength":   1,
+			},
+		},
+		"required":             []interface{}{"id"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *DeleteCustomFieldOptionTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	id, ok := args["id"].(string)
+	if !ok || id == "" {
+		return "", fmt.Errorf("id parameter is required")
+	}
+
+	if err := t.client.DeleteCustomFieldOption(ctx, id); err != nil {
+		var apiErr *incidentio.APIError
+		switch {
+		case incidentio.IsNotFound(err):
+			return "", fmt.Errorf("custom field option not found: %s. It may already have been deleted", id)
+		case errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusConflict || apiErr.StatusCode == http.StatusUnprocessableEntity):
+			return "", fmt.Errorf("custom field option %s could not be deleted, it may still be in use by incidents: %w", id, err)
+		}
+		return "", fmt.Errorf("failed to delete custom field option: %w", err)
+	}
+
+	return fmt.Sprintf("Custom field option %s deleted successfully", id), nil
+}
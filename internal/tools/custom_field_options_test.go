@@ -0,0 +1,292 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+// newCustomFieldOptionsClient serves a Team select field with one existing
+// option, records each option created, and rejects the values in conflicts
+// with a 422 as the API does for duplicates
+func newCustomFieldOptionsClient(t *testing.T, created *[]incidentio.CreateCustomFieldOptionRequest, conflicts ...string) *incidentio.Client {
+	t.Helper()
+
+	return newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/custom_fields":
+			fmt.Fprint(w, `{"custom_fields": [
+				{"id": "cf_team", "name": "Team", "field_type": "single_select", "options": [
+					{"id": "opt_payments", "custom_field_id": "cf_team", "value": "Payments", "sort_key": 10}
+				]},
+				{"id": "cf_notes", "name": "Notes", "field_type": "text"}
+			]}`)
+		case r.Method == "POST" && r.URL.Path == "/v1/custom_field_options":
+			var req incidentio.CreateCustomFieldOptionRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Fatalf("failed to decode request body: %v", err)
+			}
+			for _, conflict := range conflicts {
+				if req.Value == conflict {
+					w.WriteHeader(http.StatusUnprocessableEntity)
+					fmt.Fprintf(w, `{"type": "validation_error", "errors": [{"message": "an option with value %s already exists"}]}`, req.Value)
+					return
+				}
+			}
+			*created = append(*created, req)
+			fmt.Fprintf(w, `{"custom_field_option": {"id": "opt_%d", "custom_field_id": %q, "value": %q, "sort_key": %d}}`,
+				len(*created), req.CustomFieldID, req.Value, req.SortKey)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+}
+
+func executeBulkCreateOptions(t *testing.T, tool *BulkCreateCustomFieldOptionsTool, args map[string]interface{}) bulkCustomFieldOptionsResult {
+	t.Helper()
+
+	output, err := tool.Execute(context.Background(), args)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var result bulkCustomFieldOptionsResult
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		t.Fatalf("failed to parse result: %v\n%s", err, output)
+	}
+	return result
+}
+
+func TestBulkCreateCustomFieldOptionsTool_CreatesInOrder(t *testing.T) {
+	var created []incidentio.CreateCustomFieldOptionRequest
+	tool := NewBulkCreateCustomFieldOptionsTool(newCustomFieldOptionsClient(t, &created))
+
+	result := executeBulkCreateOptions(t, tool, map[string]interface{}{
+		"custom_field_id": "team",
+		"options":         []interface{}{"Search", "Billing", "Growth"},
+	})
+
+	want := []incidentio.CreateCustomFieldOptionRequest{
+		{CustomFieldID: "cf_team", Value: "Search", SortKey: 20},
+		{CustomFieldID: "cf_team", Value: "Billing", SortKey: 30},
+		{CustomFieldID: "cf_team", Value: "Growth", SortKey: 40},
+	}
+	if !reflect.DeepEqual(created, want) {
+		t.Errorf("expected requests %+v, got %+v", want, created)
+	}
+
+	if len(result.Created) != 3 || len(result.Errors) != 0 {
+		t.Fatalf("expected 3 created and no errors, got %+v", result)
+	}
+	for i, option := range result.Created {
+		if option.Value != want[i].Value || option.SortKey != want[i].SortKey {
+			t.Errorf("created[%d]: expected %s with sort_key %d, got %+v", i, want[i].Value, want[i].SortKey, option)
+		}
+	}
+}
+
+func TestBulkCreateCustomFieldOptionsTool_PartialFailure(t *testing.T) {
+	var created []incidentio.CreateCustomFieldOptionRequest
+	tool := NewBulkCreateCustomFieldOptionsTool(newCustomFieldOptionsClient(t, &created, "Billing"))
+
+	result := executeBulkCreateOptions(t, tool, map[string]interface{}{
+		"custom_field_id": "cf_team",
+		"options":         []interface{}{"Search", "Billing", "Growth"},
+		"sort_key_start":  float64(100),
+	})
+
+	if len(result.Created) != 2 || result.Created[0].Value != "Search" || result.Created[1].Value != "Growth" {
+		t.Fatalf("expected Search and Growth to be created, got %+v", result.Created)
+	}
+	// The failed value doesn't use up a sort key
+	if result.Created[0].SortKey != 100 || result.Created[1].SortKey != 110 {
+		t.Errorf("expected sort keys 100 and 110, got %d and %d", result.Created[0].SortKey, result.Created[1].SortKey)
+	}
+	if !contains(result.Errors["Billing"], "already exists") {
+		t.Errorf("expected an error for Billing, got %v", result.Errors)
+	}
+}
+
+func TestBulkCreateCustomFieldOptionsTool_DuplicateValues(t *testing.T) {
+	var created []incidentio.CreateCustomFieldOptionRequest
+	tool := NewBulkCreateCustomFieldOptionsTool(newCustomFieldOptionsClient(t, &created))
+
+	// "payments" matches an existing option and "Search" repeats within the batch
+	result := executeBulkCreateOptions(t, tool, map[string]interface{}{
+		"custom_field_id": "cf_team",
+		"options":         []interface{}{"Search", "payments", "search"},
+	})
+
+	if len(created) != 1 || created[0].Value != "Search" {
+		t.Errorf("expected only Search to be sent, got %+v", created)
+	}
+	if len(result.Created) != 1 {
+		t.Errorf("expected 1 option created, got %+v", result.Created)
+	}
+	for _, value := range []string{"payments", "search"} {
+		if !contains(result.Errors[value], "already has an option") {
+			t.Errorf("expected a duplicate error for %s, got %v", value, result.Errors)
+		}
+	}
+}
+
+func TestBulkCreateCustomFieldOptionsTool_InvalidField(t *testing.T) {
+	var created []incidentio.CreateCustomFieldOptionRequest
+	tool := NewBulkCreateCustomFieldOptionsTool(newCustomFieldOptionsClient(t, &created))
+
+	tests := []struct {
+		name    string
+		field   string
+		wantErr string
+	}{
+		{name: "unknown field", field: "cf_missing", wantErr: "unknown custom field 'cf_missing'"},
+		{name: "not a select field", field: "Notes", wantErr: "only be added to single_select or multi_select"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tool.Execute(context.Background(), map[string]interface{}{
+				"custom_field_id": tt.field,
+				"options":         []interface{}{"Search"},
+			})
+			if err == nil || !contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestDeleteCustomFieldOptionTool_Execute(t *testing.T) {
+	var deleted []string
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodDelete && r.URL.Path == "/v1/custom_field_options/opt_missing":
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"type": "not_found", "status": 404, "errors": [{"code": "not_found", "message": "Custom field option not found"}]}`)
+		case r.Method == http.MethodDelete && r.URL.Path == "/v1/custom_field_options/opt_in_use":
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprint(w, `{"type": "validation_error", "status": 422, "errors": [{"code": "in_use", "message": "Option is set on 3 incidents"}]}`)
+		case r.Method == http.MethodDelete && r.URL.Path == "/v1/custom_field_options/opt_typo":
+			deleted = append(deleted, "opt_typo")
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	tool := NewDeleteCustomFieldOptionTool(client)
+
+	t.Run("deletes an option", func(t *testing.T) {
+		result, err := tool.Execute(context.Background(), map[string]interface{}{"id": "opt_typo"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(deleted) != 1 {
+			t.Errorf("expected opt_typo to be deleted, got %v", deleted)
+		}
+		if result != "Custom field option opt_typo deleted successfully" {
+			t.Errorf("unexpected result: %s", result)
+		}
+	})
+
+	t.Run("reports a missing option", func(t *testing.T) {
+		_, err := tool.Execute(context.Background(), map[string]interface{}{"id": "opt_missing"})
+		if err == nil || !contains(err.Error(), "custom field option not found: opt_missing") {
+			t.Errorf("expected not found error, got: %v", err)
+		}
+	})
+
+	t.Run("warns when the option is in use", func(t *testing.T) {
+		_, err := tool.Execute(context.Background(), map[string]interface{}{"id": "opt_in_use"})
+		if err == nil || !contains(err.Error(), "still be in use") || !contains(err.Error(), "set on 3 incidents") {
+			t.Errorf("expected in use error, got: %v", err)
+		}
+	})
+}
+
+func TestUpdateCustomFieldOptionTool_Execute(t *testing.T) {
+	var updates []incidentio.UpdateCustomFieldOptionRequest
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/custom_field_options/opt_paymnets":
+			fmt.Fprint(w, `{"custom_field_option": {"id": "opt_paymnets", "custom_field_id": "cf_team", "value": "Paymnets", "sort_key": 30}}`)
+		case r.Method == http.MethodPut && r.URL.Path == "/v1/custom_field_options/opt_paymnets":
+			var req incidentio.UpdateCustomFieldOptionRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Fatalf("failed to decode request body: %v", err)
+			}
+			updates = append(updates, req)
+			fmt.Fprintf(w, `{"custom_field_option": {"id": "opt_paymnets", "custom_field_id": "cf_team", "value": %q, "sort_key": %d}}`, req.Value, req.SortKey)
+		case r.URL.Path == "/v1/custom_field_options/opt_missing":
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"type": "not_found", "status": 404, "errors": [{"code": "not_found", "message": "Custom field option not found"}]}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	tool := NewUpdateCustomFieldOptionTool(client)
+
+	tests := []struct {
+		name string
+		args map[string]interface{}
+		want incidentio.UpdateCustomFieldOptionRequest
+	}{
+		{
+			name: "rename keeps the sort key",
+			args: map[string]interface{}{"id": "opt_paymnets", "value": "Payments"},
+			want: incidentio.UpdateCustomFieldOptionRequest{Value: "Payments", SortKey: 30},
+		},
+		{
+			name: "reorder keeps the value",
+			args: map[string]interface{}{"id": "opt_paymnets", "sort_key": float64(5)},
+			want: incidentio.UpdateCustomFieldOptionRequest{Value: "Paymnets", SortKey: 5},
+		},
+		{
+			name: "rename and reorder",
+			args: map[string]interface{}{"id": "opt_paymnets", "value": "Payments", "sort_key": float64(0)},
+			want: incidentio.UpdateCustomFieldOptionRequest{Value: "Payments", SortKey: 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updates = nil
+			result, err := tool.Execute(context.Background(), tt.args)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(updates) != 1 || updates[0] != tt.want {
+				t.Errorf("expected update %+v, got %+v", tt.want, updates)
+			}
+
+			var option incidentio.CustomFieldOption
+			if err := json.Unmarshal([]byte(result), &option); err != nil {
+				t.Fatalf("failed to parse result: %v\n%s", err, result)
+			}
+			if option.Value != tt.want.Value || option.SortKey != tt.want.SortKey {
+				t.Errorf("expected updated option %+v, got %+v", tt.want, option)
+			}
+		})
+	}
+
+	t.Run("requires a mutable field", func(t *testing.T) {
+		_, err := tool.Execute(context.Background(), map[string]interface{}{"id": "opt_paymnets"})
+		if err == nil || !contains(err.Error(), "at least one of value or sort_key") {
+			t.Errorf("expected missing field error, got: %v", err)
+		}
+	})
+
+	t.Run("reports a missing option", func(t *testing.T) {
+		_, err := tool.Execute(context.Background(), map[string]interface{}{"id": "opt_missing", "value": "Search"})
+		if err == nil || !contains(err.Error(), "custom field option not found: opt_missing") {
+			t.Errorf("expected not found error, got: %v", err)
+		}
+	})
+}
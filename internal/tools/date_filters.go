@@ -0,0 +1,165 @@
+package tools
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	// Embed the time zone database so the timezone argument works in
+	// minimal container images without one
+	_ "time/tzdata"
+)
+
+// dateOnlyLayout is the layout of date-only filter values such as 2024-12-01
+const dateOnlyLayout = "2006-01-02"
+
+// dateBound is a parsed date filter value. A date-only value covers the whole
+// day in the filter's time zone, so start and end differ; an RFC 3339 time is
+// a single instant.
+type dateBound struct {
+	start time.Time
+	end   time.Time
+	// dateOnly is set for a date-only value in UTC, which the API is sent as is
+	dateOnly bool
+}
+
+// relativeTime matches "now" optionally offset by a number of minutes, hours,
+// days or weeks, such as "now-7d" or "now-24h"
+var relativeTime = regexp.MustCompile(`^now(?:([+-])(\d+)([mhdw]))?$`)
+
+// relativeUnits are the units a relative time can be offset by
+var relativeUnits = map[string]time.Duration{
+	"m": time.Minute,
+	"h": time.Hour,
+	"d": 24 * time.Hour,
+	"w": 7 * 24 * time.Hour,
+}
+
+// dateParser parses date filter values. Date-only values are days in loc,
+// and relative values are resolved against now.
+type dateParser struct {
+	loc *time.Location
+	now time.Time
+}
+
+// parseBound parses a date filter value given as a date (2024-12-01), an
+// RFC 3339 time, "today", "yesterday", or a time relative to now such as
+// "now-7d"
+func (p dateParser) parseBound(argument, value string) (dateBound, error) {
+	value = strings.TrimSpace(value)
+
+	switch strings.ToLower(value) {
+	case "today":
+		return p.day(p.now.In(p.loc)), nil
+	case "yesterday":
+		return p.day(p.now.In(p.loc).AddDate(0, 0, -1)), nil
+	}
+	if match := relativeTime.FindStringSubmatch(strings.ToLower(value)); match != nil {
+		instant := p.now
+		if match[1] != "" {
+			amount, err := strconv.Atoi(match[2])
+			if err != nil {
+				return dateBound{}, fmt.Errorf("invalid %s '%s': %w", argument, value, err)
+			}
+			offset := time.Duration(amount) * relativeUnits[match[3]]
+			if match[1] == "-" {
+				offset = -offset
+			}
+			instant = instant.Add(offset)
+		}
+		return dateBound{start: instant, end: instant}, nil
+	}
+
+	if date, err := time.ParseInLocation(dateOnlyLayout, value, p.loc); err == nil {
+		return p.day(date), nil
+	}
+	if instant, err := time.Parse(time.RFC3339, value); err == nil {
+		return dateBound{start: instant, end: instant}, nil
+	}
+
+	return dateBound{}, fmt.Errorf("invalid %s '%s': use a date such as 2024-12-01, an RFC 3339 time such as 2024-12-01T09:30:00Z, or a relative date such as today, yesterday or now-7d", argument, value)
+}
+
+// day is the bound covering the whole day in p.loc that t falls on
+func (p dateParser) day(t time.Time) dateBound {
+	start := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, p.loc)
+	return dateBound{
+		start:    start,
+		end:      start.AddDate(0, 0, 1).Add(-time.Nanosecond),
+		dateOnly: p.loc == time.UTC,
+	}
+}
+
+// apiValue is the bound as sent to the API: a UTC date as is, and otherwise
+// the UTC instant its day starts, or ends when it is an upper bound
+func (b dateBound) apiValue(upper bool) string {
+	if b.dateOnly {
+		return b.start.Format(dateOnlyLayout)
+	}
+	if upper {
+		return b.end.UTC().Format(time.RFC3339)
+	}
+	return b.start.UTC().Format(time.RFC3339)
+}
+
+// parseTimezone reads the optional timezone argument, an IANA zone name used
+// to interpret date-only filters. It defaults to UTC.
+func parseTimezone(args map[string]interface{}) (*time.Location, error) {
+	name, _ := args["timezone"].(string)
+	if name == "" || strings.EqualFold(name, "UTC") {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone '%s': use an IANA time zone name such as America/Los_Angeles", name)
+	}
+	return loc, nil
+}
+
+// parseFilters validates the <field>_gte, <field>_lte and <field>_range
+// arguments, returning them normalized for the API. Ranges that end before
+// they start are rejected, since the API would silently match nothing.
+func (p dateParser) parseFilters(args map[string]interface{}, field string) (gte, lte, dateRange string, err error) {
+	var from, to *dateBound
+
+	if value, ok := args[field+"_gte"].(string); ok && value != "" {
+		bound, err := p.parseBound(field+"_gte", value)
+		if err != nil {
+			return "", "", "", err
+		}
+		from, gte = &bound, bound.apiValue(false)
+	}
+	if value, ok := args[field+"_lte"].(string); ok && value != "" {
+		bound, err := p.parseBound(field+"_lte", value)
+		if err != nil {
+			return "", "", "", err
+		}
+		to, lte = &bound, bound.apiValue(true)
+	}
+	if from != nil && to != nil && from.start.After(to.end) {
+		return "", "", "", fmt.Errorf("invalid %s range: %s_gte (%s) is after %s_lte (%s)", field, field, gte, field, lte)
+	}
+
+	if value, ok := args[field+"_range"].(string); ok && value != "" {
+		parts := strings.Split(value, "~")
+		if len(parts) != 2 {
+			return "", "", "", fmt.Errorf("invalid %s_range '%s': use two dates separated by '~', such as 2024-12-01~2024-12-31", field, value)
+		}
+		start, err := p.parseBound(field+"_range", parts[0])
+		if err != nil {
+			return "", "", "", err
+		}
+		end, err := p.parseBound(field+"_range", parts[1])
+		if err != nil {
+			return "", "", "", err
+		}
+		if start.start.After(end.end) {
+			return "", "", "", fmt.Errorf("invalid %s_range '%s': the start (%s) is after the end (%s)", field, value, start.apiValue(false), end.apiValue(true))
+		}
+		dateRange = start.apiValue(false) + "~" + end.apiValue(true)
+	}
+
+	return gte, lte, dateRange, nil
+}
@@ -0,0 +1,211 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseDateFilters(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      map[string]interface{}
+		wantGTE   string
+		wantLTE   string
+		wantRange string
+		wantErr   string
+	}{
+		{
+			name:    "date-only values are kept",
+			args:    map[string]interface{}{"created_at_gte": " 2024-12-01 ", "created_at_lte": "2024-12-31"},
+			wantGTE: "2024-12-01",
+			wantLTE: "2024-12-31",
+		},
+		{
+			name:    "times are normalized to UTC",
+			args:    map[string]interface{}{"created_at_gte": "2024-12-01T09:30:00+01:00"},
+			wantGTE: "2024-12-01T08:30:00Z",
+		},
+		{
+			name:    "same-day date and time bounds",
+			args:    map[string]interface{}{"created_at_gte": "2024-12-01T10:00:00Z", "created_at_lte": "2024-12-01"},
+			wantGTE: "2024-12-01T10:00:00Z",
+			wantLTE: "2024-12-01",
+		},
+		{
+			name:      "range",
+			args:      map[string]interface{}{"created_at_range": "2024-12-01~2024-12-31T12:00:00-05:00"},
+			wantRange: "2024-12-01~2024-12-31T17:00:00Z",
+		},
+		{
+			name:    "invalid month",
+			args:    map[string]interface{}{"created_at_gte": "2024-13-01"},
+			wantErr: "invalid created_at_gte '2024-13-01'",
+		},
+		{
+			name:    "reversed bounds",
+			args:    map[string]interface{}{"created_at_gte": "2024-12-31", "created_at_lte": "2024-12-01"},
+			wantErr: "created_at_gte (2024-12-31) is after created_at_lte (2024-12-01)",
+		},
+		{
+			name:    "reversed range",
+			args:    map[string]interface{}{"created_at_range": "2024-12-31~2024-12-01"},
+			wantErr: "the start (2024-12-31) is after the end (2024-12-01)",
+		},
+		{
+			name:    "range without separator",
+			args:    map[string]interface{}{"created_at_range": "2024-12-01"},
+			wantErr: "use two dates separated by '~'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gte, lte, dateRange, err := dateParser{loc: time.UTC, now: time.Now()}.parseFilters(tt.args, "created_at")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got: %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if gte != tt.wantGTE || lte != tt.wantLTE || dateRange != tt.wantRange {
+				t.Errorf("expected gte=%q lte=%q range=%q, got gte=%q lte=%q range=%q", tt.wantGTE, tt.wantLTE, tt.wantRange, gte, lte, dateRange)
+			}
+		})
+	}
+}
+
+func TestParseDateFilters_Timezone(t *testing.T) {
+	loc, err := parseTimezone(map[string]interface{}{"timezone": "America/Los_Angeles"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	gte, lte, dateRange, err := dateParser{loc: loc, now: time.Now()}.parseFilters(map[string]interface{}{
+		"created_at_gte":   "2024-12-01",
+		"created_at_lte":   "2024-12-01",
+		"created_at_range": "2024-07-01~2024-07-02T12:00:00Z",
+	}, "created_at")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Midnight Pacific Standard Time is 08:00 UTC
+	if gte != "2024-12-01T08:00:00Z" {
+		t.Errorf("expected gte at the start of the day in Los Angeles, got %s", gte)
+	}
+	if lte != "2024-12-02T07:59:59Z" {
+		t.Errorf("expected lte at the end of the day in Los Angeles, got %s", lte)
+	}
+	// Daylight saving time applies in July; explicit times are unchanged
+	if dateRange != "2024-07-01T07:00:00Z~2024-07-02T12:00:00Z" {
+		t.Errorf("expected range to start at midnight Pacific Daylight Time, got %s", dateRange)
+	}
+
+	if _, err := parseTimezone(map[string]interface{}{"timezone": "Pacific/Nowhere"}); err == nil || !strings.Contains(err.Error(), "invalid timezone 'Pacific/Nowhere'") {
+		t.Errorf("expected invalid timezone error, got: %v", err)
+	}
+	if loc, err := parseTimezone(map[string]interface{}{}); err != nil || loc != time.UTC {
+		t.Errorf("expected UTC by default, got %v, %v", loc, err)
+	}
+}
+
+func TestParseDateFilters_Relative(t *testing.T) {
+	now := time.Date(2024, 12, 10, 3, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		loc       string
+		args      map[string]interface{}
+		wantGTE   string
+		wantLTE   string
+		wantRange string
+	}{
+		{name: "now-7d", args: map[string]interface{}{"created_at_gte": "now-7d"}, wantGTE: "2024-12-03T03:30:00Z"},
+		{name: "now-24h to now", args: map[string]interface{}{"created_at_range": "now-24h~now"}, wantRange: "2024-12-09T03:30:00Z~2024-12-10T03:30:00Z"},
+		{name: "today", args: map[string]interface{}{"created_at_gte": "today", "created_at_lte": "Today"}, wantGTE: "2024-12-10", wantLTE: "2024-12-10"},
+		{name: "yesterday", args: map[string]interface{}{"created_at_gte": "yesterday"}, wantGTE: "2024-12-09"},
+		// 03:30 UTC is still the previous evening in Los Angeles
+		{name: "today in a time zone", loc: "America/Los_Angeles", args: map[string]interface{}{"created_at_gte": "today", "created_at_lte": "today"}, wantGTE: "2024-12-09T08:00:00Z", wantLTE: "2024-12-10T07:59:59Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc, err := parseTimezone(map[string]interface{}{"timezone": tt.loc})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			gte, lte, dateRange, err := dateParser{loc: loc, now: now}.parseFilters(tt.args, "created_at")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if gte != tt.wantGTE || lte != tt.wantLTE || dateRange != tt.wantRange {
+				t.Errorf("expected gte=%q lte=%q range=%q, got gte=%q lte=%q range=%q", tt.wantGTE, tt.wantLTE, tt.wantRange, gte, lte, dateRange)
+			}
+		})
+	}
+
+	if _, _, _, err := (dateParser{loc: time.UTC, now: now}).parseFilters(map[string]interface{}{"created_at_gte": "now-7y"}, "created_at"); err == nil {
+		t.Error("expected an error for an unknown relative unit")
+	}
+}
+
+func TestListIncidentsTool_DateFilters(t *testing.T) {
+	var queries []string
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		fmt.Fprint(w, `{"incidents": [], "pagination_meta": {"page_size": 25}}`)
+	})
+	tool := NewListIncidentsTool(client)
+
+	t.Run("rejects a reversed updated_at range before calling the API", func(t *testing.T) {
+		queries = nil
+		_, err := tool.Execute(context.Background(), map[string]interface{}{
+			"page_size":        float64(25),
+			"updated_at_range": "2024-12-08~2024-12-02",
+		})
+		if err == nil || !strings.Contains(err.Error(), "invalid updated_at_range") {
+			t.Fatalf("expected invalid range error, got: %v", err)
+		}
+		if len(queries) != 0 {
+			t.Errorf("expected no API requests, got %v", queries)
+		}
+	})
+
+	t.Run("resolves relative dates against the tool's clock", func(t *testing.T) {
+		queries = nil
+		tool.now = func() time.Time { return time.Date(2024, 12, 10, 15, 0, 0, 0, time.UTC) }
+		defer func() { tool.now = time.Now }()
+
+		_, err := tool.Execute(context.Background(), map[string]interface{}{
+			"page_size":      float64(25),
+			"created_at_gte": "now-7d",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(queries) != 1 || !strings.Contains(queries[0], "created_at%5Bgte%5D=2024-12-03T15%3A00%3A00Z") {
+			t.Errorf("expected the resolved date in the query, got %v", queries)
+		}
+	})
+
+	t.Run("sends normalized dates", func(t *testing.T) {
+		queries = nil
+		_, err := tool.Execute(context.Background(), map[string]interface{}{
+			"page_size":      float64(25),
+			"updated_at_gte": "2024-12-02T01:00:00+02:00",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(queries) != 1 || !strings.Contains(queries[0], "updated_at%5Bgte%5D=2024-12-01T23%3A00%3A00Z") {
+			t.Errorf("expected the normalized date in the query, got %v", queries)
+		}
+	})
+}
@@ -1,6 +1,7 @@
 package tools
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -71,7 +72,7 @@ func (t *DebugIncidentTool) InputSchema() map[string]interface{} {
 	}
 }
 
-func (t *DebugIncidentTool) Execute(args map[string]interface{}) (string, error) {
+func (t *DebugIncidentTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
 	identifier, ok := args["incident_id"].(string)
 	if !ok || identifier == "" {
 		return "", fmt.Errorf("incident_id parameter is required and must be a non-empty string")
@@ -79,13 +80,13 @@ func (t *DebugIncidentTool) Execute(args map[string]interface{}) (string, error)
 
 	// Resolve identifier to incident ID
 	getIncidentTool := NewGetIncidentTool(t.client)
-	incidentID, err := getIncidentTool.ResolveIncidentIdentifier(identifier)
+	incidentID, err := getIncidentTool.ResolveIncidentIdentifier(ctx, identifier)
 	if err != nil {
 		return "", err
 	}
 
 	// Get the incident
-	incident, err := t.client.GetIncident(incidentID)
+	incident, err := t.client.GetIncident(ctx, incidentID)
 	if err != nil {
 		return "", err
 	}
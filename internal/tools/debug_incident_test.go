@@ -1,6 +1,7 @@
 package tools
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -284,7 +285,7 @@ func TestDebugIncidentTool_Execute(t *testing.T) {
 			tool := NewDebugIncidentTool(client)
 
 			// Execute tool
-			result, err := tool.Execute(map[string]interface{}{
+			result, err := tool.Execute(context.Background(), map[string]interface{}{
 				"incident_id": tt.incidentID,
 			})
 
@@ -342,7 +343,7 @@ func TestDebugIncidentTool_Execute_ParameterValidation(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, err := tool.Execute(tt.args)
+			_, err := tool.Execute(context.Background(), tt.args)
 			if tt.wantError && err == nil {
 				t.Error("Expected error but got none")
 			}
@@ -414,7 +415,7 @@ func TestDebugIncidentTool_Execute_DiagnosticFields(t *testing.T) {
 	}
 
 	tool := NewDebugIncidentTool(client)
-	result, err := tool.Execute(map[string]interface{}{
+	result, err := tool.Execute(context.Background(), map[string]interface{}{
 		"incident_id": "01HSTU7788990011FGHIJKLM",
 	})
 
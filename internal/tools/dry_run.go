@@ -0,0 +1,63 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+// dryRunArgument is accepted by every tool. When true, mutating API requests
+// are previewed instead of sent.
+const dryRunArgument = "dry_run"
+
+// TakeDryRunArgument removes the dry_run argument from args, so tools with
+// strict schemas never see it, and reports whether it was true
+func TakeDryRunArgument(args map[string]interface{}) bool {
+	dryRun, _ := args[dryRunArgument].(bool)
+	delete(args, dryRunArgument)
+	return dryRun
+}
+
+// WithDryRunProperty returns a copy of a tool's input schema that also
+// declares the dry_run argument
+func WithDryRunProperty(schema map[string]interface{}) map[string]interface{} {
+	properties := map[string]interface{}{}
+	if existing, ok := schema["properties"].(map[string]interface{}); ok {
+		for name, property := range existing {
+			properties[name] = property
+		}
+	}
+	properties[dryRunArgument] = map[string]interface{}{
+		"type":        "boolean",
+		"description": "Validate the arguments and return the API request that would be sent, without changing anything",
+		"default":     false,
+	}
+
+	withDryRun := make(map[string]interface{}, len(schema)+1)
+	for key, value := range schema {
+		withDryRun[key] = value
+	}
+	withDryRun["properties"] = properties
+	return withDryRun
+}
+
+// FormatDryRun renders the request a dry run stopped as a tool result
+func FormatDryRun(dryRun *incidentio.DryRunError) (string, error) {
+	var body interface{}
+	if len(dryRun.Body) > 0 {
+		body = dryRun.Body
+	}
+
+	result, err := json.MarshalIndent(map[string]interface{}{
+		"dry_run": true,
+		"message": "Dry run: no changes were made. This request would have been sent to incident.io",
+		"method":  dryRun.Method,
+		"url":     dryRun.URL,
+		"body":    body,
+	}, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to format response: %w", err)
+	}
+	return string(result), nil
+}
@@ -0,0 +1,41 @@
+package tools
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+	"github.com/incident-io/incidentio-mcp-golang/internal/logging"
+)
+
+// FormatError renders a tool error for an MCP error response. incident.io
+// validation errors are expanded to one line per problem so the caller can see
+// which arguments to correct. The API key is always redacted, since the
+// message is shown to the model.
+func FormatError(err error) string {
+	var apiErr *incidentio.APIError
+	if !errors.As(err, &apiErr) || len(apiErr.Errors) == 0 {
+		return logging.Redact(err.Error())
+	}
+
+	var b strings.Builder
+	b.WriteString(err.Error())
+	b.WriteString("\n\nincident.io reported the following problems:")
+
+	hasField := false
+	for _, fieldErr := range apiErr.Errors {
+		b.WriteString("\n- " + fieldErr.String())
+		if fieldErr.Code != "" {
+			fmt.Fprintf(&b, " (%s)", fieldErr.Code)
+		}
+		if fieldErr.Field != "" {
+			hasField = true
+		}
+	}
+
+	if hasField {
+		b.WriteString("\n\nCorrect the listed fields and try again.")
+	}
+	return logging.Redact(b.String())
+}
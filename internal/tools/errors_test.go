@@ -0,0 +1,53 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestFormatError(t *testing.T) {
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{
+			"type": "validation_error",
+			"status": 422,
+			"errors": [{"code": "invalid_value", "message": "Severity not found", "source": {"field": "severity_id"}}]
+		}`)
+	})
+
+	_, err := NewUpdateIncidentTool(client).Execute(context.Background(), map[string]interface{}{
+		"incident_id": "inc_1",
+		"severity_id": "sev_missing",
+	})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	formatted := FormatError(err)
+	for _, expected := range []string{
+		"HTTP 422",
+		"- severity_id: Severity not found (invalid_value)",
+		"Correct the listed fields",
+	} {
+		if !strings.Contains(formatted, expected) {
+			t.Errorf("expected formatted error to contain %q, got:\n%s", expected, formatted)
+		}
+	}
+
+	if plain := FormatError(errors.New("id parameter is required")); plain != "id parameter is required" {
+		t.Errorf("expected non-API errors to be unchanged, got %q", plain)
+	}
+}
+
+func TestFormatError_RedactsAPIKey(t *testing.T) {
+	t.Setenv("INCIDENT_IO_API_KEY", "secret-api-key")
+
+	formatted := FormatError(errors.New("request failed: invalid key secret-api-key"))
+	if formatted != "request failed: invalid key ***" {
+		t.Errorf("expected API key to be redacted, got %q", formatted)
+	}
+}
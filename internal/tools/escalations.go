@@ -0,0 +1,77 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+// ListEscalationsTool lists escalation paths from incident.io
+type ListEscalationsTool struct {
+	client *incidentio.Client
+}
+
+func NewListEscalationsTool(client *incidentio.Client) *ListEscalationsTool {
+	return &ListEscalationsTool{client: client}
+}
+
+func (t *ListEscalationsTool) Name() string {
+	return "list_escalations"
+}
+
+func (t *ListEscalationsTool) Description() string {
+	return `List escalation paths that alert routes can escalate to.
+
+USAGE WORKFLOW:
+1. Call to see all escalation paths with their IDs and names
+2. Use escalation path IDs in the escalations of create_alert_route and update_alert_route
+
+PARAMETERS:
+- page_size: Number of results per page (1-250)
+- after: Pagination cursor for next page
+
+EXAMPLES:
+- List all escalation paths: {}
+- List with pagination: {"page_size": 50, "after": "cursor_abc"}
+
+IMPORTANT: Escalation IDs from this tool are required for alert route escalations.`
+}
+
+func (t *ListEscalationsTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"page_size": map[string]interface{}{
+				"type":        "integer",
+				"description": "Number of results per page",
+				"minimum":     1,
+				"maximum":     250,
+			},
+			"after": map[string]interface{}{
+				"type":        "string",
+				"description": "Pagination cursor for next page",
+			},
+			"fields": fieldsProperty(),
+		},
+		"additionalProperties": false,
+	}
+}
+
+func (t *ListEscalationsTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	params := &incidentio.ListEscalationsParams{}
+
+	if pageSize, ok := args["page_size"].(float64); ok {
+		params.PageSize = int(pageSize)
+	}
+	if after, ok := args["after"].(string); ok {
+		params.After = after
+	}
+
+	result, err := t.client.ListEscalations(ctx, params)
+	if err != nil {
+		return "", fmt.Errorf("failed to list escalations: %w", err)
+	}
+
+	return formatJSONResult(result, args)
+}
@@ -0,0 +1,286 @@
+package tools
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"strings"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+	"github.com/incident-io/incidentio-mcp-golang/internal/logging"
+)
+
+// exportPageSize is the page size used when paging through incidents for export
+const exportPageSize = 250
+
+// ExportIncidentsTool exports filtered incidents for data pipelines
+type ExportIncidentsTool struct {
+	client   *incidentio.Client
+	listTool *ListIncidentsTool
+	pageSize int
+}
+
+func NewExportIncidentsTool(client *incidentio.Client) *ExportIncidentsTool {
+	return &ExportIncidentsTool{
+		client:   client,
+		listTool: newListIncidentsTool(client),
+		pageSize: exportPageSize,
+	}
+}
+
+func (t *ExportIncidentsTool) Name() string {
+	return "export_incidents"
+}
+
+func (t *ExportIncidentsTool) Description() string {
+	return `Export incidents as newline-delimited JSON (NDJSON) for loading into data warehouses and pipelines.
+
+The export is written to a file, one page at a time as each page arrives, so large exports are not held in memory or limited by the response size. Each line of the file is one complete incident JSON object, so it can be split on newlines and every line parsed independently. The result gives the file's path, the number of incidents written and whether more matched.
+
+PARAMETERS:
+- format: Output format. Currently only "ndjson" (default)
+- output_path: Optional. File to write the export to. It must not exist yet; existing files are never overwritten. Defaults to a new file in the system temporary directory
+- fields: Comma-separated fields to include for each incident, using the same syntax as list_incidents
+  * Example: "id,reference,name,severity.name,incident_status.category,created_at"
+  * Omit to export every field
+- status, severity, mode: Same filters as list_incidents (names, aliases and comma-separated strings are accepted)
+- created_at_gte, created_at_lte, created_at_range: Same created_at filters as list_incidents
+- updated_at_gte, updated_at_lte, updated_at_range: Same updated_at filters as list_incidents
+- max_results: Maximum number of incidents to export (default 1000, max 10000)
+
+EXAMPLES:
+- Export closed incidents from December: {"status": "closed", "created_at_range": "2024-12-01~2024-12-31"}
+- Export a narrow projection to a chosen file: {"fields": "id,reference,severity.name,created_at", "max_results": 5000, "output_path": "/tmp/incidents.ndjson"}
+
+Returns {"path": file, "format": "ndjson", "incidents": count, "bytes": size, "truncated": true if more incidents matched than were written}. Progress notifications report each page as it is written. If the export fails part way, the file is removed.
+
+IMPORTANT: Exports are automatically paginated, so large exports make one API request per 250 incidents. Use filters to keep exports focused.`
+}
+
+func (t *ExportIncidentsTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"format": map[string]interface{}{
+				"type":        "string",
+				"description": "Output format: ndjson emits one incident JSON object per line",
+				"enum":        []string{"ndjson"},
+				"default":     "ndjson",
+			},
+			"output_path": map[string]interface{}{
+				"type":        "string",
+				"description": "File to write the export to, which must not exist yet. Defaults to a new file in the system temporary directory.",
+			},
+			"fields": map[string]interface{}{
+				"type":        "string",
+				"description": "Comma-separated fields to include for each incident (e.g. \"id,reference,severity.name\"). Omit to export all fields.",
+			},
+			"status": map[string]interface{}{
+				"type":        []string{"array", "string"},
+				"items":       map[string]interface{}{"type": "string"},
+				"description": "Filter by incident status, as an array or a comma-separated string. Accepts the same values and aliases as list_incidents.",
+			},
+			"severity": map[string]interface{}{
+				"type":        []string{"array", "string"},
+				"items":       map[string]interface{}{"type": "string"},
+				"description": "Filter by severity name or ID, as an array or a comma-separated string. Accepts the same values as list_incidents.",
+			},
+			"mode": map[string]interface{}{
+				"type":        []string{"array", "string"},
+				"items":       map[string]interface{}{"type": "string", "enum": incidentModes},
+				"description": "Filter by incident mode (standard, retrospective, tutorial), as an array or a comma-separated string.",
+			},
+			"created_at_gte": map[string]interface{}{
+				"type":        "string",
+				"description": "Export incidents created on or after this date (ISO 8601 format)",
+			},
+			"created_at_lte": map[string]interface{}{
+				"type":        "string",
+				"description": "Export incidents created on or before this date (ISO 8601 format)",
+			},
+			"created_at_range": map[string]interface{}{
+				"type":        "string",
+				"description": "Export incidents created within a tilde-separated date range, e.g. \"2024-12-01~2024-12-31\"",
+			},
+			"updated_at_gte": map[string]interface{}{
+				"type":        "string",
+				"description": "Export incidents updated on or after this date (ISO 8601 format)",
+			},
+			"updated_at_lte": map[string]interface{}{
+				"type":        "string",
+				"description": "Export incidents updated on or before this date (ISO 8601 format)",
+			},
+			"updated_at_range": map[string]interface{}{
+				"type":        "string",
+				"description": "Export incidents updated within a tilde-separated date range, e.g. \"2024-12-01~2024-12-31\"",
+			},
+			"max_results": map[string]interface{}{
+				"type":        "integer",
+				"description": "Maximum number of incidents to export (default 1000, max 10000)",
+				"default":     defaultAutoPaginateLimit,
+			},
+		},
+		"additionalProperties": false,
+	}
+}
+
+func (t *ExportIncidentsTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	format, _ := args["format"].(string)
+	if format == "" {
+		format = "ndjson"
+	}
+	if format != "ndjson" {
+		return "", fmt.Errorf("unsupported format '%s'. Supported formats: ndjson", format)
+	}
+
+	maxResults := defaultAutoPaginateLimit
+	if value, ok := args["max_results"].(float64); ok {
+		maxResults = int(value)
+	}
+	if maxResults < 1 || maxResults > maxAutoPaginateLimit {
+		return "", fmt.Errorf("max_results must be between 1 and %d, got %d", maxAutoPaginateLimit, maxResults)
+	}
+
+	opts := &incidentio.ListIncidentsOptions{}
+	if err := t.listTool.applyFilters(ctx, args, opts); err != nil {
+		return "", err
+	}
+
+	fieldsStr, _ := args["fields"].(string)
+	var fields map[string]interface{}
+	if fieldsStr != "" {
+		var err error
+		if fields, err = parseFieldList(fieldsStr); err != nil {
+			return "", err
+		}
+	}
+
+	outputPath, _ := args["output_path"].(string)
+	file, err := createExportFile(strings.TrimSpace(outputPath))
+	if err != nil {
+		return "", err
+	}
+
+	written, truncated, err := t.writeNDJSON(ctx, file, opts, fields, maxResults)
+	if closeErr := file.Close(); err == nil && closeErr != nil {
+		err = fmt.Errorf("failed to write export file %s: %w", file.Name(), closeErr)
+	}
+	if err != nil {
+		// A partial export would look complete to whatever loads it next
+		os.Remove(file.Name())
+		return "", err
+	}
+
+	info, err := os.Stat(file.Name())
+	if err != nil {
+		return "", fmt.Errorf("failed to read export file %s: %w", file.Name(), err)
+	}
+
+	return formatJSONResult(map[string]interface{}{
+		"path":      file.Name(),
+		"format":    format,
+		"incidents": written,
+		"bytes":     info.Size(),
+		"truncated": truncated,
+	}, nil)
+}
+
+// createExportFile creates the file an export is written to: path if given,
+// refusing to overwrite an existing file, or a new temporary file otherwise
+func createExportFile(path string) (*os.File, error) {
+	if path == "" {
+		file, err := os.CreateTemp("", "incidents-*.ndjson")
+		if err != nil {
+			return nil, fmt.Errorf("failed to create export file: %w", err)
+		}
+		return file, nil
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if errors.Is(err, fs.ErrExist) {
+		return nil, fmt.Errorf("output_path %s already exists. Choose a new file name; exports never overwrite files", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create export file: %w", err)
+	}
+	return file, nil
+}
+
+// writeNDJSON pages through incidents matching opts and writes each one to w
+// as a single line of JSON, flushing each page before the next is requested,
+// and stops after limit incidents. It returns the number of incidents written
+// and whether more incidents matched than were written.
+func (t *ExportIncidentsTool) writeNDJSON(ctx context.Context, w io.Writer, opts *incidentio.ListIncidentsOptions, fields map[string]interface{}, limit int) (int, bool, error) {
+	pageOpts := *opts
+	pageOpts.PageSize = t.pageSize
+
+	buffered := bufio.NewWriter(w)
+	encoder := json.NewEncoder(buffered)
+	written := 0
+	guard := incidentio.NewPaginationGuard("incidents", pageOpts.After)
+	for page := 1; ; page++ {
+		resp, err := t.listTool.listPage(ctx, &pageOpts)
+		if err != nil {
+			return written, false, err
+		}
+
+		for _, incident := range resp.Incidents {
+			if written >= limit {
+				return written, true, buffered.Flush()
+			}
+			record, err := projectFields(incident, fields)
+			if err != nil {
+				return written, false, err
+			}
+			// Encode writes the value followed by a newline
+			if err := encoder.Encode(record); err != nil {
+				return written, false, fmt.Errorf("failed to write incident %s: %w", incident.ID, err)
+			}
+			written++
+		}
+		if err := buffered.Flush(); err != nil {
+			return written, false, fmt.Errorf("failed to write export: %w", err)
+		}
+
+		// A page can be empty once filtered, so only the cursor says whether
+		// the API has more
+		more := resp.PaginationMeta.After != ""
+		if !more || written >= limit {
+			return written, more, nil
+		}
+		if page >= maxAutoPaginatePages {
+			logging.Warnf("Stopped exporting incidents after %d pages with %d incidents written", page, written)
+			return written, true, nil
+		}
+		if err := guard.Next(resp.PaginationMeta.After, len(resp.Incidents)); err != nil {
+			return written, false, err
+		}
+		reportIncidentProgress(ctx, written, resp.PaginationMeta.TotalRecordCount, limit)
+		pageOpts.After = resp.PaginationMeta.After
+	}
+}
+
+// projectFields applies a parsed field list to a single value. A nil field
+// list returns the value unchanged.
+func projectFields(data interface{}, fields map[string]interface{}) (interface{}, error) {
+	if fields == nil {
+		return data, nil
+	}
+
+	jsonBytes, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal data: %w", err)
+	}
+
+	var rawData interface{}
+	if err := json.Unmarshal(jsonBytes, &rawData); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal data: %w", err)
+	}
+
+	return filterObject(rawData, fields), nil
+}
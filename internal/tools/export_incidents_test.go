@@ -0,0 +1,212 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+// requestCountingWriter records how many list requests had been made when
+// each line was written
+type requestCountingWriter struct {
+	requests       *int
+	requestsAtLine []int
+}
+
+func (w *requestCountingWriter) Write(p []byte) (int, error) {
+	for i := 0; i < strings.Count(string(p), "\n"); i++ {
+		w.requestsAtLine = append(w.requestsAtLine, *w.requests)
+	}
+	return len(p), nil
+}
+
+type exportResult struct {
+	Path      string `json:"path"`
+	Format    string `json:"format"`
+	Incidents int    `json:"incidents"`
+	Bytes     int64  `json:"bytes"`
+	Truncated bool   `json:"truncated"`
+}
+
+// executeExport runs the export and returns its result and the lines of the
+// file it wrote
+func executeExport(t *testing.T, tool *ExportIncidentsTool, args map[string]interface{}) (exportResult, []string) {
+	t.Helper()
+
+	result, err := tool.Execute(context.Background(), args)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var parsed exportResult
+	if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+		t.Fatalf("failed to parse result: %v\n%s", err, result)
+	}
+
+	content, err := os.ReadFile(parsed.Path)
+	if err != nil {
+		t.Fatalf("failed to read export file: %v", err)
+	}
+	if int64(len(content)) != parsed.Bytes {
+		t.Errorf("expected %d bytes in the file, got %d", parsed.Bytes, len(content))
+	}
+	return parsed, strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+}
+
+func TestExportIncidentsTool_NDJSON(t *testing.T) {
+	requests := 0
+	tool := NewExportIncidentsTool(newPagedIncidentsClient(t, &requests))
+	tool.pageSize = 2
+
+	path := filepath.Join(t.TempDir(), "incidents.ndjson")
+	result, lines := executeExport(t, tool, map[string]interface{}{
+		"format":      "ndjson",
+		"fields":      "id,severity.name",
+		"output_path": path,
+	})
+
+	if result.Path != path || result.Format != "ndjson" || result.Incidents != 5 || result.Truncated {
+		t.Errorf("unexpected result: %+v", result)
+	}
+	if len(lines) != 5 {
+		t.Fatalf("expected 5 lines, got %d:\n%s", len(lines), strings.Join(lines, "\n"))
+	}
+
+	for i, line := range lines {
+		var record map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("line %d is not valid JSON: %v\n%s", i+1, err, line)
+		}
+
+		// Only the projected fields should remain
+		for key := range record {
+			if key != "id" && key != "severity" {
+				t.Errorf("line %d: unexpected field %q", i+1, key)
+			}
+		}
+		if expected := fmt.Sprintf("inc_%d", i+1); record["id"] != expected {
+			t.Errorf("line %d: expected id %s, got %v", i+1, expected, record["id"])
+		}
+	}
+}
+
+func TestExportIncidentsTool_MaxResults(t *testing.T) {
+	requests := 0
+	tool := NewExportIncidentsTool(newPagedIncidentsClient(t, &requests))
+	tool.pageSize = 2
+
+	result, lines := executeExport(t, tool, map[string]interface{}{
+		"fields":      "id",
+		"max_results": float64(3),
+		"output_path": filepath.Join(t.TempDir(), "incidents.ndjson"),
+	})
+
+	if len(lines) != 3 || result.Incidents != 3 {
+		t.Errorf("expected 3 incidents, got %d lines and result %+v", len(lines), result)
+	}
+	if !result.Truncated {
+		t.Error("expected the export to be marked truncated")
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 page requests, got %d", requests)
+	}
+}
+
+func TestExportIncidentsTool_DefaultsToTemporaryFile(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("TMPDIR", dir)
+
+	requests := 0
+	tool := NewExportIncidentsTool(newPagedIncidentsClient(t, &requests))
+	tool.pageSize = 2
+
+	result, lines := executeExport(t, tool, map[string]interface{}{"fields": "id"})
+	if filepath.Dir(result.Path) != dir || !strings.HasSuffix(result.Path, ".ndjson") {
+		t.Errorf("expected an .ndjson file in %s, got %s", dir, result.Path)
+	}
+	if len(lines) != 5 {
+		t.Errorf("expected 5 lines, got %d", len(lines))
+	}
+}
+
+func TestExportIncidentsTool_RefusesExistingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "incidents.ndjson")
+	if err := os.WriteFile(path, []byte("keep me\n"), 0o600); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	requests := 0
+	tool := NewExportIncidentsTool(newPagedIncidentsClient(t, &requests))
+	_, err := tool.Execute(context.Background(), map[string]interface{}{"output_path": path})
+	if err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Fatalf("expected an already exists error, got: %v", err)
+	}
+
+	if content, _ := os.ReadFile(path); string(content) != "keep me\n" {
+		t.Errorf("expected the existing file to be left alone, got %q", content)
+	}
+	if requests != 0 {
+		t.Errorf("expected no requests, got %d", requests)
+	}
+}
+
+func TestExportIncidentsTool_RemovesFileOnFailure(t *testing.T) {
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("after") == "" {
+			fmt.Fprint(w, `{"incidents": [{"id": "inc_1"}], "pagination_meta": {"after": "inc_1", "page_size": 1}}`)
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"type": "validation_error", "errors": [{"message": "invalid cursor"}]}`)
+	})
+
+	path := filepath.Join(t.TempDir(), "incidents.ndjson")
+	if _, err := NewExportIncidentsTool(client).Execute(context.Background(), map[string]interface{}{"output_path": path}); err == nil {
+		t.Fatal("expected the export to fail")
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("expected the partial export to be removed, got %v", err)
+	}
+}
+
+func TestExportIncidentsTool_WritesEachPageBeforeTheNext(t *testing.T) {
+	requests := 0
+	tool := NewExportIncidentsTool(newPagedIncidentsClient(t, &requests))
+	tool.pageSize = 2
+
+	fields, err := parseFieldList("id")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	w := &requestCountingWriter{requests: &requests}
+	written, truncated, err := tool.writeNDJSON(context.Background(), w, &incidentio.ListIncidentsOptions{}, fields, 100)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if written != 5 || truncated {
+		t.Fatalf("expected 5 incidents written and no truncation, got %d (truncated %v)", written, truncated)
+	}
+
+	// Incidents from each page are written before the next page is requested
+	expected := []int{1, 1, 2, 2, 3}
+	for i, requestCount := range w.requestsAtLine {
+		if requestCount != expected[i] {
+			t.Errorf("line %d: written after %d requests, expected %d", i+1, requestCount, expected[i])
+		}
+	}
+}
+
+func TestExportIncidentsTool_UnsupportedFormat(t *testing.T) {
+	tool := &ExportIncidentsTool{}
+
+	_, err := tool.Execute(context.Background(), map[string]interface{}{"format": "csv"})
+	if err == nil {
+		t.Fatal("expected error for unsupported format")
+	}
+}
@@ -3,27 +3,60 @@ package tools
 import (
 	"encoding/json"
 	"fmt"
-	"log"
 	"strings"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/logging"
 )
 
+// collectionKeys are the keys that hold the items of list API responses
+var collectionKeys = []string{
+	"incidents",
+	"alerts",
+	"actions",
+	"alert_routes",
+	"alert_sources",
+	"catalog_entries",
+	"catalog_types",
+	"custom_fields",
+	"escalation_paths",
+	"follow_ups",
+	"incident_roles",
+	"incident_statuses",
+	"incident_subscribers",
+	"incident_types",
+	"incident_updates",
+	"severities",
+	"timeline",
+	"users",
+	"workflows",
+}
+
 // FilterFields filters a JSON object to only include the specified fields.
 // Fields can be specified as:
 // - Top-level fields: "id", "name", "summary"
 // - Nested fields with dot notation: "severity.name", "incident_status.category"
-// - Array elements are filtered recursively
+// - Array elements are filtered recursively: when a path crosses an array, the
+//   rest of the path applies to each element, so "incident_role_assignments.role.name"
+//   keeps just the role name of every assignment
+// - A leading "-" excludes a field instead: "-incident_role_assignments,-severity.description"
+// - "*" keeps every field at its level: "*,severity.name" keeps all top-level
+//   fields but trims severity to its name
 //
-// For API responses with collection fields (incidents, alerts), the field filter
-// is automatically applied to the items in the collection, not the response wrapper.
+// Inclusion and exclusion cannot be mixed in one field list.
+//
+// For API responses with collection fields (incidents, alerts, severities and
+// the other keys in collectionKeys), the field filter is automatically applied
+// to the items in the collection, not the response wrapper.
+// Other top-level keys such as pagination_meta are kept as-is.
 //
 // Example:
 //   fields := "id,name,severity.name,incident_status.category"
 //   filtered, err := FilterFields(data, fields)
 func FilterFields(data interface{}, fieldsStr string) (string, error) {
-	log.Printf("[FilterFields] START - fieldsStr=%q", fieldsStr)
+	logging.Debugf("[FilterFields] START - fieldsStr=%q", fieldsStr)
 
 	if fieldsStr == "" {
-		log.Printf("[FilterFields] No fields specified, returning all data")
+		logging.Debugf("[FilterFields] No fields specified, returning all data")
 		// No filtering requested, return original data
 		result, err := json.MarshalIndent(data, "", "  ")
 		if err != nil {
@@ -33,15 +66,18 @@ func FilterFields(data interface{}, fieldsStr string) (string, error) {
 	}
 
 	// Parse field list
-	fields := parseFieldList(fieldsStr)
-	log.Printf("[FilterFields] Parsed fields structure: %+v", fields)
+	fields, err := parseFieldList(fieldsStr)
+	if err != nil {
+		return "", err
+	}
+	logging.Debugf("[FilterFields] Parsed fields structure: %+v", fields)
 
 	// Marshal to JSON first to get map representation
 	jsonBytes, err := json.Marshal(data)
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal data: %w", err)
 	}
-	log.Printf("[FilterFields] JSON bytes length: %d", len(jsonBytes))
+	logging.Debugf("[FilterFields] JSON bytes length: %d", len(jsonBytes))
 
 	var rawData interface{}
 	if err := json.Unmarshal(jsonBytes, &rawData); err != nil {
@@ -51,64 +87,37 @@ func FilterFields(data interface{}, fieldsStr string) (string, error) {
 	// Check if this is a collection response (incidents, alerts, etc.)
 	// If so, apply filtering to the collection items, not the response wrapper
 	if dataMap, ok := rawData.(map[string]interface{}); ok {
-		log.Printf("[FilterFields] Data is a map with keys: %v", getKeys(dataMap))
-
-		if incidents, hasIncidents := dataMap["incidents"]; hasIncidents {
-			log.Printf("[FilterFields] Found incidents collection")
-			if incArray, ok := incidents.([]interface{}); ok {
-				log.Printf("[FilterFields] Incidents array has %d items", len(incArray))
-				if len(incArray) > 0 {
-					firstInc, _ := json.Marshal(incArray[0])
-					log.Printf("[FilterFields] First incident sample: %s", string(firstInc))
-				}
-			}
-
-			// Filter the incidents array
-			filteredIncidents := filterObject(incidents, fields)
-			log.Printf("[FilterFields] Filtered incidents: %+v", filteredIncidents)
+		logging.Debugf("[FilterFields] Data is a map with keys: %v", getKeys(dataMap))
 
-			// Preserve the response structure with filtered incidents
-			filtered := map[string]interface{}{
-				"incidents": filteredIncidents,
-			}
-			// Include pagination_meta if present
-			if paginationMeta, hasPagination := dataMap["pagination_meta"]; hasPagination {
-				filtered["pagination_meta"] = paginationMeta
-			}
-			result, err := json.MarshalIndent(filtered, "", "  ")
-			if err != nil {
-				return "", fmt.Errorf("failed to marshal filtered data: %w", err)
+		for _, collectionKey := range collectionKeys {
+			items, isCollection := dataMap[collectionKey].([]interface{})
+			if !isCollection {
+				continue
 			}
-			log.Printf("[FilterFields] END - returning %d bytes", len(result))
-			return string(result), nil
-		}
+			logging.Debugf("[FilterFields] Found %s collection with %d items", collectionKey, len(items))
 
-		if alerts, hasAlerts := dataMap["alerts"]; hasAlerts {
-			log.Printf("[FilterFields] Found alerts collection")
-			// Filter the alerts array
-			filteredAlerts := filterObject(alerts, fields)
-			// Preserve the response structure with filtered alerts
-			filtered := map[string]interface{}{
-				"alerts": filteredAlerts,
-			}
-			// Include pagination_meta if present
-			if paginationMeta, hasPagination := dataMap["pagination_meta"]; hasPagination {
-				filtered["pagination_meta"] = paginationMeta
+			// Preserve the response structure with filtered items, and response
+			// metadata such as pagination_meta as-is
+			filtered := make(map[string]interface{}, len(dataMap))
+			for key, value := range dataMap {
+				filtered[key] = value
 			}
+			filtered[collectionKey] = filterObject(items, fields)
+
 			result, err := json.MarshalIndent(filtered, "", "  ")
 			if err != nil {
 				return "", fmt.Errorf("failed to marshal filtered data: %w", err)
 			}
-			log.Printf("[FilterFields] END - returning %d bytes", len(result))
+			logging.Debugf("[FilterFields] END - returning %d bytes", len(result))
 			return string(result), nil
 		}
 
-		log.Printf("[FilterFields] No collection found, filtering data map directly")
+		logging.Debugf("[FilterFields] No collection found, filtering data map directly")
 	}
 
 	// Default behavior: filter the object directly
 	filtered := filterObject(rawData, fields)
-	log.Printf("[FilterFields] Filtered result: %+v", filtered)
+	logging.Debugf("[FilterFields] Filtered result: %+v", filtered)
 
 	// Marshal the filtered result
 	result, err := json.MarshalIndent(filtered, "", "  ")
@@ -116,7 +125,7 @@ func FilterFields(data interface{}, fieldsStr string) (string, error) {
 		return "", fmt.Errorf("failed to marshal filtered data: %w", err)
 	}
 
-	log.Printf("[FilterFields] END - returning %d bytes", len(result))
+	logging.Debugf("[FilterFields] END - returning %d bytes", len(result))
 	return string(result), nil
 }
 
@@ -129,9 +138,12 @@ func getKeys(m map[string]interface{}) []string {
 	return keys
 }
 
-// parseFieldList parses a comma-separated field list into a hierarchical structure
-func parseFieldList(fieldsStr string) map[string]interface{} {
+// parseFieldList parses a comma-separated field list into a hierarchical
+// structure. Leaves are true for included fields and false for fields excluded
+// with a leading "-".
+func parseFieldList(fieldsStr string) (map[string]interface{}, error) {
 	fields := make(map[string]interface{})
+	var included, excluded []string
 
 	for _, field := range strings.Split(fieldsStr, ",") {
 		field = strings.TrimSpace(field)
@@ -139,14 +151,28 @@ func parseFieldList(fieldsStr string) map[string]interface{} {
 			continue
 		}
 
+		include := !strings.HasPrefix(field, "-")
+		if include {
+			included = append(included, field)
+		} else {
+			field = strings.TrimSpace(strings.TrimPrefix(field, "-"))
+			excluded = append(excluded, field)
+			if field == "" {
+				return nil, fmt.Errorf("invalid field '-': a field name must follow '-'")
+			}
+			if field == "*" || strings.HasSuffix(field, ".*") {
+				return nil, fmt.Errorf("invalid field '-%s': '*' cannot be excluded", field)
+			}
+		}
+
 		// Split by dot for nested fields
 		parts := strings.Split(field, ".")
 		current := fields
 
 		for i, part := range parts {
 			if i == len(parts)-1 {
-				// Leaf node - mark as included
-				current[part] = true
+				// Leaf node - mark as included or excluded
+				current[part] = include
 			} else {
 				// Intermediate node - create nested map if needed
 				if _, exists := current[part]; !exists {
@@ -160,7 +186,30 @@ func parseFieldList(fieldsStr string) map[string]interface{} {
 		}
 	}
 
-	return fields
+	if len(included) > 0 && len(excluded) > 0 {
+		return nil, fmt.Errorf("cannot mix included and excluded fields (included: %s; excluded: -%s). Use either a list of fields to keep or a list of '-' prefixed fields to drop",
+			strings.Join(included, ", "), strings.Join(excluded, ", -"))
+	}
+
+	return fields, nil
+}
+
+// excludesFields reports whether a field specification lists fields to drop
+// rather than fields to keep
+func excludesFields(fields map[string]interface{}) bool {
+	for _, spec := range fields {
+		switch spec := spec.(type) {
+		case bool:
+			if !spec {
+				return true
+			}
+		case map[string]interface{}:
+			if excludesFields(spec) {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 // filterObject recursively filters an object based on the field specification
@@ -177,44 +226,53 @@ func filterObject(data interface{}, fields map[string]interface{}) interface{} {
 
 // filterMap filters a map object
 func filterMap(data map[string]interface{}, fields map[string]interface{}) map[string]interface{} {
-	log.Printf("[filterMap] Filtering map with %d keys, fields spec: %+v", len(data), fields)
+	logging.Debugf("[filterMap] Filtering map with %d keys, fields spec: %+v", len(data), fields)
 
 	if len(fields) == 0 {
 		// No fields specified, include everything
-		log.Printf("[filterMap] No fields specified, returning all %d keys", len(data))
+		logging.Debugf("[filterMap] No fields specified, returning all %d keys", len(data))
 		return data
 	}
 
 	result := make(map[string]interface{})
-	log.Printf("[filterMap] Data keys: %v", getKeys(data))
-	log.Printf("[filterMap] Requested fields: %v", getKeys(fields))
+	logging.Debugf("[filterMap] Data keys: %v", getKeys(data))
+	logging.Debugf("[filterMap] Requested fields: %v", getKeys(fields))
+
+	// Fields missing from the spec are kept when excluding or when "*" is given
+	keepUnlisted := fields["*"] == true || excludesFields(fields)
 
 	for key, value := range data {
 		if fieldSpec, exists := fields[key]; exists {
-			log.Printf("[filterMap] Field %q exists in spec: %+v", key, fieldSpec)
+			logging.Debugf("[filterMap] Field %q exists in spec: %+v", key, fieldSpec)
 			switch spec := fieldSpec.(type) {
 			case bool:
-				// Simple field - include as-is
+				// Simple field - include as-is, or drop it when excluded
 				if spec {
-					log.Printf("[filterMap] Including simple field %q", key)
+					logging.Debugf("[filterMap] Including simple field %q", key)
 					result[key] = value
+				} else {
+					logging.Debugf("[filterMap] Excluding field %q", key)
 				}
 			case map[string]interface{}:
 				// Nested field - recursively filter
-				log.Printf("[filterMap] Recursively filtering nested field %q", key)
+				logging.Debugf("[filterMap] Recursively filtering nested field %q", key)
 				filtered := filterObject(value, spec)
 				result[key] = filtered
 			}
+		} else if keepUnlisted {
+			logging.Debugf("[filterMap] Field %q NOT in spec, keeping", key)
+			result[key] = value
 		} else {
-			log.Printf("[filterMap] Field %q NOT in spec, skipping", key)
+			logging.Debugf("[filterMap] Field %q NOT in spec, skipping", key)
 		}
 	}
 
-	log.Printf("[filterMap] Result has %d keys: %v", len(result), getKeys(result))
+	logging.Debugf("[filterMap] Result has %d keys: %v", len(result), getKeys(result))
 	return result
 }
 
-// filterArray filters an array by applying the same filter to each element
+// filterArray filters an array by applying the same filter to each element,
+// which is how dotted paths descend into arrays of objects
 func filterArray(data []interface{}, fields map[string]interface{}) []interface{} {
 	result := make([]interface{}, len(data))
 
@@ -224,3 +282,36 @@ func filterArray(data []interface{}, fields map[string]interface{}) []interface{
 
 	return result
 }
+
+// fieldsProperty is the input schema for the optional fields argument shared
+// by tools that return JSON
+func fieldsProperty() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "string",
+		"description": `Comma-separated list of fields to include in the response to reduce context usage. ` +
+			`Supports nested fields with dot notation ("severity.name"), "-" to drop fields instead ("-attributes") ` +
+			`and "*" to keep every field at a level. For list responses the fields apply to each item. Omit to return all fields.`,
+	}
+}
+
+// formatJSONResult renders a tool result as indented JSON, trimmed to the
+// fields argument when the caller gave one
+func formatJSONResult(data interface{}, args map[string]interface{}) (string, error) {
+	fieldsStr, _ := args["fields"].(string)
+	return FilterFields(data, fieldsStr)
+}
+
+// withRawJSON appends data as raw JSON to a human-readable tool result. When
+// the caller asked for specific fields, only the filtered JSON is returned so
+// the readable part does not undo the savings.
+func withRawJSON(output string, data interface{}, args map[string]interface{}) (string, error) {
+	if fieldsStr, _ := args["fields"].(string); fieldsStr != "" {
+		return FilterFields(data, fieldsStr)
+	}
+
+	jsonOutput, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return output, nil
+	}
+	return output + "\nRaw JSON:\n" + string(jsonOutput), nil
+}
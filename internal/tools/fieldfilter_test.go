@@ -211,8 +211,10 @@ func TestFilterFields_EmptyFields(t *testing.T) {
 	}
 }
 
-func TestFilterFields_ComplexNesting(t *testing.T) {
-	data := map[string]interface{}{
+// complexIncidentFixture returns an incident with nested objects and an
+// array of role assignments
+func complexIncidentFixture() map[string]interface{} {
+	return map[string]interface{}{
 		"id":   "inc_123",
 		"name": "Production Outage",
 		"incident_status": map[string]interface{}{
@@ -237,8 +239,19 @@ func TestFilterFields_ComplexNesting(t *testing.T) {
 					"name": "John Doe",
 				},
 			},
+			map[string]interface{}{
+				"role": map[string]interface{}{
+					"id":   "role_2",
+					"name": "Communications Lead",
+				},
+				"assignee": nil,
+			},
 		},
 	}
+}
+
+func TestFilterFields_ComplexNesting(t *testing.T) {
+	data := complexIncidentFixture()
 
 	result, err := FilterFields(data, "id,name,severity.name,incident_status.category")
 	if err != nil {
@@ -287,6 +300,76 @@ func TestFilterFields_ComplexNesting(t *testing.T) {
 	}
 }
 
+func TestFilterFields_NestedArrayPaths(t *testing.T) {
+	result, err := FilterFields(complexIncidentFixture(), "id,incident_role_assignments.role.name,incident_role_assignments.assignee.name")
+	if err != nil {
+		t.Fatalf("FilterFields failed: %v", err)
+	}
+
+	var parsed struct {
+		ID                      string                   `json:"id"`
+		Name                    string                   `json:"name"`
+		IncidentRoleAssignments []map[string]interface{} `json:"incident_role_assignments"`
+	}
+	if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+
+	if parsed.ID != "inc_123" || parsed.Name != "" {
+		t.Errorf("Expected only id at the top level, got %s", result)
+	}
+	if len(parsed.IncidentRoleAssignments) != 2 {
+		t.Fatalf("Expected 2 role assignments, got %d", len(parsed.IncidentRoleAssignments))
+	}
+
+	expectedRoles := []string{"Incident Lead", "Communications Lead"}
+	for i, assignment := range parsed.IncidentRoleAssignments {
+		role, ok := assignment["role"].(map[string]interface{})
+		if !ok {
+			t.Fatalf("Expected role to be a map in assignment %d, got %v", i, assignment["role"])
+		}
+		if len(role) != 1 || role["name"] != expectedRoles[i] {
+			t.Errorf("Expected assignment %d role to be trimmed to name %q, got %v", i, expectedRoles[i], role)
+		}
+	}
+
+	assignee, ok := parsed.IncidentRoleAssignments[0]["assignee"].(map[string]interface{})
+	if !ok || len(assignee) != 1 || assignee["name"] != "John Doe" {
+		t.Errorf("Expected first assignee to be trimmed to its name, got %v", parsed.IncidentRoleAssignments[0]["assignee"])
+	}
+	if value, exists := parsed.IncidentRoleAssignments[1]["assignee"]; !exists || value != nil {
+		t.Errorf("Expected missing assignee to stay null, got %v", value)
+	}
+}
+
+func TestFilterFields_NestedArrayExclusion(t *testing.T) {
+	result, err := FilterFields(complexIncidentFixture(), "-incident_role_assignments.role.id,-incident_role_assignments.assignee")
+	if err != nil {
+		t.Fatalf("FilterFields failed: %v", err)
+	}
+
+	var parsed map[string]interface{}
+	if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+
+	if parsed["name"] != "Production Outage" {
+		t.Errorf("Expected unlisted fields to be kept, got %v", parsed)
+	}
+
+	assignments := parsed["incident_role_assignments"].([]interface{})
+	for i, item := range assignments {
+		assignment := item.(map[string]interface{})
+		if _, exists := assignment["assignee"]; exists {
+			t.Errorf("Expected assignee to be excluded from assignment %d, got %v", i, assignment)
+		}
+		role := assignment["role"].(map[string]interface{})
+		if _, exists := role["id"]; exists || role["name"] == nil {
+			t.Errorf("Expected assignment %d role to keep only its name, got %v", i, role)
+		}
+	}
+}
+
 func TestFilterFields_JSONFormatting(t *testing.T) {
 	data := map[string]interface{}{
 		"id":   "123",
@@ -490,3 +573,169 @@ func TestFilterFields_AlertsCollection_BugFix(t *testing.T) {
 		t.Error("Expected pagination_meta to be preserved")
 	}
 }
+
+func TestFilterFields_Exclusion(t *testing.T) {
+	data := map[string]interface{}{
+		"id":   "123",
+		"name": "Test",
+		"severity": map[string]interface{}{
+			"name":        "Critical",
+			"description": "Customer-facing outage",
+		},
+		"incident_role_assignments": []interface{}{
+			map[string]interface{}{"role": "lead"},
+		},
+		"custom_field_entries": []interface{}{},
+	}
+
+	result, err := FilterFields(data, "-incident_role_assignments, -custom_field_entries,-severity.description")
+	if err != nil {
+		t.Fatalf("FilterFields failed: %v", err)
+	}
+
+	var parsed map[string]interface{}
+	if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+
+	if len(parsed) != 3 {
+		t.Errorf("Expected 3 fields, got %d: %v", len(parsed), parsed)
+	}
+	if parsed["id"] != "123" || parsed["name"] != "Test" {
+		t.Errorf("Expected unlisted fields to be kept, got %v", parsed)
+	}
+	if _, exists := parsed["incident_role_assignments"]; exists {
+		t.Error("Expected incident_role_assignments to be excluded")
+	}
+	if _, exists := parsed["custom_field_entries"]; exists {
+		t.Error("Expected custom_field_entries to be excluded")
+	}
+
+	severity, ok := parsed["severity"].(map[string]interface{})
+	if !ok {
+		t.Fatal("Expected severity to be kept")
+	}
+	if severity["name"] != "Critical" {
+		t.Errorf("Expected severity.name='Critical', got %v", severity["name"])
+	}
+	if _, exists := severity["description"]; exists {
+		t.Error("Expected severity.description to be excluded")
+	}
+}
+
+func TestFilterFields_IncidentsCollection_Exclusion(t *testing.T) {
+	data := map[string]interface{}{
+		"incidents": []interface{}{
+			map[string]interface{}{
+				"id":                        "inc_1",
+				"name":                      "First",
+				"incident_role_assignments": []interface{}{map[string]interface{}{"role": "lead"}},
+			},
+			map[string]interface{}{
+				"id":                        "inc_2",
+				"name":                      "Second",
+				"incident_role_assignments": []interface{}{},
+			},
+		},
+		"pagination_meta": map[string]interface{}{
+			"page_size": 25,
+		},
+	}
+
+	result, err := FilterFields(data, "-incident_role_assignments")
+	if err != nil {
+		t.Fatalf("FilterFields failed: %v", err)
+	}
+
+	var parsed map[string]interface{}
+	if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+
+	incidents, ok := parsed["incidents"].([]interface{})
+	if !ok || len(incidents) != 2 {
+		t.Fatalf("Expected 2 incidents, got %v", parsed["incidents"])
+	}
+	for _, item := range incidents {
+		incident := item.(map[string]interface{})
+		if _, exists := incident["incident_role_assignments"]; exists {
+			t.Errorf("Expected incident_role_assignments to be excluded, got %v", incident)
+		}
+		if incident["id"] == nil || incident["name"] == nil {
+			t.Errorf("Expected id and name to be kept, got %v", incident)
+		}
+	}
+
+	if _, hasPagination := parsed["pagination_meta"]; !hasPagination {
+		t.Error("Expected pagination_meta to be preserved")
+	}
+}
+
+func TestFilterFields_Wildcard(t *testing.T) {
+	data := map[string]interface{}{
+		"id":   "123",
+		"name": "Test",
+		"severity": map[string]interface{}{
+			"id":   "sev_1",
+			"name": "Critical",
+		},
+	}
+
+	result, err := FilterFields(data, "*,severity.name")
+	if err != nil {
+		t.Fatalf("FilterFields failed: %v", err)
+	}
+
+	var parsed map[string]interface{}
+	if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+
+	if parsed["id"] != "123" || parsed["name"] != "Test" {
+		t.Errorf("Expected '*' to keep all top-level fields, got %v", parsed)
+	}
+	severity := parsed["severity"].(map[string]interface{})
+	if len(severity) != 1 || severity["name"] != "Critical" {
+		t.Errorf("Expected severity to be trimmed to its name, got %v", severity)
+	}
+
+	result, err = FilterFields(data, "id,severity.*")
+	if err != nil {
+		t.Fatalf("FilterFields failed: %v", err)
+	}
+	parsed = nil
+	if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if _, exists := parsed["name"]; exists {
+		t.Error("Expected name to be filtered out")
+	}
+	if severity := parsed["severity"].(map[string]interface{}); len(severity) != 2 {
+		t.Errorf("Expected 'severity.*' to keep all severity fields, got %v", severity)
+	}
+}
+
+func TestFilterFields_InvalidExclusion(t *testing.T) {
+	data := map[string]interface{}{"id": "123", "name": "Test"}
+
+	tests := []struct {
+		fields  string
+		wantErr string
+	}{
+		{fields: "id,-name", wantErr: "cannot mix included and excluded fields"},
+		{fields: "-*", wantErr: "'*' cannot be excluded"},
+		{fields: "-", wantErr: "a field name must follow '-'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fields, func(t *testing.T) {
+			_, err := FilterFields(data, tt.fields)
+			if err == nil {
+				t.Fatalf("Expected error for fields %q", tt.fields)
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,373 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+// ListFollowUpsTool lists follow-ups from incident.io
+type ListFollowUpsTool struct {
+	client *incidentio.Client
+}
+
+func NewListFollowUpsTool(client *incidentio.Client) *ListFollowUpsTool {
+	return &ListFollowUpsTool{client: client}
+}
+
+func (t *ListFollowUpsTool) Name() string {
+	return "list_follow_ups"
+}
+
+func (t *ListFollowUpsTool) Description() string {
+	return `List follow-ups (post-incident work items) from incident.io with optional filtering.
+
+USAGE WORKFLOW:
+1. Call without filters to see follow-ups across all incidents
+2. Filter by incident_id to see follow-ups raised for a specific incident
+3. Filter by status to see only outstanding or completed follow-ups
+4. Use the pagination_meta.after cursor to fetch the next page
+
+PARAMETERS:
+- incident_id: Filter follow-ups by specific incident ID
+- status: Array of status values (outstanding, completed, not_doing, deleted) - Multiple values match any (OR logic)
+- page_size: Number of results (default 25, max 250)
+- after: Pagination cursor from a previous response
+
+EXAMPLES:
+- List outstanding follow-ups: {"status": ["outstanding"]}
+- List follow-ups for incident: {"incident_id": "01HXYZ..."}
+- Next page: {"incident_id": "01HXYZ...", "after": "01HABC..."}`
+}
+
+func (t *ListFollowUpsTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"incident_id": map[string]interface{}{
+				"type":        "string",
+				"description": "Filter follow-ups by incident ID",
+			},
+			"status": map[string]interface{}{
+				"type":        "array",
+				"items":       map[string]interface{}{"type": "string"},
+				"description": "Filter by follow-up status (outstanding, completed, not_doing, deleted)",
+			},
+			"page_size": map[string]interface{}{
+				"type":        "integer",
+				"description": "Number of results per page (max 250)",
+				"default":     25,
+			},
+			"after": map[string]interface{}{
+				"type":        "string",
+				"description": "Pagination cursor from a previous response",
+			},
+			"fields": fieldsProperty(),
+		},
+		"additionalProperties": false,
+	}
+}
+
+func (t *ListFollowUpsTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	opts := &incidentio.ListFollowUpsOptions{PageSize: 25}
+
+	if incidentID, ok := args["incident_id"].(string); ok {
+		opts.IncidentID = incidentID
+	}
+	if statuses, ok := args["status"].([]interface{}); ok {
+		for _, s := range statuses {
+			if str, ok := s.(string); ok {
+				opts.Status = append(opts.Status, str)
+			}
+		}
+	}
+	if pageSize, ok := args["page_size"].(float64); ok {
+		opts.PageSize = int(pageSize)
+	}
+	if after, ok := args["after"].(string); ok {
+		opts.After = after
+	}
+
+	resp, err := t.client.ListFollowUps(ctx, opts)
+	if err != nil {
+		return "", err
+	}
+
+	return formatJSONResult(resp, args)
+}
+
+// CreateFollowUpTool creates a new follow-up
+type CreateFollowUpTool struct {
+	client *incidentio.Client
+}
+
+func NewCreateFollowUpTool(client *incidentio.Client) *CreateFollowUpTool {
+	return &CreateFollowUpTool{client: client}
+}
+
+func (t *CreateFollowUpTool) Name() string {
+	return "create_follow_up"
+}
+
+func (t *CreateFollowUpTool) Description() string {
+	return `Create a follow-up to track post-incident work such as fixes, clean-up or process changes.
+
+USAGE WORKFLOW:
+1. Get incident ID from list_incidents or get_incident
+2. Optionally get the assignee's user ID from list_users
+3. Create the follow-up with a short, actionable title
+
+PARAMETERS:
+- title: Required. Short description of the work to be done
+- description: Optional. Longer explanation or context
+- incident_id: Optional. The incident the follow-up was raised from
+- assignee_id: Optional. User ID of the person responsible
+- priority_id: Optional. ID of the follow-up priority
+
+EXAMPLES:
+- Minimal: {"title": "Add alerting on queue depth", "incident_id": "01HXYZ..."}
+- Assigned: {"title": "Rotate leaked credentials", "incident_id": "01HXYZ...", "assignee_id": "01HUSER...", "priority_id": "01HPRIO..."}`
+}
+
+func (t *CreateFollowUpTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"title": map[string]interface{}{
+				"type":        "string",
+				"description": "Short description of the follow-up",
+			},
+			"description": map[string]interface{}{
+				"type":        "string",
+				"description": "Longer explanation of the follow-up",
+			},
+			"incident_id": map[string]interface{}{
+				"type":        "string",
+				"description": "The incident the follow-up belongs to",
+			},
+			"assignee_id": map[string]interface{}{
+				"type":        "string",
+				"description": "User ID of the assignee (get from list_users)",
+			},
+			"priority_id": map[string]interface{}{
+				"type":        "string",
+				"description": "ID of the follow-up priority",
+			},
+		},
+		"required":             []interface{}{"title"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *CreateFollowUpTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	title, ok := args["title"].(string)
+	if !ok || title == "" {
+		return "", fmt.Errorf("title parameter is required")
+	}
+
+	req := &incidentio.CreateFollowUpRequest{Title: title}
+	if description, ok := args["description"].(string); ok {
+		req.Description = description
+	}
+	if incidentID, ok := args["incident_id"].(string); ok {
+		req.IncidentID = incidentID
+	}
+	if assigneeID, ok := args["assignee_id"].(string); ok {
+		req.AssigneeID = assigneeID
+	}
+	if priorityID, ok := args["priority_id"].(string); ok {
+		req.PriorityID = priorityID
+	}
+
+	followUp, err := t.client.CreateFollowUp(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := json.MarshalIndent(followUp, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to format response: %w", err)
+	}
+
+	return string(result), nil
+}
+
+// UpdateFollowUpTool updates an existing follow-up
+type UpdateFollowUpTool struct {
+	client *incidentio.Client
+}
+
+func NewUpdateFollowUpTool(client *incidentio.Client) *UpdateFollowUpTool {
+	return &UpdateFollowUpTool{client: client}
+}
+
+func (t *UpdateFollowUpTool) Name() string {
+	return "update_follow_up"
+}
+
+func (t *UpdateFollowUpTool) Description() string {
+	return `Update an existing follow-up's title, description, status, assignee or priority.
+
+USAGE WORKFLOW:
+1. Get follow-up ID from list_follow_ups
+2. Call this tool with the follow-up ID and the fields to change
+3. At least one field must be updated
+
+PARAMETERS:
+- follow_up_id: Required. The follow-up ID to update
+- title: Optional. New title
+- description: Optional. New description
+- status: Optional. New status (outstanding, completed, not_doing, deleted)
+- assignee_id: Optional. User ID of the new assignee (from list_users)
+- priority_id: Optional. ID of the new priority
+
+EXAMPLES:
+- Reassign: {"follow_up_id": "01HFU...", "assignee_id": "01HUSER..."}
+- Won't do: {"follow_up_id": "01HFU...", "status": "not_doing"}
+
+IMPORTANT: At least one field to update must be provided. Use complete_follow_up to mark a follow-up as done.`
+}
+
+func (t *UpdateFollowUpTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"follow_up_id": map[string]interface{}{
+				"type":        "string",
+				"description": "The follow-up ID to update",
+			},
+			"title": map[string]interface{}{
+				"type":        "string",
+				"description": "Update the follow-up title",
+			},
+			"description": map[string]interface{}{
+				"type":        "string",
+				"description": "Update the follow-up description",
+			},
+			"status": map[string]interface{}{
+				"type":        "string",
+				"description": "Update the follow-up status",
+				"enum":        []string{"outstanding", "completed", "not_doing", "deleted"},
+			},
+			"assignee_id": map[string]interface{}{
+				"type":        "string",
+				"description": "User ID of the new assignee (get from list_users)",
+			},
+			"priority_id": map[string]interface{}{
+				"type":        "string",
+				"description": "ID of the new follow-up priority",
+			},
+		},
+		"required":             []interface{}{"follow_up_id"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *UpdateFollowUpTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	id, ok := args["follow_up_id"].(string)
+	if !ok || id == "" {
+		return "", fmt.Errorf("follow_up_id parameter is required")
+	}
+
+	req := &incidentio.UpdateFollowUpRequest{}
+	hasUpdate := false
+
+	if title, ok := args["title"].(string); ok && title != "" {
+		req.Title = title
+		hasUpdate = true
+	}
+	if description, ok := args["description"].(string); ok && description != "" {
+		req.Description = description
+		hasUpdate = true
+	}
+	if status, ok := args["status"].(string); ok && status != "" {
+		req.Status = status
+		hasUpdate = true
+	}
+	if assigneeID, ok := args["assignee_id"].(string); ok && assigneeID != "" {
+		req.AssigneeID = assigneeID
+		hasUpdate = true
+	}
+	if priorityID, ok := args["priority_id"].(string); ok && priorityID != "" {
+		req.PriorityID = priorityID
+		hasUpdate = true
+	}
+
+	if !hasUpdate {
+		return "", fmt.Errorf("at least one field to update must be provided")
+	}
+
+	followUp, err := t.client.UpdateFollowUp(ctx, id, req)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := json.MarshalIndent(followUp, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to format response: %w", err)
+	}
+
+	return string(result), nil
+}
+
+// CompleteFollowUpTool marks a follow-up as completed
+type CompleteFollowUpTool struct {
+	client *incidentio.Client
+}
+
+func NewCompleteFollowUpTool(client *incidentio.Client) *CompleteFollowUpTool {
+	return &CompleteFollowUpTool{client: client}
+}
+
+func (t *CompleteFollowUpTool) Name() string {
+	return "complete_follow_up"
+}
+
+func (t *CompleteFollowUpTool) Description() string {
+	return `Mark a follow-up as completed and record when it was completed.
+
+USAGE WORKFLOW:
+1. Get follow-up ID from list_follow_ups
+2. Call this tool once the work is done
+
+PARAMETERS:
+- follow_up_id: Required. The follow-up ID to complete
+
+EXAMPLES:
+- Complete: {"follow_up_id": "01HFU..."}`
+}
+
+func (t *CompleteFollowUpTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"follow_up_id": map[string]interface{}{
+				"type":        "string",
+				"description": "The follow-up ID to complete",
+			},
+		},
+		"required":             []interface{}{"follow_up_id"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *CompleteFollowUpTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	id, ok := args["follow_up_id"].(string)
+	if !ok || id == "" {
+		return "", fmt.Errorf("follow_up_id parameter is required")
+	}
+
+	followUp, err := t.client.CompleteFollowUp(ctx, id)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := json.MarshalIndent(followUp, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to format response: %w", err)
+	}
+
+	return string(result), nil
+}
@@ -0,0 +1,245 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+func TestCreateFollowUpTool_Execute(t *testing.T) {
+	tests := []struct {
+		name           string
+		args           map[string]interface{}
+		expectedBody   map[string]interface{}
+		expectAssignee bool
+	}{
+		{
+			name: "without assignee",
+			args: map[string]interface{}{
+				"title":       "Add alerting on queue depth",
+				"incident_id": "inc_1",
+			},
+			expectedBody: map[string]interface{}{
+				"title":       "Add alerting on queue depth",
+				"incident_id": "inc_1",
+			},
+		},
+		{
+			name: "with assignee and priority",
+			args: map[string]interface{}{
+				"title":       "Rotate leaked credentials",
+				"description": "Keys were pasted into the incident channel",
+				"incident_id": "inc_1",
+				"assignee_id": "user_1",
+				"priority_id": "prio_high",
+			},
+			expectedBody: map[string]interface{}{
+				"title":       "Rotate leaked credentials",
+				"description": "Keys were pasted into the incident channel",
+				"incident_id": "inc_1",
+				"assignee_id": "user_1",
+				"priority_id": "prio_high",
+			},
+			expectAssignee: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body map[string]interface{}
+			client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/follow_ups" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Errorf("failed to decode request body: %v", err)
+				}
+
+				assignee := "null"
+				if id, ok := body["assignee_id"].(string); ok {
+					assignee = fmt.Sprintf(`{"id": %q, "name": "Jane Doe"}`, id)
+				}
+				fmt.Fprintf(w, `{"follow_up": {"id": "fu_1", "incident_id": "inc_1", "title": %q, "status": "outstanding", "assignee": %s}}`, body["title"], assignee)
+			})
+
+			result, err := NewCreateFollowUpTool(client).Execute(context.Background(), tt.args)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(body) != len(tt.expectedBody) {
+				t.Errorf("expected request body %v, got %v", tt.expectedBody, body)
+			}
+			for key, value := range tt.expectedBody {
+				if body[key] != value {
+					t.Errorf("expected %s=%v in request body, got %v", key, value, body[key])
+				}
+			}
+
+			var followUp incidentio.FollowUp
+			if err := json.Unmarshal([]byte(result), &followUp); err != nil {
+				t.Fatalf("failed to parse result: %v", err)
+			}
+			if followUp.ID != "fu_1" {
+				t.Errorf("expected follow-up fu_1, got %s", followUp.ID)
+			}
+			if tt.expectAssignee && (followUp.Assignee == nil || followUp.Assignee.ID != "user_1") {
+				t.Errorf("expected assignee user_1, got %+v", followUp.Assignee)
+			}
+			if !tt.expectAssignee && followUp.Assignee != nil {
+				t.Errorf("expected no assignee, got %+v", followUp.Assignee)
+			}
+		})
+	}
+}
+
+func TestCreateFollowUpTool_MissingTitle(t *testing.T) {
+	tool := &CreateFollowUpTool{}
+
+	if _, err := tool.Execute(context.Background(), map[string]interface{}{"incident_id": "inc_1"}); err == nil {
+		t.Fatal("expected error for missing title")
+	}
+}
+
+func TestListFollowUpsTool_FilterByIncident(t *testing.T) {
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/follow_ups" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		query := r.URL.Query()
+		if query.Get("incident_id") != "inc_1" {
+			t.Errorf("expected incident_id=inc_1, got %q", query.Get("incident_id"))
+		}
+		if statuses := query["status"]; len(statuses) != 1 || statuses[0] != "outstanding" {
+			t.Errorf("expected status=outstanding, got %v", statuses)
+		}
+		if query.Get("page_size") != "2" {
+			t.Errorf("expected page_size=2, got %q", query.Get("page_size"))
+		}
+
+		fmt.Fprint(w, `{
+			"follow_ups": [
+				{"id": "fu_1", "incident_id": "inc_1", "title": "Add alerting", "status": "outstanding"},
+				{"id": "fu_2", "incident_id": "inc_1", "title": "Write runbook", "status": "outstanding"}
+			],
+			"pagination_meta": {"after": "fu_2", "page_size": 2}
+		}`)
+	})
+
+	result, err := NewListFollowUpsTool(client).Execute(context.Background(), map[string]interface{}{
+		"incident_id": "inc_1",
+		"status":      []interface{}{"outstanding"},
+		"page_size":   float64(2),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var response incidentio.ListFollowUpsResponse
+	if err := json.Unmarshal([]byte(result), &response); err != nil {
+		t.Fatalf("failed to parse result: %v", err)
+	}
+	if len(response.FollowUps) != 2 {
+		t.Fatalf("expected 2 follow-ups, got %d", len(response.FollowUps))
+	}
+	if response.PaginationMeta.After != "fu_2" {
+		t.Errorf("expected pagination cursor fu_2, got %q", response.PaginationMeta.After)
+	}
+}
+
+func TestListFollowUpsTool_DefaultPageSize(t *testing.T) {
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("page_size"); got != "25" {
+			t.Errorf("expected the documented default page_size=25, got %q", got)
+		}
+		fmt.Fprint(w, `{"follow_ups": [], "pagination_meta": {"page_size": 25}}`)
+	})
+
+	if _, err := NewListFollowUpsTool(client).Execute(context.Background(), map[string]interface{}{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestCompleteFollowUpTool_Execute(t *testing.T) {
+	var body map[string]interface{}
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/follow_ups/fu_1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode request body: %v", err)
+		}
+
+		fmt.Fprintf(w, `{"follow_up": {"id": "fu_1", "title": "Add alerting", "status": %q, "completed_at": %q}}`, body["status"], body["completed_at"])
+	})
+
+	result, err := NewCompleteFollowUpTool(client).Execute(context.Background(), map[string]interface{}{"follow_up_id": "fu_1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if body["status"] != "completed" {
+		t.Errorf("expected status=completed in request body, got %v", body["status"])
+	}
+	if completedAt, ok := body["completed_at"].(string); !ok || completedAt == "" {
+		t.Errorf("expected completed_at in request body, got %v", body["completed_at"])
+	}
+
+	var followUp incidentio.FollowUp
+	if err := json.Unmarshal([]byte(result), &followUp); err != nil {
+		t.Fatalf("failed to parse result: %v", err)
+	}
+	if followUp.Status != "completed" || followUp.CompletedAt == nil {
+		t.Errorf("expected completed follow-up with completed_at, got %+v", followUp)
+	}
+}
+
+func TestUpdateFollowUpTool_Execute(t *testing.T) {
+	t.Run("rejects update with no fields", func(t *testing.T) {
+		requests := 0
+		client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+			requests++
+		})
+
+		_, err := NewUpdateFollowUpTool(client).Execute(context.Background(), map[string]interface{}{"follow_up_id": "fu_1"})
+		if err == nil || !strings.Contains(err.Error(), "at least one field") {
+			t.Fatalf("expected at least one field error, got %v", err)
+		}
+		if requests != 0 {
+			t.Errorf("expected no API requests, got %d", requests)
+		}
+	})
+
+	t.Run("sends only provided fields", func(t *testing.T) {
+		var body map[string]interface{}
+		client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode request body: %v", err)
+			}
+			fmt.Fprint(w, `{"follow_up": {"id": "fu_1", "title": "Add alerting", "status": "not_doing"}}`)
+		})
+
+		_, err := NewUpdateFollowUpTool(client).Execute(context.Background(), map[string]interface{}{
+			"follow_up_id": "fu_1",
+			"status":       "not_doing",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(body) != 1 || body["status"] != "not_doing" {
+			t.Errorf("expected body with only status=not_doing, got %v", body)
+		}
+	})
+
+	t.Run("missing follow_up_id", func(t *testing.T) {
+		if _, err := (&UpdateFollowUpTool{}).Execute(context.Background(), map[string]interface{}{"title": "x"}); err == nil {
+			t.Fatal("expected error for missing follow_up_id")
+		}
+	})
+}
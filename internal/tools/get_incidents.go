@@ -0,0 +1,185 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+const (
+	// getIncidentsConcurrency bounds the number of incident lookups in flight at once
+	getIncidentsConcurrency = 5
+	// maxGetIncidents caps the number of identifiers accepted in one call
+	maxGetIncidents = 50
+)
+
+// GetIncidentsTool fetches several incidents in one call
+type GetIncidentsTool struct {
+	client   *incidentio.Client
+	resolver *GetIncidentTool
+}
+
+func NewGetIncidentsTool(client *incidentio.Client) *GetIncidentsTool {
+	return &GetIncidentsTool{
+		client:   client,
+		resolver: NewGetIncidentTool(client),
+	}
+}
+
+func (t *GetIncidentsTool) Name() string {
+	return "get_incidents"
+}
+
+func (t *GetIncidentsTool) Description() string {
+	return `Get full details for several incidents in one call.
+
+Each identifier accepts the same formats as get_incident (incident ID, reference such as "INC-123" or "123", Slack channel ID or Slack channel name). Incidents are fetched concurrently, at most 5 at a time.
+
+USAGE WORKFLOW:
+1. Collect the identifiers of the incidents you need, e.g. from list_incidents or search_incidents
+2. Call this tool once instead of calling get_incident for each incident
+3. Check "errors" for any identifiers that could not be fetched
+
+PARAMETERS:
+- incident_ids: Required. Array of incident identifiers (max 50)
+- fields: Optional. Comma-separated fields to include for each incident, using the same syntax as get_incident
+
+EXAMPLES:
+- Get three incidents: {"incident_ids": ["INC-101", "INC-102", "01HXYZ..."]}
+- Get selected fields: {"incident_ids": ["INC-101", "INC-102"], "fields": "id,name,severity.name,incident_status.category"}
+
+Returns {"incidents": {identifier: incident}, "errors": {identifier: message}}. A failure for one identifier does not fail the others.`
+}
+
+func (t *GetIncidentsTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"incident_ids": map[string]interface{}{
+				"type":        "array",
+				"items":       map[string]interface{}{"type": "string"},
+				"description": "Incident identifiers: IDs, references (INC-123 or 123), Slack channel IDs or Slack channel names",
+				"minItems":    1,
+				"maxItems":    maxGetIncidents,
+			},
+			"fields": map[string]interface{}{
+				"type":        "string",
+				"description": "Comma-separated list of fields to include for each incident (e.g. \"id,name,severity.name\"). Omit to return all fields.",
+			},
+		},
+		"required":             []interface{}{"incident_ids"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *GetIncidentsTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	rawIDs, ok := args["incident_ids"].([]interface{})
+	if !ok || len(rawIDs) == 0 {
+		return "", fmt.Errorf("incident_ids parameter is required and must be a non-empty array")
+	}
+
+	var identifiers []string
+	seen := make(map[string]bool)
+	for _, raw := range rawIDs {
+		identifier, ok := raw.(string)
+		if !ok || identifier == "" {
+			return "", fmt.Errorf("incident_ids must only contain non-empty strings, got %v", raw)
+		}
+		if !seen[identifier] {
+			seen[identifier] = true
+			identifiers = append(identifiers, identifier)
+		}
+	}
+	if len(identifiers) > maxGetIncidents {
+		return "", fmt.Errorf("at most %d incident_ids can be requested at once, got %d", maxGetIncidents, len(identifiers))
+	}
+
+	var fields map[string]interface{}
+	if fieldsStr, _ := args["fields"].(string); fieldsStr != "" {
+		var err error
+		if fields, err = parseFieldList(fieldsStr); err != nil {
+			return "", err
+		}
+	}
+
+	incidents, errs := t.fetchAll(ctx, identifiers)
+
+	found := make(map[string]interface{}, len(incidents))
+	for identifier, incident := range incidents {
+		record, err := projectFields(incident, fields)
+		if err != nil {
+			return "", err
+		}
+		found[identifier] = record
+	}
+	failed := make(map[string]string, len(errs))
+	for identifier, err := range errs {
+		failed[identifier] = err.Error()
+	}
+
+	response := map[string]interface{}{
+		"incidents": found,
+		"errors":    failed,
+	}
+
+	result, err := json.MarshalIndent(response, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to format response: %w", err)
+	}
+
+	return string(result), nil
+}
+
+// fetchAll resolves and fetches each identifier with a bounded worker pool,
+// returning the incidents that were found and the errors for the rest
+func (t *GetIncidentsTool) fetchAll(ctx context.Context, identifiers []string) (map[string]*incidentio.Incident, map[string]error) {
+	var (
+		mu        sync.Mutex
+		wg        sync.WaitGroup
+		incidents = make(map[string]*incidentio.Incident)
+		errs      = make(map[string]error)
+	)
+	sem := make(chan struct{}, getIncidentsConcurrency)
+
+	for _, identifier := range identifiers {
+		wg.Add(1)
+		go func(identifier string) {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			incident, err := t.fetch(ctx, identifier)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs[identifier] = err
+				return
+			}
+			incidents[identifier] = incident
+		}(identifier)
+	}
+	wg.Wait()
+
+	return incidents, errs
+}
+
+func (t *GetIncidentsTool) fetch(ctx context.Context, identifier string) (*incidentio.Incident, error) {
+	incidentID, err := t.resolver.ResolveIncidentIdentifier(ctx, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	incident, err := t.client.GetIncident(ctx, incidentID)
+	if err != nil {
+		if incidentio.IsNotFound(err) {
+			return nil, fmt.Errorf("incident not found: %s", identifier)
+		}
+		return nil, err
+	}
+	return incident, nil
+}
@@ -0,0 +1,98 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestGetIncidentsTool_PartialResults(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		requests []string
+	)
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.URL.Path)
+		mu.Unlock()
+
+		switch r.URL.Path {
+		case "/incidents/01HXYZ1234567890ABCDEFGH":
+			fmt.Fprint(w, `{"incident": {"id": "01HXYZ1234567890ABCDEFGH", "reference": "INC-1", "name": "Database outage", "severity": {"name": "Critical"}}}`)
+		case "/incidents/2":
+			fmt.Fprint(w, `{"incident": {"id": "01HXYZ2234567890ABCDEFGH", "reference": "INC-2", "name": "Checkout errors", "severity": {"name": "Minor"}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"type": "not_found", "status": 404, "errors": [{"code": "not_found", "message": "Incident not found"}]}`)
+		}
+	})
+
+	result, err := NewGetIncidentsTool(client).Execute(context.Background(), map[string]interface{}{
+		"incident_ids": []interface{}{"01HXYZ1234567890ABCDEFGH", "INC-2", "INC-404", "INC-2"},
+		"fields":       "id,name",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var response struct {
+		Incidents map[string]map[string]interface{} `json:"incidents"`
+		Errors    map[string]string                 `json:"errors"`
+	}
+	if err := json.Unmarshal([]byte(result), &response); err != nil {
+		t.Fatalf("failed to parse result: %v\n%s", err, result)
+	}
+
+	if len(response.Incidents) != 2 {
+		t.Fatalf("expected 2 incidents, got %v", response.Incidents)
+	}
+	if response.Incidents["01HXYZ1234567890ABCDEFGH"]["name"] != "Database outage" {
+		t.Errorf("expected incident by ID, got %v", response.Incidents["01HXYZ1234567890ABCDEFGH"])
+	}
+	if response.Incidents["INC-2"]["id"] != "01HXYZ2234567890ABCDEFGH" {
+		t.Errorf("expected incident by reference, got %v", response.Incidents["INC-2"])
+	}
+	if _, ok := response.Incidents["INC-2"]["severity"]; ok {
+		t.Errorf("expected fields to be projected, got %v", response.Incidents["INC-2"])
+	}
+
+	if len(response.Errors) != 1 || !strings.Contains(response.Errors["INC-404"], "incident not found: INC-404") {
+		t.Errorf("expected a not found error for INC-404, got %v", response.Errors)
+	}
+
+	// Duplicate identifiers are only fetched once
+	if len(requests) != 3 {
+		t.Errorf("expected 3 requests, got %v", requests)
+	}
+}
+
+func TestGetIncidentsTool_Validation(t *testing.T) {
+	tool := &GetIncidentsTool{}
+
+	tooMany := make([]interface{}, maxGetIncidents+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("INC-%d", i+1)
+	}
+
+	tests := []struct {
+		name string
+		args map[string]interface{}
+	}{
+		{name: "missing incident_ids", args: map[string]interface{}{}},
+		{name: "empty incident_ids", args: map[string]interface{}{"incident_ids": []interface{}{}}},
+		{name: "non-string identifier", args: map[string]interface{}{"incident_ids": []interface{}{float64(1)}}},
+		{name: "too many identifiers", args: map[string]interface{}{"incident_ids": tooMany}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tool.Execute(context.Background(), tt.args); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package tools
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -103,7 +104,7 @@ func (t *GetIncidentDebriefTool) InputSchema() map[string]interface{} {
 	}
 }
 
-func (t *GetIncidentDebriefTool) Execute(args map[string]interface{}) (string, error) {
+func (t *GetIncidentDebriefTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
 	identifier, ok := args["incident_id"].(string)
 	if !ok || identifier == "" {
 		argDetails := make(map[string]interface{})
@@ -115,13 +116,13 @@ func (t *GetIncidentDebriefTool) Execute(args map[string]interface{}) (string, e
 
 	// Create a temporary GetIncidentTool to reuse the identifier resolution logic
 	getIncidentTool := NewGetIncidentTool(t.client)
-	incidentID, err := getIncidentTool.ResolveIncidentIdentifier(identifier)
+	incidentID, err := getIncidentTool.ResolveIncidentIdentifier(ctx, identifier)
 	if err != nil {
 		return "", err
 	}
 
 	// Get the incident debrief using the client method
-	incident, err := t.client.GetIncidentDebrief(incidentID)
+	incident, err := t.client.GetIncidentDebrief(ctx, incidentID)
 	if err != nil {
 		return "", err
 	}
@@ -1,6 +1,7 @@
 package tools
 
 import (
+	"context"
 	"encoding/json"
 	"strings"
 	"testing"
@@ -51,7 +52,7 @@ func TestGetIncidentDebriefTool_ExecuteValidation(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, err := tool.Execute(tt.args)
+			_, err := tool.Execute(context.Background(), tt.args)
 
 			if tt.wantError {
 				if err == nil {
@@ -0,0 +1,115 @@
+package tools
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+// durationStartTimestamps and durationEndTimestamps name the incident
+// timestamps a duration is measured between, in order of preference. Names
+// are matched case-insensitively, with or without a trailing " at".
+var (
+	durationStartTimestamps = []string{"detected"}
+	durationEndTimestamps   = []string{"resolved", "closed"}
+)
+
+// finishedStatusCategories are the status categories of incidents whose
+// impact is over, so their duration no longer grows
+var finishedStatusCategories = map[string]bool{
+	"learning": true,
+	"closed":   true,
+	"declined": true,
+	"merged":   true,
+	"canceled": true,
+}
+
+// incidentDuration is how long an incident lasted, or for an open incident
+// how long it has lasted so far
+type incidentDuration struct {
+	Seconds int64  `json:"duration_seconds"`
+	Human   string `json:"duration"`
+	Open    bool   `json:"duration_open"`
+	// From and To name the timestamps the duration was measured between
+	From string `json:"duration_from"`
+	To   string `json:"duration_to"`
+}
+
+// incidentWithDuration is an incident with its computed duration embedded
+type incidentWithDuration struct {
+	incidentio.Incident
+	incidentDuration
+}
+
+// computeIncidentDuration measures an incident from its detected timestamp, or
+// creation when that isn't set, to its resolved or closed timestamp. Incidents
+// that are still open are measured up to now.
+func computeIncidentDuration(incident *incidentio.Incident, now time.Time) incidentDuration {
+	duration := incidentDuration{From: "created_at", To: "now"}
+
+	start := incident.CreatedAt
+	if name, value, ok := findTimestampValue(incident, durationStartTimestamps); ok {
+		start, duration.From = value, name
+	}
+
+	end := now
+	if name, value, ok := findTimestampValue(incident, durationEndTimestamps); ok {
+		end, duration.To = value, name
+	} else if finishedStatusCategories[incident.IncidentStatus.Category] {
+		// Finished without a resolved timestamp: the last update is the best estimate
+		end, duration.To = incident.UpdatedAt, "updated_at"
+	} else {
+		duration.Open = true
+	}
+
+	elapsed := end.Sub(start)
+	if start.IsZero() || elapsed < 0 {
+		elapsed = 0
+	}
+	duration.Seconds = int64(elapsed / time.Second)
+	duration.Human = formatDuration(elapsed)
+	return duration
+}
+
+// findTimestampValue returns the first of names that is set on the incident,
+// along with the timestamp's display name
+func findTimestampValue(incident *incidentio.Incident, names []string) (string, time.Time, bool) {
+	for _, name := range names {
+		for _, value := range incident.IncidentTimestampValues {
+			if value.Value == nil || value.Value.Value == nil {
+				continue
+			}
+			timestampName := strings.ToLower(strings.TrimSpace(value.IncidentTimestamp.Name))
+			if timestampName == name || timestampName == name+" at" {
+				return value.IncidentTimestamp.Name, *value.Value.Value, true
+			}
+		}
+	}
+	return "", time.Time{}, false
+}
+
+// formatDuration renders d as days, hours and minutes, such as "2d 3h 15m",
+// falling back to seconds for durations under a minute
+func formatDuration(d time.Duration) string {
+	if d < time.Minute {
+		return fmt.Sprintf("%ds", int64(d/time.Second))
+	}
+
+	days := d / (24 * time.Hour)
+	hours := (d % (24 * time.Hour)) / time.Hour
+	minutes := (d % time.Hour) / time.Minute
+
+	var parts []string
+	if days > 0 {
+		parts = append(parts, fmt.Sprintf("%dd", days))
+	}
+	if hours > 0 {
+		parts = append(parts, fmt.Sprintf("%dh", hours))
+	}
+	if minutes > 0 {
+		parts = append(parts, fmt.Sprintf("%dm", minutes))
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,109 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestGetIncidentTool_Duration(t *testing.T) {
+	now := time.Date(2024, 12, 2, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		incident string
+		want     incidentDuration
+	}{
+		{
+			name: "resolved incident",
+			incident: `{
+				"id": "01HXYZINCIDENT0000000000000",
+				"incident_status": {"category": "closed"},
+				"created_at": "2024-12-01T09:30:00Z",
+				"updated_at": "2024-12-02T08:00:00Z",
+				"incident_timestamp_values": [
+					{"incident_timestamp": {"id": "ts_1", "name": "Detected"}, "value": {"value": "2024-12-01T09:00:00Z"}},
+					{"incident_timestamp": {"id": "ts_2", "name": "Resolved at"}, "value": {"value": "2024-12-01T11:15:30Z"}},
+					{"incident_timestamp": {"id": "ts_3", "name": "Closed at"}, "value": {"value": "2024-12-01T12:00:00Z"}}
+				]
+			}`,
+			want: incidentDuration{Seconds: 8130, Human: "2h 15m", From: "Detected", To: "Resolved at"},
+		},
+		{
+			name: "open incident",
+			incident: `{
+				"id": "01HXYZINCIDENT0000000000000",
+				"incident_status": {"category": "live"},
+				"created_at": "2024-11-30T10:00:00Z",
+				"updated_at": "2024-12-02T08:00:00Z",
+				"incident_timestamp_values": [
+					{"incident_timestamp": {"id": "ts_2", "name": "Resolved at"}}
+				]
+			}`,
+			want: incidentDuration{Seconds: 180000, Human: "2d 2h", Open: true, From: "created_at", To: "now"},
+		},
+		{
+			name: "closed without a resolved timestamp",
+			incident: `{
+				"id": "01HXYZINCIDENT0000000000000",
+				"incident_status": {"category": "closed"},
+				"created_at": "2024-12-01T09:30:00Z",
+				"updated_at": "2024-12-01T09:30:45Z"
+			}`,
+			want: incidentDuration{Seconds: 45, Human: "45s", From: "created_at", To: "updated_at"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `{"incident": %s}`, tt.incident)
+			})
+			tool := NewGetIncidentTool(client)
+			tool.now = func() time.Time { return now }
+
+			result, err := tool.Execute(context.Background(), map[string]interface{}{
+				"incident_id": "01HXYZINCIDENT0000000000000",
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var got struct {
+				ID string `json:"id"`
+				incidentDuration
+			}
+			if err := json.Unmarshal([]byte(result), &got); err != nil {
+				t.Fatalf("failed to parse result: %v\n%s", err, result)
+			}
+			if got.ID != "01HXYZINCIDENT0000000000000" {
+				t.Errorf("expected the incident fields to be kept, got:\n%s", result)
+			}
+			if got.incidentDuration != tt.want {
+				t.Errorf("expected duration %+v, got %+v", tt.want, got.incidentDuration)
+			}
+		})
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		duration time.Duration
+		want     string
+	}{
+		{0, "0s"},
+		{59 * time.Second, "59s"},
+		{90 * time.Minute, "1h 30m"},
+		{3 * time.Hour, "3h"},
+		{49*time.Hour + 5*time.Minute + 30*time.Second, "2d 1h 5m"},
+	}
+
+	for _, tt := range tests {
+		if got := formatDuration(tt.duration); got != tt.want {
+			t.Errorf("formatDuration(%v) = %q, want %q", tt.duration, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,373 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+// postmortemSections are the sections export_incident_postmortem can render,
+// in document order
+var postmortemSections = []string{"overview", "timeline", "roles", "actions", "follow_ups", "analysis"}
+
+// postmortemAnalysisPrompts are the headings of the analysis section, left
+// for the team to fill in
+var postmortemAnalysisPrompts = []string{"Root cause", "Contributing factors", "What went well", "What could have gone better", "Lessons learned"}
+
+// maxPostmortemUpdates is the number of incident updates included in the
+// timeline, the largest page the API returns
+const maxPostmortemUpdates = 250
+
+// ExportIncidentTool renders an incident as a Markdown postmortem document
+type ExportIncidentTool struct {
+	client   *incidentio.Client
+	resolver *GetIncidentTool
+	// now is the end of an open incident's duration. Tests replace it
+	now func() time.Time
+}
+
+func NewExportIncidentTool(client *incidentio.Client) *ExportIncidentTool {
+	return &ExportIncidentTool{
+		client:   client,
+		resolver: NewGetIncidentTool(client),
+		now:      time.Now,
+	}
+}
+
+func (t *ExportIncidentTool) Name() string {
+	return "export_incident_postmortem"
+}
+
+func (t *ExportIncidentTool) Description() string {
+	return `Render an incident as a Markdown postmortem document, ready to paste into a doc.
+
+The document gathers the incident's details, its timeline of timestamps and updates, role assignments, actions and follow-ups, and ends with analysis headings for the team to fill in.
+
+USAGE WORKFLOW:
+1. Find the incident with list_incidents or search_incidents
+2. Call this tool with its identifier, optionally choosing sections
+3. Return the Markdown as-is, or edit it with the user
+
+PARAMETERS:
+- incident_id: Required. Incident ID, reference ("INC-123" or "123"), Slack channel ID or Slack channel name
+- sections: Optional. Sections to include, in any order (default: all). One or more of:
+  * overview: Reference, status, severity, type, lead, times, duration, links and summary
+  * timeline: Incident timestamps and updates, oldest first
+  * roles: Who held each incident role
+  * actions: Actions taken during the incident
+  * follow_ups: Follow-up work, with assignees, priorities and linked issues
+  * analysis: Empty headings for root cause, contributing factors and lessons learned
+
+EXAMPLES:
+- Full postmortem: {"incident_id": "INC-123"}
+- Just the timeline and follow-ups: {"incident_id": "INC-123", "sections": ["timeline", "follow_ups"]}
+
+IMPORTANT: Each of the timeline, actions and follow_ups sections makes an extra API call. Leave out sections you don't need.`
+}
+
+func (t *ExportIncidentTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"incident_id": map[string]interface{}{
+				"type":        "string",
+				"description": "Incident identifier: full ID, reference (INC-123 or 123), Slack channel ID or Slack channel name",
+			},
+			"sections": map[string]interface{}{
+				"type":        "array",
+				"items":       map[string]interface{}{"type": "string", "enum": postmortemSections},
+				"description": "Sections to include (default: all). They are always rendered in document order.",
+				"minItems":    1,
+			},
+		},
+		"required":             []interface{}{"incident_id"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *ExportIncidentTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	identifier, ok := args["incident_id"].(string)
+	if !ok || identifier == "" {
+		return "", fmt.Errorf("incident_id parameter is required")
+	}
+
+	sections, err := parsePostmortemSections(args["sections"])
+	if err != nil {
+		return "", err
+	}
+
+	incidentID, err := t.resolver.ResolveIncidentIdentifier(ctx, identifier)
+	if err != nil {
+		return "", err
+	}
+	incident, err := t.client.GetIncident(ctx, incidentID)
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now
+	if t.now != nil {
+		now = t.now
+	}
+	doc := &postmortem{incident: incident, duration: computeIncidentDuration(incident, now())}
+
+	// Related items are looked up by the incident's ID, which a reference such as "123" is not
+	if sections["timeline"] {
+		updates, err := t.client.ListIncidentUpdates(ctx, &incidentio.ListIncidentUpdatesOptions{
+			IncidentID: incident.ID,
+			PageSize:   maxPostmortemUpdates,
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to list incident updates: %w", err)
+		}
+		doc.updates = updates.IncidentUpdates
+	}
+	if sections["actions"] {
+		actions, err := t.client.ListActions(ctx, &incidentio.ListActionsOptions{IncidentID: incident.ID})
+		if err != nil {
+			return "", fmt.Errorf("failed to list actions: %w", err)
+		}
+		doc.actions = actions.Actions
+	}
+	if sections["follow_ups"] {
+		followUps, err := t.client.ListFollowUps(ctx, &incidentio.ListFollowUpsOptions{
+			IncidentID: incident.ID,
+			PageSize:   maxEmbeddedFollowUps,
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to list follow-ups: %w", err)
+		}
+		doc.followUps = followUps.FollowUps
+	}
+
+	return doc.render(sections), nil
+}
+
+// parsePostmortemSections returns the requested sections as a set, defaulting
+// to every section
+func parsePostmortemSections(value interface{}) (map[string]bool, error) {
+	sections := make(map[string]bool, len(postmortemSections))
+	raw, _ := value.([]interface{})
+	if len(raw) == 0 {
+		for _, section := range postmortemSections {
+			sections[section] = true
+		}
+		return sections, nil
+	}
+
+	for _, item := range raw {
+		section, _ := item.(string)
+		section = strings.ToLower(strings.TrimSpace(section))
+		if !containsSection(section) {
+			return nil, fmt.Errorf("invalid section '%v'. Available sections: %s", item, strings.Join(postmortemSections, ", "))
+		}
+		sections[section] = true
+	}
+	return sections, nil
+}
+
+func containsSection(section string) bool {
+	for _, s := range postmortemSections {
+		if s == section {
+			return true
+		}
+	}
+	return false
+}
+
+// postmortem holds the data rendered into a postmortem document
+type postmortem struct {
+	incident  *incidentio.Incident
+	duration  incidentDuration
+	updates   []incidentio.IncidentUpdate
+	actions   []incidentio.Action
+	followUps []incidentio.FollowUp
+}
+
+func (p *postmortem) render(sections map[string]bool) string {
+	var b strings.Builder
+
+	title := p.incident.Name
+	if p.incident.Reference != "" {
+		title = p.incident.Reference + ": " + p.incident.Name
+	}
+	fmt.Fprintf(&b, "# Postmortem: %s\n", title)
+
+	renderers := map[string]func(*strings.Builder){
+		"overview":   p.renderOverview,
+		"timeline":   p.renderTimeline,
+		"roles":      p.renderRoles,
+		"actions":    p.renderActions,
+		"follow_ups": p.renderFollowUps,
+		"analysis":   renderAnalysis,
+	}
+	for _, section := range postmortemSections {
+		if sections[section] {
+			b.WriteString("\n")
+			renderers[section](&b)
+		}
+	}
+
+	return b.String()
+}
+
+func (p *postmortem) renderOverview(b *strings.Builder) {
+	incident := p.incident
+
+	status := valueOrDefault(incident.IncidentStatus.Name, "unknown")
+	if category := incident.IncidentStatus.Category; category != "" && !strings.EqualFold(category, status) {
+		status += " (" + category + ")"
+	}
+	duration := p.duration.Human
+	if p.duration.Open {
+		duration += " so far (open)"
+	}
+
+	b.WriteString("## Overview\n\n")
+	b.WriteString("| Field | Value |\n")
+	b.WriteString("| --- | --- |\n")
+	rows := [][2]string{
+		{"Reference", valueOrDefault(incident.Reference, incident.ID)},
+		{"Status", status},
+		{"Severity", valueOrDefault(incident.Severity.Name, "none")},
+		{"Type", valueOrDefault(incident.IncidentType.Name, "none")},
+		{"Lead", incidentLead(incident)},
+		{"Created", formatSummaryTime(incident.CreatedAt)},
+		{"Duration", duration},
+	}
+	if slack := slackChannelLink(incident); slack != "" {
+		rows = append(rows, [2]string{"Slack", slack})
+	}
+	if incident.Permalink != "" {
+		rows = append(rows, [2]string{"Link", incident.Permalink})
+	}
+	for _, row := range rows {
+		fmt.Fprintf(b, "| %s | %s |\n", row[0], markdownTableCell(row[1]))
+	}
+
+	b.WriteString("\n### Summary\n\n")
+	b.WriteString(valueOrDefault(strings.TrimSpace(incident.Summary), "_No summary provided._"))
+	b.WriteString("\n")
+}
+
+// timelineEntry is a timestamp or update shown in the timeline
+type timelineEntry struct {
+	at   time.Time
+	text string
+}
+
+func (p *postmortem) renderTimeline(b *strings.Builder) {
+	var entries []timelineEntry
+	for _, value := range p.incident.IncidentTimestampValues {
+		if value.Value == nil || value.Value.Value == nil {
+			continue
+		}
+		entries = append(entries, timelineEntry{at: *value.Value.Value, text: "**" + value.IncidentTimestamp.Name + "**"})
+	}
+	for _, update := range p.updates {
+		text := "Update"
+		if update.Author != nil && update.Author.Name != "" {
+			text += " from " + update.Author.Name
+		}
+		text += ": " + markdownListText(update.Message)
+		entries = append(entries, timelineEntry{at: update.CreatedAt, text: text})
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].at.Before(entries[j].at) })
+
+	b.WriteString("## Timeline\n\n")
+	if len(entries) == 0 {
+		b.WriteString("_No timestamps or updates recorded._\n")
+		return
+	}
+	for _, entry := range entries {
+		fmt.Fprintf(b, "- %s: %s\n", formatSummaryTime(entry.at), entry.text)
+	}
+}
+
+func (p *postmortem) renderRoles(b *strings.Builder) {
+	b.WriteString("## Roles\n\n")
+	if len(p.incident.IncidentRoleAssignments) == 0 {
+		b.WriteString("_No roles assigned._\n")
+		return
+	}
+	for _, assignment := range p.incident.IncidentRoleAssignments {
+		assignee := "_unassigned_"
+		if assignment.Assignee != nil {
+			assignee = formatPostmortemUser(assignment.Assignee)
+		}
+		fmt.Fprintf(b, "- **%s**: %s\n", assignment.Role.Name, assignee)
+	}
+}
+
+func (p *postmortem) renderActions(b *strings.Builder) {
+	b.WriteString("## Actions\n\n")
+	if len(p.actions) == 0 {
+		b.WriteString("_No actions recorded._\n")
+		return
+	}
+	for _, action := range p.actions {
+		fmt.Fprintf(b, "- %s %s (%s", markdownCheckbox(action.Status == incidentio.ActionStatusCompleted), markdownListText(action.Description), action.Status)
+		if action.Assignee != nil {
+			fmt.Fprintf(b, ", %s", formatPostmortemUser(action.Assignee))
+		}
+		b.WriteString(")\n")
+	}
+}
+
+func (p *postmortem) renderFollowUps(b *strings.Builder) {
+	b.WriteString("## Follow-ups\n\n")
+	if len(p.followUps) == 0 {
+		b.WriteString("_No follow-ups recorded._\n")
+		return
+	}
+	for _, followUp := range p.followUps {
+		details := []string{followUp.Status}
+		if followUp.Assignee != nil {
+			details = append(details, formatPostmortemUser(followUp.Assignee))
+		}
+		if followUp.Priority != nil && followUp.Priority.Name != "" {
+			details = append(details, "priority "+followUp.Priority.Name)
+		}
+		if issue := followUp.ExternalIssueReference; issue != nil && issue.IssuePermalink != "" {
+			details = append(details, fmt.Sprintf("[%s](%s)", valueOrDefault(issue.IssueName, issue.Provider), issue.IssuePermalink))
+		}
+		fmt.Fprintf(b, "- %s %s (%s)\n", markdownCheckbox(followUp.Status == "completed"), markdownListText(followUp.Title), strings.Join(details, ", "))
+	}
+}
+
+func renderAnalysis(b *strings.Builder) {
+	b.WriteString("## Analysis\n")
+	for _, prompt := range postmortemAnalysisPrompts {
+		fmt.Fprintf(b, "\n### %s\n\n_To be completed._\n", prompt)
+	}
+}
+
+func formatPostmortemUser(user *incidentio.User) string {
+	if user.Email == "" {
+		return valueOrDefault(user.Name, user.ID)
+	}
+	return fmt.Sprintf("%s <%s>", user.Name, user.Email)
+}
+
+func markdownCheckbox(done bool) string {
+	if done {
+		return "[x]"
+	}
+	return "[ ]"
+}
+
+// markdownListText indents continuation lines so multi-line text stays
+// inside its list item
+func markdownListText(text string) string {
+	return strings.ReplaceAll(strings.TrimSpace(text), "\n", "\n  ")
+}
+
+// markdownTableCell keeps a value on one table row
+func markdownTableCell(text string) string {
+	text = strings.ReplaceAll(text, "|", `\|`)
+	return strings.ReplaceAll(text, "\n", " ")
+}
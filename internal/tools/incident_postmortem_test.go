@@ -0,0 +1,162 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const postmortemIncidentID = "01HXYZINCIDENT0000000000000"
+
+// newPostmortemTool serves a fully populated incident and records the paths
+// requested
+func newPostmortemTool(t *testing.T, requested *[]string) *ExportIncidentTool {
+	t.Helper()
+
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		*requested = append(*requested, r.URL.Path)
+		if r.URL.Path != "/incidents/123" && r.URL.Query().Get("incident_id") != postmortemIncidentID {
+			t.Errorf("expected %s to be filtered by incident ID, got %q", r.URL.Path, r.URL.RawQuery)
+		}
+
+		switch r.URL.Path {
+		case "/incidents/123":
+			fmt.Fprintf(w, `{"incident": {
+				"id": %q,
+				"reference": "INC-123",
+				"name": "Checkout errors",
+				"summary": "Card payments failed for EU customers.",
+				"permalink": "https://app.incident.io/incidents/123",
+				"incident_status": {"name": "Closed", "category": "closed"},
+				"severity": {"name": "Critical"},
+				"incident_type": {"name": "Production"},
+				"slack_channel_name": "inc-123-checkout-errors",
+				"created_at": "2024-12-01T09:30:00Z",
+				"updated_at": "2024-12-02T08:00:00Z",
+				"incident_role_assignments": [
+					{"role": {"name": "Incident Lead", "role_type": "lead"}, "assignee": {"name": "Ada Lovelace", "email": "ada@example.com"}},
+					{"role": {"name": "Communications", "role_type": "custom"}}
+				],
+				"incident_timestamp_values": [
+					{"incident_timestamp": {"id": "ts_1", "name": "Detected"}, "value": {"value": "2024-12-01T09:00:00Z"}},
+					{"incident_timestamp": {"id": "ts_2", "name": "Resolved at"}, "value": {"value": "2024-12-01T11:15:00Z"}}
+				]
+			}}`, postmortemIncidentID)
+		case "/incident_updates":
+			fmt.Fprint(w, `{"incident_updates": [
+				{"id": "upd_2", "message": "Rolled back the payment service.\nErrors are recovering.", "created_at": "2024-12-01T10:45:00Z", "author": {"name": "Ada Lovelace"}},
+				{"id": "upd_1", "message": "Investigating elevated 5xx errors", "created_at": "2024-12-01T09:40:00Z"}
+			]}`)
+		case "/actions":
+			fmt.Fprint(w, `{"actions": [
+				{"id": "act_1", "description": "Roll back payment service", "status": "completed", "assignee": {"name": "Grace Hopper"}},
+				{"id": "act_2", "description": "Page the card processor", "status": "outstanding"}
+			], "pagination_meta": {"page_size": 250}}`)
+		case "/follow_ups":
+			fmt.Fprint(w, `{"follow_ups": [
+				{"id": "fu_1", "title": "Alert on checkout 5xx rate", "status": "outstanding", "assignee": {"name": "Grace Hopper"},
+					"priority": {"name": "High"},
+					"external_issue_reference": {"provider": "jira", "issue_name": "PAY-42", "issue_permalink": "https://jira.example.com/PAY-42"}}
+			]}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	tool := NewExportIncidentTool(client)
+	tool.now = func() time.Time { return time.Date(2024, 12, 3, 0, 0, 0, 0, time.UTC) }
+	return tool
+}
+
+func TestExportIncidentTool_FullPostmortem(t *testing.T) {
+	var requested []string
+	tool := newPostmortemTool(t, &requested)
+
+	result, err := tool.Execute(context.Background(), map[string]interface{}{"incident_id": "INC-123"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []string{
+		"# Postmortem: INC-123: Checkout errors",
+		"## Overview",
+		"| Severity | Critical |",
+		"| Status | Closed |",
+		"| Type | Production |",
+		"| Lead | Ada Lovelace <ada@example.com> |",
+		"| Duration | 2h 15m |",
+		"| Link | https://app.incident.io/incidents/123 |",
+		"### Summary\n\nCard payments failed for EU customers.",
+		"## Timeline",
+		"- 2024-12-01T09:00:00Z: **Detected**\n" +
+			"- 2024-12-01T09:40:00Z: Update: Investigating elevated 5xx errors\n" +
+			"- 2024-12-01T10:45:00Z: Update from Ada Lovelace: Rolled back the payment service.\n  Errors are recovering.\n" +
+			"- 2024-12-01T11:15:00Z: **Resolved at**\n",
+		"## Roles",
+		"- **Incident Lead**: Ada Lovelace <ada@example.com>",
+		"- **Communications**: _unassigned_",
+		"## Actions",
+		"- [x] Roll back payment service (completed, Grace Hopper)",
+		"- [ ] Page the card processor (outstanding)",
+		"## Follow-ups",
+		"- [ ] Alert on checkout 5xx rate (outstanding, Grace Hopper, priority High, [PAY-42](https://jira.example.com/PAY-42))",
+		"## Analysis",
+		"### Root cause",
+		"### Lessons learned",
+	}
+	for _, want := range expected {
+		if !strings.Contains(result, want) {
+			t.Errorf("expected postmortem to contain %q, got:\n%s", want, result)
+		}
+	}
+
+	// Sections are rendered in document order
+	var positions []int
+	for _, header := range []string{"## Overview", "## Timeline", "## Roles", "## Actions", "## Follow-ups", "## Analysis"} {
+		positions = append(positions, strings.Index(result, header))
+	}
+	for i := 1; i < len(positions); i++ {
+		if positions[i] < positions[i-1] {
+			t.Errorf("expected sections in document order, got positions %v", positions)
+		}
+	}
+}
+
+func TestExportIncidentTool_Sections(t *testing.T) {
+	var requested []string
+	tool := newPostmortemTool(t, &requested)
+
+	result, err := tool.Execute(context.Background(), map[string]interface{}{
+		"incident_id": "INC-123",
+		"sections":    []interface{}{"follow_ups", "roles"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Only the data the chosen sections need is fetched
+	if want := []string{"/incidents/123", "/follow_ups"}; !reflect.DeepEqual(requested, want) {
+		t.Errorf("expected requests %v, got %v", want, requested)
+	}
+	if !strings.Contains(result, "## Roles") || !strings.Contains(result, "## Follow-ups") {
+		t.Errorf("expected the roles and follow-ups sections, got:\n%s", result)
+	}
+	for _, header := range []string{"## Overview", "## Timeline", "## Actions", "## Analysis"} {
+		if strings.Contains(result, header) {
+			t.Errorf("expected no %q section, got:\n%s", header, result)
+		}
+	}
+
+	_, err = tool.Execute(context.Background(), map[string]interface{}{
+		"incident_id": "INC-123",
+		"sections":    []interface{}{"appendix"},
+	})
+	if err == nil || !strings.Contains(err.Error(), "invalid section 'appendix'") {
+		t.Errorf("expected an invalid section error, got: %v", err)
+	}
+}
@@ -0,0 +1,251 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+const (
+	// defaultStatsWindow is the created_at filter used when no date filter is given
+	defaultStatsWindow = "now-30d"
+	// defaultStatsLimit is the default cap on incidents scanned for statistics
+	defaultStatsLimit = 5000
+	// noSeverity groups incidents that have no severity set
+	noSeverity = "none"
+)
+
+// statsDateFilters are the date filter arguments that define the stats window
+var statsDateFilters = []string{
+	"created_at_gte", "created_at_lte", "created_at_range",
+	"updated_at_gte", "updated_at_lte", "updated_at_range",
+}
+
+// IncidentStatsTool counts incidents by severity and status over a window
+type IncidentStatsTool struct {
+	client   *incidentio.Client
+	listTool *ListIncidentsTool
+}
+
+func NewIncidentStatsTool(client *incidentio.Client) *IncidentStatsTool {
+	return &IncidentStatsTool{
+		client:   client,
+		listTool: newListIncidentsTool(client),
+	}
+}
+
+func (t *IncidentStatsTool) Name() string {
+	return "get_incident_stats"
+}
+
+func (t *IncidentStatsTool) Description() string {
+	return `Count incidents by severity and status over a time window, without listing them.
+
+USAGE WORKFLOW:
+1. Pick a window with the created_at or updated_at filters (default: created in the last 30 days)
+2. Optionally narrow it with the same status, severity and mode filters as list_incidents
+3. Read the totals from by_severity and by_status_category
+
+PARAMETERS:
+- created_at_gte, created_at_lte, created_at_range: Same created_at filters as list_incidents, including relative dates such as "now-7d"
+- updated_at_gte, updated_at_lte, updated_at_range: Same updated_at filters as list_incidents
+- timezone: IANA time zone for date-only filters (default UTC)
+- status, severity, severity_gte, severity_lte, mode: Same filters as list_incidents
+- max_results: Maximum number of incidents to scan (default 5000, max 10000)
+
+RESULT:
+- total: Number of incidents counted
+- by_severity: Counts keyed by severity name ("none" for incidents without a severity)
+- by_status_category: Counts keyed by status category (triage, live, learning, closed, ...)
+- window: The date filters applied, plus the earliest and latest created_at seen
+- truncated: true if max_results was reached before every matching incident was counted
+
+EXAMPLES:
+- Last quarter's incidents: {"created_at_gte": "now-90d"}
+- Critical incidents in December: {"created_at_range": "2024-12-01~2024-12-31", "severity": ["Critical"]}
+
+IMPORTANT: Incidents are fetched 250 per API request, so wide windows make many requests. Counts cover only the scanned incidents when truncated is true.`
+}
+
+func (t *IncidentStatsTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"created_at_gte": map[string]interface{}{
+				"type":        "string",
+				"description": "Count incidents created on or after this date (ISO 8601 format), or relative: \"now-7d\", \"today\". Defaults to \"now-30d\" when no date filter is given.",
+			},
+			"created_at_lte": map[string]interface{}{
+				"type":        "string",
+				"description": "Count incidents created on or before this date (ISO 8601 format), or relative: \"now-7d\", \"today\"",
+			},
+			"created_at_range": map[string]interface{}{
+				"type":        "string",
+				"description": "Count incidents created within a tilde-separated date range, e.g. \"2024-12-01~2024-12-31\"",
+			},
+			"updated_at_gte": map[string]interface{}{
+				"type":        "string",
+				"description": "Count incidents updated on or after this date (ISO 8601 format)",
+			},
+			"updated_at_lte": map[string]interface{}{
+				"type":        "string",
+				"description": "Count incidents updated on or before this date (ISO 8601 format)",
+			},
+			"updated_at_range": map[string]interface{}{
+				"type":        "string",
+				"description": "Count incidents updated within a tilde-separated date range, e.g. \"2024-12-01~2024-12-31\"",
+			},
+			"timezone": map[string]interface{}{
+				"type":        "string",
+				"description": "IANA time zone used to interpret date-only filters, e.g. \"America/Los_Angeles\". Defaults to UTC.",
+			},
+			"status": map[string]interface{}{
+				"type":        []string{"array", "string"},
+				"items":       map[string]interface{}{"type": "string"},
+				"description": "Only count incidents with these statuses. Accepts the same values and aliases as list_incidents.",
+			},
+			"severity": map[string]interface{}{
+				"type":        []string{"array", "string"},
+				"items":       map[string]interface{}{"type": "string"},
+				"description": "Only count incidents with these severities, by name or ID",
+			},
+			"severity_gte": map[string]interface{}{
+				"type":        "string",
+				"description": "Only count incidents at least this severe, by severity name or ID",
+			},
+			"severity_lte": map[string]interface{}{
+				"type":        "string",
+				"description": "Only count incidents at most this severe, by severity name or ID",
+			},
+			"mode": map[string]interface{}{
+				"type":        []string{"array", "string"},
+				"items":       map[string]interface{}{"type": "string", "enum": incidentModes},
+				"description": "Only count incidents in these modes (standard, retrospective, tutorial)",
+			},
+			"max_results": map[string]interface{}{
+				"type":        "integer",
+				"description": "Maximum number of incidents to scan (default 5000, max 10000)",
+				"default":     defaultStatsLimit,
+			},
+		},
+		"additionalProperties": false,
+	}
+}
+
+// incidentStats is the result of get_incident_stats
+type incidentStats struct {
+	Total            int            `json:"total"`
+	BySeverity       map[string]int `json:"by_severity"`
+	ByStatusCategory map[string]int `json:"by_status_category"`
+	Window           statsWindow    `json:"window"`
+	Truncated        bool           `json:"truncated"`
+}
+
+// statsWindow describes the window the statistics cover
+type statsWindow struct {
+	CreatedAtGTE      string     `json:"created_at_gte,omitempty"`
+	CreatedAtLTE      string     `json:"created_at_lte,omitempty"`
+	CreatedAtRange    string     `json:"created_at_range,omitempty"`
+	UpdatedAtGTE      string     `json:"updated_at_gte,omitempty"`
+	UpdatedAtLTE      string     `json:"updated_at_lte,omitempty"`
+	UpdatedAtRange    string     `json:"updated_at_range,omitempty"`
+	EarliestCreatedAt *time.Time `json:"earliest_created_at,omitempty"`
+	LatestCreatedAt   *time.Time `json:"latest_created_at,omitempty"`
+}
+
+func (t *IncidentStatsTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	maxResults := defaultStatsLimit
+	if value, ok := args["max_results"].(float64); ok {
+		maxResults = int(value)
+	}
+	if maxResults < 1 || maxResults > maxAutoPaginateLimit {
+		return "", fmt.Errorf("max_results must be between 1 and %d, got %d", maxAutoPaginateLimit, maxResults)
+	}
+
+	// Without a date filter the scan would cover every incident ever declared
+	if !hasAnyArgument(args, statsDateFilters) {
+		withDefault := make(map[string]interface{}, len(args)+1)
+		for key, value := range args {
+			withDefault[key] = value
+		}
+		withDefault["created_at_gte"] = defaultStatsWindow
+		args = withDefault
+	}
+
+	opts := &incidentio.ListIncidentsOptions{}
+	if err := t.listTool.applyFilters(ctx, args, opts); err != nil {
+		return "", err
+	}
+
+	resp, truncated, err := t.listTool.autoPaginate(ctx, opts, maxResults)
+	if err != nil {
+		return "", err
+	}
+
+	stats := countIncidents(resp.Incidents)
+	stats.Truncated = truncated
+	stats.Window.CreatedAtGTE = opts.CreatedAtGTE
+	stats.Window.CreatedAtLTE = opts.CreatedAtLTE
+	stats.Window.CreatedAtRange = opts.CreatedAtRange
+	stats.Window.UpdatedAtGTE = opts.UpdatedAtGTE
+	stats.Window.UpdatedAtLTE = opts.UpdatedAtLTE
+	stats.Window.UpdatedAtRange = opts.UpdatedAtRange
+
+	result, err := json.MarshalIndent(stats, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return string(result), nil
+}
+
+// countIncidents groups incidents by severity name and status category, and
+// records the range of creation times seen
+func countIncidents(incidents []incidentio.Incident) *incidentStats {
+	stats := &incidentStats{
+		Total:            len(incidents),
+		BySeverity:       map[string]int{},
+		ByStatusCategory: map[string]int{},
+	}
+
+	for i := range incidents {
+		incident := &incidents[i]
+
+		severity := incident.Severity.Name
+		if severity == "" {
+			severity = noSeverity
+		}
+		stats.BySeverity[severity]++
+
+		category := incident.IncidentStatus.Category
+		if category == "" {
+			category = "unknown"
+		}
+		stats.ByStatusCategory[category]++
+
+		if incident.CreatedAt.IsZero() {
+			continue
+		}
+		if stats.Window.EarliestCreatedAt == nil || incident.CreatedAt.Before(*stats.Window.EarliestCreatedAt) {
+			stats.Window.EarliestCreatedAt = &incident.CreatedAt
+		}
+		if stats.Window.LatestCreatedAt == nil || incident.CreatedAt.After(*stats.Window.LatestCreatedAt) {
+			stats.Window.LatestCreatedAt = &incident.CreatedAt
+		}
+	}
+
+	return stats
+}
+
+// hasAnyArgument reports whether any of names is set to a non-empty value
+func hasAnyArgument(args map[string]interface{}, names []string) bool {
+	for _, name := range names {
+		if value, ok := args[name].(string); ok && value != "" {
+			return true
+		}
+	}
+	return false
+}
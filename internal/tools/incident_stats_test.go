@@ -0,0 +1,116 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// newStatsClient serves six incidents over two pages and records the
+// created_at filter of each request
+func newStatsClient(t *testing.T, createdAtGTE *string) *ListIncidentsTool {
+	t.Helper()
+
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		*createdAtGTE = r.URL.Query().Get("created_at[gte]")
+		if r.URL.Query().Get("after") == "" {
+			fmt.Fprint(w, `{
+				"incidents": [
+					{"id": "inc_1", "severity": {"name": "Critical"}, "incident_status": {"category": "live"}, "created_at": "2024-12-03T10:00:00Z"},
+					{"id": "inc_2", "severity": {"name": "Critical"}, "incident_status": {"category": "closed"}, "created_at": "2024-12-01T10:00:00Z"},
+					{"id": "inc_3", "severity": {"name": "Minor"}, "incident_status": {"category": "closed"}, "created_at": "2024-12-05T10:00:00Z"}
+				],
+				"pagination_meta": {"after": "inc_3", "page_size": 3, "total_record_count": 6}
+			}`)
+			return
+		}
+		fmt.Fprint(w, `{
+			"incidents": [
+				{"id": "inc_4", "severity": {"name": "Minor"}, "incident_status": {"category": "closed"}, "created_at": "2024-12-02T10:00:00Z"},
+				{"id": "inc_5", "incident_status": {"category": "triage"}, "created_at": "2024-12-04T10:00:00Z"},
+				{"id": "inc_6", "severity": {"name": "Critical"}, "incident_status": {"category": "live"}, "created_at": "2024-12-06T10:00:00Z"}
+			],
+			"pagination_meta": {"page_size": 3, "total_record_count": 6}
+		}`)
+	})
+
+	listTool := NewListIncidentsTool(client)
+	listTool.now = func() time.Time { return time.Date(2024, 12, 31, 12, 0, 0, 0, time.UTC) }
+	return listTool
+}
+
+func TestIncidentStatsTool_Counts(t *testing.T) {
+	var createdAtGTE string
+	tool := &IncidentStatsTool{listTool: newStatsClient(t, &createdAtGTE)}
+
+	result, err := tool.Execute(context.Background(), map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var stats incidentStats
+	if err := json.Unmarshal([]byte(result), &stats); err != nil {
+		t.Fatalf("failed to parse result: %v\n%s", err, result)
+	}
+
+	if stats.Total != 6 {
+		t.Errorf("expected total 6, got %d", stats.Total)
+	}
+	if want := map[string]int{"Critical": 3, "Minor": 2, "none": 1}; !reflect.DeepEqual(stats.BySeverity, want) {
+		t.Errorf("expected by_severity %v, got %v", want, stats.BySeverity)
+	}
+	if want := map[string]int{"live": 2, "closed": 3, "triage": 1}; !reflect.DeepEqual(stats.ByStatusCategory, want) {
+		t.Errorf("expected by_status_category %v, got %v", want, stats.ByStatusCategory)
+	}
+	if stats.Truncated {
+		t.Error("expected truncated to be false")
+	}
+
+	// The default window is the last 30 days
+	if createdAtGTE != "2024-12-01T12:00:00Z" {
+		t.Errorf("expected default created_at[gte] 2024-12-01T12:00:00Z, got %q", createdAtGTE)
+	}
+	if stats.Window.CreatedAtGTE != createdAtGTE {
+		t.Errorf("expected window created_at_gte %q, got %q", createdAtGTE, stats.Window.CreatedAtGTE)
+	}
+	if stats.Window.EarliestCreatedAt == nil || !stats.Window.EarliestCreatedAt.Equal(time.Date(2024, 12, 1, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected earliest_created_at: %v", stats.Window.EarliestCreatedAt)
+	}
+	if stats.Window.LatestCreatedAt == nil || !stats.Window.LatestCreatedAt.Equal(time.Date(2024, 12, 6, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected latest_created_at: %v", stats.Window.LatestCreatedAt)
+	}
+}
+
+func TestIncidentStatsTool_Truncated(t *testing.T) {
+	var createdAtGTE string
+	tool := &IncidentStatsTool{listTool: newStatsClient(t, &createdAtGTE)}
+
+	result, err := tool.Execute(context.Background(), map[string]interface{}{
+		"created_at_range": "2024-12-01~2024-12-31",
+		"max_results":      float64(4),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var stats incidentStats
+	if err := json.Unmarshal([]byte(result), &stats); err != nil {
+		t.Fatalf("failed to parse result: %v\n%s", err, result)
+	}
+
+	if stats.Total != 4 || !stats.Truncated {
+		t.Errorf("expected 4 incidents counted and truncated, got total %d truncated %v", stats.Total, stats.Truncated)
+	}
+	if want := map[string]int{"Critical": 2, "Minor": 2}; !reflect.DeepEqual(stats.BySeverity, want) {
+		t.Errorf("expected by_severity %v, got %v", want, stats.BySeverity)
+	}
+
+	// An explicit window replaces the default
+	if createdAtGTE != "" || stats.Window.CreatedAtRange != "2024-12-01~2024-12-31" {
+		t.Errorf("expected only the given range, got created_at[gte] %q and window %+v", createdAtGTE, stats.Window)
+	}
+}
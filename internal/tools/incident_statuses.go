@@ -1,6 +1,7 @@
 package tools
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -39,18 +40,16 @@ IMPORTANT: Status IDs from this tool are required for create_incident and update
 
 func (t *ListIncidentStatusesTool) InputSchema() map[string]interface{} {
 	return map[string]interface{}{
-		"type":       "object",
-		"properties": map[string]interface{}{},
+		"type": "object",
+		"properties": map[string]interface{}{
+			"fields": fieldsProperty(),
+		},
 	}
 }
 
-func (t *ListIncidentStatusesTool) Execute(args map[string]interface{}) (string, error) {
+func (t *ListIncidentStatusesTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
 	// Use V1 API to get incident statuses
-	originalBaseURL := t.client.BaseURL()
-	t.client.SetBaseURL("https://api.incident.io/v1")
-	defer t.client.SetBaseURL(originalBaseURL)
-
-	respBody, err := t.client.DoRequest("GET", "/incident_statuses", nil, nil)
+	respBody, err := t.client.RawRequest(ctx, "GET", "/v1/incident_statuses", nil, nil)
 	if err != nil {
 		return "", fmt.Errorf("failed to fetch incident statuses: %w", err)
 	}
@@ -60,10 +59,5 @@ func (t *ListIncidentStatusesTool) Execute(args map[string]interface{}) (string,
 		return "", fmt.Errorf("failed to parse response: %w", err)
 	}
 
-	result, err := json.MarshalIndent(response, "", "  ")
-	if err != nil {
-		return "", fmt.Errorf("failed to format response: %w", err)
-	}
-
-	return string(result), nil
+	return formatJSONResult(response, args)
 }
@@ -0,0 +1,101 @@
+package tools
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+// incidentOutputFormats are the values accepted by get_incident's format argument
+var incidentOutputFormats = []string{"json", "summary"}
+
+// formatIncidentSummary renders the key facts of an incident as a compact,
+// human-readable block for chat display
+func formatIncidentSummary(incident *incidentio.Incident, duration incidentDuration) string {
+	var b strings.Builder
+
+	title := incident.Name
+	if incident.Reference != "" {
+		title = incident.Reference + ": " + incident.Name
+	}
+	b.WriteString(title + "\n")
+
+	status := valueOrDefault(incident.IncidentStatus.Name, "unknown")
+	if category := incident.IncidentStatus.Category; category != "" && !strings.EqualFold(category, status) {
+		status += " (" + category + ")"
+	}
+	fmt.Fprintf(&b, "Status: %s\n", status)
+	fmt.Fprintf(&b, "Severity: %s\n", valueOrDefault(incident.Severity.Name, "none"))
+	fmt.Fprintf(&b, "Lead: %s\n", incidentLead(incident))
+
+	if slack := slackChannelLink(incident); slack != "" {
+		fmt.Fprintf(&b, "Slack: %s\n", slack)
+	}
+	if incident.Permalink != "" {
+		fmt.Fprintf(&b, "Link: %s\n", incident.Permalink)
+	}
+	fmt.Fprintf(&b, "Created: %s\n", formatSummaryTime(incident.CreatedAt))
+	fmt.Fprintf(&b, "Updated: %s\n", formatSummaryTime(incident.UpdatedAt))
+	if duration.Open {
+		fmt.Fprintf(&b, "Duration: %s so far (open)", duration.Human)
+	} else {
+		fmt.Fprintf(&b, "Duration: %s", duration.Human)
+	}
+
+	return b.String()
+}
+
+// incidentLead describes the assignee of the incident's lead role
+func incidentLead(incident *incidentio.Incident) string {
+	for _, assignment := range incident.IncidentRoleAssignments {
+		if assignment.Role.RoleType != "lead" {
+			continue
+		}
+		if assignment.Assignee == nil {
+			return "unassigned"
+		}
+		if assignment.Assignee.Email == "" {
+			return assignment.Assignee.Name
+		}
+		return fmt.Sprintf("%s <%s>", assignment.Assignee.Name, assignment.Assignee.Email)
+	}
+	return "unassigned"
+}
+
+// slackChannelLink names the incident's Slack channel and links to it when
+// the channel ID is known
+func slackChannelLink(incident *incidentio.Incident) string {
+	if incident.SlackChannelID == "" {
+		if incident.SlackChannelName == "" {
+			return ""
+		}
+		return "#" + incident.SlackChannelName
+	}
+
+	query := url.Values{"channel": {incident.SlackChannelID}}
+	if incident.SlackTeamID != "" {
+		query.Set("team", incident.SlackTeamID)
+	}
+	link := "https://slack.com/app_redirect?" + query.Encode()
+	if incident.SlackChannelName == "" {
+		return link
+	}
+	return fmt.Sprintf("#%s (%s)", incident.SlackChannelName, link)
+}
+
+func formatSummaryTime(value time.Time) string {
+	if value.IsZero() {
+		return "unknown"
+	}
+	return value.UTC().Format(time.RFC3339)
+}
+
+func valueOrDefault(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
@@ -0,0 +1,121 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// newIncidentSummaryTestTool returns a get_incident tool backed by a single
+// closed incident with a lead and a Slack channel
+func newIncidentSummaryTestTool(t *testing.T) *GetIncidentTool {
+	t.Helper()
+
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/incidents/01HXYZINCIDENT0000000000000" {
+			t.Errorf("unexpected request: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `{"incident": {
+			"id": "01HXYZINCIDENT0000000000000",
+			"reference": "INC-42",
+			"name": "Database outage",
+			"permalink": "https://app.incident.io/acme/incidents/42",
+			"incident_status": {"id": "st_closed", "name": "Closed", "category": "closed"},
+			"severity": {"id": "sev_1", "name": "Critical"},
+			"slack_team_id": "T123",
+			"slack_channel_id": "C0456",
+			"slack_channel_name": "inc-42-database-outage",
+			"incident_role_assignments": [
+				{"role": {"id": "role_comms", "name": "Communications", "role_type": "custom"}, "assignee": {"id": "u_2", "name": "Sam Lee", "email": "sam@example.com"}},
+				{"role": {"id": "role_lead", "name": "Incident Lead", "role_type": "lead"}, "assignee": {"id": "u_1", "name": "Alex Kim", "email": "alex@example.com"}}
+			],
+			"created_at": "2024-12-01T09:30:00Z",
+			"updated_at": "2024-12-01T11:00:00+01:00"
+		}}`)
+	})
+	return NewGetIncidentTool(client)
+}
+
+func TestGetIncidentTool_SummaryFormat(t *testing.T) {
+	tool := newIncidentSummaryTestTool(t)
+
+	result, err := tool.Execute(context.Background(), map[string]interface{}{
+		"incident_id": "01HXYZINCIDENT0000000000000",
+		"format":      "summary",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, line := range []string{
+		"INC-42: Database outage",
+		"Status: Closed",
+		"Severity: Critical",
+		"Lead: Alex Kim <alex@example.com>",
+		"Slack: #inc-42-database-outage (https://slack.com/app_redirect?channel=C0456&team=T123)",
+		"Link: https://app.incident.io/acme/incidents/42",
+		"Created: 2024-12-01T09:30:00Z",
+		"Updated: 2024-12-01T10:00:00Z",
+		"Duration: 30m",
+	} {
+		if !strings.Contains(result, line) {
+			t.Errorf("expected summary to contain %q, got:\n%s", line, result)
+		}
+	}
+	if strings.Contains(result, "Status: Closed (closed)") {
+		t.Errorf("expected status category matching the name to be omitted, got:\n%s", result)
+	}
+	if strings.HasPrefix(strings.TrimSpace(result), "{") {
+		t.Errorf("expected a text summary rather than JSON, got:\n%s", result)
+	}
+}
+
+func TestGetIncidentTool_FormatArgument(t *testing.T) {
+	t.Run("json format keeps field filtering", func(t *testing.T) {
+		tool := newIncidentSummaryTestTool(t)
+
+		result, err := tool.Execute(context.Background(), map[string]interface{}{
+			"incident_id": "01HXYZINCIDENT0000000000000",
+			"format":      "json",
+			"fields":      "reference,incident_status.name",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strings.Contains(result, `"reference": "INC-42"`) || !strings.Contains(result, `"name": "Closed"`) {
+			t.Errorf("expected filtered JSON, got: %s", result)
+		}
+		if strings.Contains(result, "Database outage") {
+			t.Errorf("expected unrequested fields to be dropped, got: %s", result)
+		}
+	})
+
+	t.Run("rejects an unknown format", func(t *testing.T) {
+		tool := newIncidentSummaryTestTool(t)
+
+		_, err := tool.Execute(context.Background(), map[string]interface{}{
+			"incident_id": "01HXYZINCIDENT0000000000000",
+			"format":      "yaml",
+		})
+		if err == nil || !strings.Contains(err.Error(), "unsupported format 'yaml'") {
+			t.Fatalf("expected unsupported format error, got: %v", err)
+		}
+	})
+
+	t.Run("rejects fields with the summary format", func(t *testing.T) {
+		tool := newIncidentSummaryTestTool(t)
+
+		_, err := tool.Execute(context.Background(), map[string]interface{}{
+			"incident_id": "01HXYZINCIDENT0000000000000",
+			"format":      "summary",
+			"fields":      "name",
+		})
+		if err == nil || !strings.Contains(err.Error(), "json format") {
+			t.Fatalf("expected error for fields with summary format, got: %v", err)
+		}
+	})
+}
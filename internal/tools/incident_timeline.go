@@ -0,0 +1,239 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+const (
+	// defaultTimelineLimit is the number of timeline entries returned when
+	// limit is not set
+	defaultTimelineLimit = 100
+	// maxTimelineLimit caps the limit argument
+	maxTimelineLimit = 1000
+)
+
+// Kinds of timeline entry
+const (
+	timelineKindTimestamp      = "timestamp"
+	timelineKindUpdate         = "update"
+	timelineKindStatusChange   = "status_change"
+	timelineKindSeverityChange = "severity_change"
+)
+
+// GetIncidentTimelineTool returns an incident's key timestamps and updates as
+// one chronological list
+type GetIncidentTimelineTool struct {
+	client   *incidentio.Client
+	resolver *GetIncidentTool
+	// now resolves relative since values. Tests replace it
+	now func() time.Time
+}
+
+func NewGetIncidentTimelineTool(client *incidentio.Client) *GetIncidentTimelineTool {
+	return &GetIncidentTimelineTool{
+		client:   client,
+		resolver: NewGetIncidentTool(client),
+		now:      time.Now,
+	}
+}
+
+func (t *GetIncidentTimelineTool) Name() string {
+	return "get_incident_timeline"
+}
+
+func (t *GetIncidentTimelineTool) Description() string {
+	return `Get the chronological timeline of an incident: its key timestamps (such as reported, accepted and resolved), the updates posted to it, and the status and severity changes those updates made.
+
+USAGE WORKFLOW:
+1. Find the incident with list_incidents or search_incidents
+2. Call this tool with its identifier to read what happened, oldest first
+3. If "truncated" is true, call again with since set to the last entry's "at" to read on
+
+PARAMETERS:
+- incident_id: Required. Incident ID, reference ("INC-123" or "123"), Slack channel ID or Slack channel name
+- since: Optional. Only entries at or after this time: a date (2024-12-01), an RFC 3339 time, or a relative time such as "now-2h"
+- limit: Optional. Maximum number of entries to return (default 100, max 1000)
+
+EXAMPLES:
+- Full timeline: {"incident_id": "INC-123"}
+- The last two hours: {"incident_id": "INC-123", "since": "now-2h"}
+- First 20 entries, times and messages only: {"incident_id": "INC-123", "limit": 20, "fields": "at,message"}
+
+Each entry has "at", "kind" (timestamp, update, status_change or severity_change), "message", and "actor" when someone posted it. status_change and severity_change entries also have "new_status" or "new_severity".`
+}
+
+func (t *GetIncidentTimelineTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"incident_id": map[string]interface{}{
+				"type":        "string",
+				"description": "Incident identifier: full ID, reference (INC-123 or 123), Slack channel ID or Slack channel name",
+			},
+			"since": map[string]interface{}{
+				"type":        "string",
+				"description": "Only entries at or after this time: a date, an RFC 3339 time, or a relative time such as now-2h",
+			},
+			"limit": map[string]interface{}{
+				"type":        "integer",
+				"description": "Maximum number of entries to return, oldest first",
+				"default":     defaultTimelineLimit,
+				"minimum":     1,
+				"maximum":     maxTimelineLimit,
+			},
+			"fields": fieldsProperty(),
+		},
+		"required":             []interface{}{"incident_id"},
+		"additionalProperties": false,
+	}
+}
+
+// incidentTimelineEntry is a single entry in an incident's timeline
+type incidentTimelineEntry struct {
+	At               time.Time `json:"at"`
+	Kind             string    `json:"kind"`
+	Message          string    `json:"message"`
+	Actor            string    `json:"actor,omitempty"`
+	NewStatus        string    `json:"new_status,omitempty"`
+	NewSeverity      string    `json:"new_severity,omitempty"`
+	IncidentUpdateID string    `json:"incident_update_id,omitempty"`
+}
+
+func (t *GetIncidentTimelineTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	identifier, _ := args["incident_id"].(string)
+	identifier = strings.TrimSpace(identifier)
+	if identifier == "" {
+		return "", fmt.Errorf("incident_id parameter is required")
+	}
+
+	limit := defaultTimelineLimit
+	if value, ok := args["limit"].(float64); ok {
+		limit = int(value)
+		if limit < 1 || limit > maxTimelineLimit {
+			return "", fmt.Errorf("limit must be between 1 and %d, got %v", maxTimelineLimit, value)
+		}
+	}
+
+	var since time.Time
+	if value, _ := args["since"].(string); strings.TrimSpace(value) != "" {
+		now := time.Now
+		if t.now != nil {
+			now = t.now
+		}
+		bound, err := dateParser{loc: time.UTC, now: now()}.parseBound("since", value)
+		if err != nil {
+			return "", err
+		}
+		since = bound.start
+	}
+
+	incidentID, err := t.resolver.ResolveIncidentIdentifier(ctx, identifier)
+	if err != nil {
+		return "", err
+	}
+	incident, err := t.client.GetIncident(ctx, incidentID)
+	if err != nil {
+		return "", err
+	}
+
+	// Updates are listed by the incident's ID, which a reference such as "123" is not
+	updates, err := t.listAllUpdates(ctx, incident.ID)
+	if err != nil {
+		return "", err
+	}
+
+	entries := buildIncidentTimeline(incident, updates)
+	filtered := make([]incidentTimelineEntry, 0, len(entries))
+	for _, entry := range entries {
+		if !since.IsZero() && entry.At.Before(since) {
+			continue
+		}
+		filtered = append(filtered, entry)
+	}
+	truncated := len(filtered) > limit
+	if truncated {
+		filtered = filtered[:limit]
+	}
+
+	return formatJSONResult(map[string]interface{}{
+		"incident_id": incident.ID,
+		"reference":   incident.Reference,
+		"name":        incident.Name,
+		"timeline":    filtered,
+		"truncated":   truncated,
+	}, args)
+}
+
+// listAllUpdates pages through every update posted to an incident
+func (t *GetIncidentTimelineTool) listAllUpdates(ctx context.Context, incidentID string) ([]incidentio.IncidentUpdate, error) {
+	opts := &incidentio.ListIncidentUpdatesOptions{IncidentID: incidentID, PageSize: 250}
+	guard := incidentio.NewPaginationGuard("incident updates", "")
+
+	var updates []incidentio.IncidentUpdate
+	for {
+		resp, err := t.client.ListIncidentUpdates(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list incident updates: %w", err)
+		}
+		updates = append(updates, resp.IncidentUpdates...)
+
+		after := resp.PaginationMeta.After
+		if after == "" || len(resp.IncidentUpdates) == 0 {
+			return updates, nil
+		}
+		if err := guard.Next(after, len(resp.IncidentUpdates)); err != nil {
+			return nil, err
+		}
+		opts.After = after
+	}
+}
+
+// buildIncidentTimeline merges an incident's timestamp values and updates
+// into one list, oldest first. An update that changed the status or severity
+// is reported as that change.
+func buildIncidentTimeline(incident *incidentio.Incident, updates []incidentio.IncidentUpdate) []incidentTimelineEntry {
+	var entries []incidentTimelineEntry
+	for _, value := range incident.IncidentTimestampValues {
+		if value.Value == nil || value.Value.Value == nil {
+			continue
+		}
+		entries = append(entries, incidentTimelineEntry{
+			At:      *value.Value.Value,
+			Kind:    timelineKindTimestamp,
+			Message: value.IncidentTimestamp.Name,
+		})
+	}
+
+	for _, update := range updates {
+		entry := incidentTimelineEntry{
+			At:               update.CreatedAt,
+			Kind:             timelineKindUpdate,
+			Message:          update.Message,
+			IncidentUpdateID: update.ID,
+		}
+		if update.Author != nil {
+			entry.Actor = update.Author.Name
+			if entry.Actor == "" {
+				entry.Actor = update.Author.Email
+			}
+		}
+		if update.NewSeverity != nil {
+			entry.Kind = timelineKindSeverityChange
+			entry.NewSeverity = update.NewSeverity.Name
+		}
+		if update.NewIncidentStatus != nil {
+			entry.Kind = timelineKindStatusChange
+			entry.NewStatus = update.NewIncidentStatus.Name
+		}
+		entries = append(entries, entry)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].At.Before(entries[j].At) })
+	return entries
+}
@@ -0,0 +1,188 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const timelineIncidentID = "01HXYZTIMELINE000000000000"
+
+// newTimelineTool serves an incident with two timestamps and three updates
+// split across two pages, deliberately out of order
+func newTimelineTool(t *testing.T) *GetIncidentTimelineTool {
+	t.Helper()
+
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/incidents/123":
+			fmt.Fprintf(w, `{"incident": {
+				"id": %q,
+				"reference": "INC-123",
+				"name": "Checkout errors",
+				"incident_timestamp_values": [
+					{"incident_timestamp": {"id": "ts_2", "name": "Resolved at"}, "value": {"value": "2024-12-01T11:15:00Z"}},
+					{"incident_timestamp": {"id": "ts_1", "name": "Reported at"}, "value": {"value": "2024-12-01T09:00:00Z"}},
+					{"incident_timestamp": {"id": "ts_3", "name": "Impact started"}}
+				]
+			}}`, timelineIncidentID)
+		case "/incident_updates":
+			if got := r.URL.Query().Get("incident_id"); got != timelineIncidentID {
+				t.Errorf("expected updates for %s, got %q", timelineIncidentID, got)
+			}
+			if r.URL.Query().Get("after") == "" {
+				fmt.Fprint(w, `{"incident_updates": [
+					{"id": "upd_3", "message": "Fixed", "created_at": "2024-12-01T11:00:00Z", "author": {"name": "Ada Lovelace"},
+						"new_incident_status": {"name": "Monitoring", "category": "live"}},
+					{"id": "upd_2", "message": "Raising severity", "created_at": "2024-12-01T10:00:00Z", "author": {"email": "grace@example.com"},
+						"new_severity": {"name": "Critical"}}
+				], "pagination_meta": {"after": "upd_2", "page_size": 2}}`)
+				return
+			}
+			fmt.Fprint(w, `{"incident_updates": [
+				{"id": "upd_1", "message": "Investigating", "created_at": "2024-12-01T09:30:00Z"}
+			], "pagination_meta": {"page_size": 2}}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	tool := NewGetIncidentTimelineTool(client)
+	tool.now = func() time.Time { return time.Date(2024, 12, 1, 12, 0, 0, 0, time.UTC) }
+	return tool
+}
+
+type timelineResult struct {
+	IncidentID string                  `json:"incident_id"`
+	Timeline   []incidentTimelineEntry `json:"timeline"`
+	Truncated  bool                    `json:"truncated"`
+}
+
+func executeTimeline(t *testing.T, args map[string]interface{}) timelineResult {
+	t.Helper()
+
+	result, err := newTimelineTool(t).Execute(context.Background(), args)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var parsed timelineResult
+	if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+		t.Fatalf("failed to parse result: %v", err)
+	}
+	return parsed
+}
+
+func timelineMessages(entries []incidentTimelineEntry) []string {
+	messages := make([]string, len(entries))
+	for i, entry := range entries {
+		messages[i] = entry.Message
+	}
+	return messages
+}
+
+func TestGetIncidentTimelineTool_ChronologicalOrder(t *testing.T) {
+	result := executeTimeline(t, map[string]interface{}{"incident_id": "INC-123"})
+
+	if result.IncidentID != timelineIncidentID {
+		t.Errorf("expected incident %s, got %s", timelineIncidentID, result.IncidentID)
+	}
+	expected := []string{"Reported at", "Investigating", "Raising severity", "Fixed", "Resolved at"}
+	if got := timelineMessages(result.Timeline); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected entries %v, got %v", expected, got)
+	}
+	for i := 1; i < len(result.Timeline); i++ {
+		if result.Timeline[i].At.Before(result.Timeline[i-1].At) {
+			t.Errorf("entry %d at %s is before entry %d at %s", i, result.Timeline[i].At, i-1, result.Timeline[i-1].At)
+		}
+	}
+
+	severity, status := result.Timeline[2], result.Timeline[3]
+	if severity.Kind != timelineKindSeverityChange || severity.NewSeverity != "Critical" || severity.Actor != "grace@example.com" {
+		t.Errorf("unexpected severity change entry: %+v", severity)
+	}
+	if status.Kind != timelineKindStatusChange || status.NewStatus != "Monitoring" || status.Actor != "Ada Lovelace" {
+		t.Errorf("unexpected status change entry: %+v", status)
+	}
+	if result.Timeline[0].Kind != timelineKindTimestamp || result.Timeline[1].Kind != timelineKindUpdate {
+		t.Errorf("unexpected entry kinds: %+v", result.Timeline[:2])
+	}
+	if result.Truncated {
+		t.Error("expected the timeline not to be truncated")
+	}
+}
+
+func TestGetIncidentTimelineTool_Since(t *testing.T) {
+	tests := []struct {
+		name     string
+		since    string
+		expected []string
+	}{
+		{
+			name:     "RFC 3339 time is inclusive",
+			since:    "2024-12-01T10:00:00Z",
+			expected: []string{"Raising severity", "Fixed", "Resolved at"},
+		},
+		{
+			name:     "relative to now",
+			since:    "now-90m",
+			expected: []string{"Fixed", "Resolved at"},
+		},
+		{
+			name:     "after every entry",
+			since:    "2024-12-02",
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := executeTimeline(t, map[string]interface{}{"incident_id": "INC-123", "since": tt.since})
+			if got := timelineMessages(result.Timeline); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected entries %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestGetIncidentTimelineTool_Limit(t *testing.T) {
+	result := executeTimeline(t, map[string]interface{}{
+		"incident_id": "INC-123",
+		"since":       "2024-12-01T09:15:00Z",
+		"limit":       float64(2),
+	})
+
+	expected := []string{"Investigating", "Raising severity"}
+	if got := timelineMessages(result.Timeline); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected entries %v, got %v", expected, got)
+	}
+	if !result.Truncated {
+		t.Error("expected the timeline to be truncated")
+	}
+}
+
+func TestGetIncidentTimelineTool_InvalidArguments(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    map[string]interface{}
+		wantErr string
+	}{
+		{"missing incident_id", map[string]interface{}{}, "incident_id parameter is required"},
+		{"limit too large", map[string]interface{}{"incident_id": "INC-123", "limit": float64(5000)}, "limit must be between 1 and 1000"},
+		{"invalid since", map[string]interface{}{"incident_id": "INC-123", "since": "last tuesday"}, "invalid since 'last tuesday'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newTimelineTool(t).Execute(context.Background(), tt.args)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
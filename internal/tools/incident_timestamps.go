@@ -0,0 +1,163 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+// SetIncidentTimestampsTool sets timestamp values such as "Impact started"
+// or "Resolved at" on an incident
+type SetIncidentTimestampsTool struct {
+	client *incidentio.Client
+}
+
+func NewSetIncidentTimestampsTool(client *incidentio.Client) *SetIncidentTimestampsTool {
+	return &SetIncidentTimestampsTool{client: client}
+}
+
+func (t *SetIncidentTimestampsTool) Name() string {
+	return "set_incident_timestamps"
+}
+
+func (t *SetIncidentTimestampsTool) Description() string {
+	return `Set timestamp values on an incident, such as when impact started or when it was detected.
+
+USAGE WORKFLOW:
+1. Get the incident ID from list_incidents or get_incident
+2. Call this tool with a map of timestamp name or ID to an ISO 8601 value
+3. Current timestamp values are shown in get_incident under incident_timestamp_values
+
+PARAMETERS:
+- incident_id: Required. The incident ID to update
+- timestamps: Required. Map of timestamp name (case-insensitive) or ID to an ISO 8601 value
+
+EXAMPLES:
+- Set impact start: {"incident_id": "01HXYZ...", "timestamps": {"Impact started": "2024-12-01T09:30:00Z"}}
+- Set several: {"incident_id": "01HXYZ...", "timestamps": {"Reported at": "2024-12-01T09:35:00Z", "Fixed at": "2024-12-01T11:00:00+01:00"}}
+
+IMPORTANT: Timestamp names are validated against the timestamps configured in your organization, and values must include a time zone.`
+}
+
+func (t *SetIncidentTimestampsTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"incident_id": map[string]interface{}{
+				"type":        "string",
+				"description": "The incident ID to update",
+			},
+			"timestamps": map[string]interface{}{
+				"type":        "object",
+				"description": "Map of timestamp name or ID to an ISO 8601 value, e.g. {\"Impact started\": \"2024-12-01T09:30:00Z\"}",
+				"additionalProperties": map[string]interface{}{
+					"type":   "string",
+					"format": "date-time",
+				},
+			},
+		},
+		"required":             []interface{}{"incident_id", "timestamps"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *SetIncidentTimestampsTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	incidentID, ok := args["incident_id"].(string)
+	if !ok || incidentID == "" {
+		return "", fmt.Errorf("incident_id parameter is required")
+	}
+
+	timestamps, ok := args["timestamps"].(map[string]interface{})
+	if !ok || len(timestamps) == 0 {
+		return "", fmt.Errorf("timestamps parameter is required and must map at least one timestamp to a value")
+	}
+
+	definitions, err := t.client.ListIncidentTimestamps(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to list incident timestamps: %w", err)
+	}
+
+	values, err := buildIncidentTimestampValues(definitions.IncidentTimestamps, timestamps)
+	if err != nil {
+		return "", err
+	}
+
+	incident, err := t.client.UpdateIncident(ctx, incidentID, &incidentio.UpdateIncidentRequest{
+		IncidentTimestampValues: values,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	result, err := json.MarshalIndent(map[string]interface{}{
+		"message":                   fmt.Sprintf("Set %d timestamp(s) on incident %s", len(values), incident.Name),
+		"incident_id":               incident.ID,
+		"incident_timestamp_values": incident.IncidentTimestampValues,
+	}, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to format response: %w", err)
+	}
+
+	return string(result), nil
+}
+
+// buildIncidentTimestampValues resolves timestamp names or IDs against the
+// organization's definitions and parses each value as RFC 3339
+func buildIncidentTimestampValues(definitions []incidentio.IncidentTimestamp, input map[string]interface{}) ([]incidentio.IncidentTimestampValueRequest, error) {
+	keys := make([]string, 0, len(input))
+	for key := range input {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	values := make([]incidentio.IncidentTimestampValueRequest, 0, len(keys))
+	for _, key := range keys {
+		definition, ok := findIncidentTimestamp(definitions, key)
+		if !ok {
+			return nil, fmt.Errorf("unknown incident timestamp '%s'. Available timestamps: %s", key, formatAvailableTimestamps(definitions))
+		}
+
+		raw, ok := input[key].(string)
+		if !ok {
+			return nil, fmt.Errorf("value for timestamp '%s' must be an ISO 8601 string", key)
+		}
+		value, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value '%s' for timestamp '%s': expected ISO 8601 with a time zone, e.g. 2024-12-01T09:30:00Z", raw, key)
+		}
+
+		values = append(values, incidentio.IncidentTimestampValueRequest{
+			IncidentTimestampID: definition.ID,
+			Value:               value.UTC().Format(time.RFC3339),
+		})
+	}
+
+	return values, nil
+}
+
+// findIncidentTimestamp matches a timestamp by ID, or by name ignoring case
+func findIncidentTimestamp(definitions []incidentio.IncidentTimestamp, key string) (incidentio.IncidentTimestamp, bool) {
+	for _, definition := range definitions {
+		if definition.ID == key || strings.EqualFold(definition.Name, key) {
+			return definition, true
+		}
+	}
+	return incidentio.IncidentTimestamp{}, false
+}
+
+func formatAvailableTimestamps(definitions []incidentio.IncidentTimestamp) string {
+	if len(definitions) == 0 {
+		return "none"
+	}
+
+	names := make([]string, len(definitions))
+	for i, definition := range definitions {
+		names[i] = fmt.Sprintf("%s (%s)", definition.Name, definition.ID)
+	}
+	return strings.Join(names, ", ")
+}
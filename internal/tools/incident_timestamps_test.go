@@ -0,0 +1,106 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+// newIncidentTimestampsTestClient serves timestamp definitions and records the
+// timestamp values sent with every edit request
+func newIncidentTimestampsTestClient(t *testing.T, sent *[]incidentio.IncidentTimestampValueRequest) *incidentio.Client {
+	t.Helper()
+
+	return newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/incident_timestamps":
+			fmt.Fprint(w, `{"incident_timestamps": [
+				{"id": "ts_impact", "name": "Impact started", "rank": 1},
+				{"id": "ts_reported", "name": "Reported at", "rank": 2}
+			]}`)
+		case "/incidents/inc_1/actions/edit":
+			var body struct {
+				Incident struct {
+					IncidentTimestampValues []incidentio.IncidentTimestampValueRequest `json:"incident_timestamp_values"`
+				} `json:"incident"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode request body: %v", err)
+			}
+			*sent = append(*sent, body.Incident.IncidentTimestampValues...)
+			fmt.Fprint(w, `{"incident": {
+				"id": "inc_1",
+				"name": "Database outage",
+				"incident_timestamp_values": [
+					{"incident_timestamp": {"id": "ts_impact", "name": "Impact started", "rank": 1}, "value": {"value": "2024-12-01T08:30:00Z"}}
+				]
+			}}`)
+		default:
+			t.Errorf("unexpected request: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+}
+
+func TestSetIncidentTimestampsTool_Execute(t *testing.T) {
+	t.Run("sets a valid timestamp by name", func(t *testing.T) {
+		var sent []incidentio.IncidentTimestampValueRequest
+		tool := NewSetIncidentTimestampsTool(newIncidentTimestampsTestClient(t, &sent))
+
+		result, err := tool.Execute(context.Background(), map[string]interface{}{
+			"incident_id": "inc_1",
+			"timestamps":  map[string]interface{}{"impact started": "2024-12-01T09:30:00+01:00"},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		expected := incidentio.IncidentTimestampValueRequest{IncidentTimestampID: "ts_impact", Value: "2024-12-01T08:30:00Z"}
+		if len(sent) != 1 || sent[0] != expected {
+			t.Errorf("expected timestamp values [%+v], got %+v", expected, sent)
+		}
+		if !strings.Contains(result, "Set 1 timestamp(s)") || !strings.Contains(result, "2024-12-01T08:30:00Z") {
+			t.Errorf("expected result to describe the new value, got: %s", result)
+		}
+	})
+
+	t.Run("rejects an unknown timestamp name", func(t *testing.T) {
+		var sent []incidentio.IncidentTimestampValueRequest
+		tool := NewSetIncidentTimestampsTool(newIncidentTimestampsTestClient(t, &sent))
+
+		_, err := tool.Execute(context.Background(), map[string]interface{}{
+			"incident_id": "inc_1",
+			"timestamps":  map[string]interface{}{"Detected at": "2024-12-01T09:30:00Z"},
+		})
+		if err == nil {
+			t.Fatal("expected error for unknown timestamp")
+		}
+		if !strings.Contains(err.Error(), "unknown incident timestamp 'Detected at'") || !strings.Contains(err.Error(), "Impact started (ts_impact)") {
+			t.Errorf("expected error listing available timestamps, got: %v", err)
+		}
+		if len(sent) != 0 {
+			t.Errorf("expected no edit requests, got %+v", sent)
+		}
+	})
+
+	t.Run("rejects an unparseable date", func(t *testing.T) {
+		var sent []incidentio.IncidentTimestampValueRequest
+		tool := NewSetIncidentTimestampsTool(newIncidentTimestampsTestClient(t, &sent))
+
+		_, err := tool.Execute(context.Background(), map[string]interface{}{
+			"incident_id": "inc_1",
+			"timestamps":  map[string]interface{}{"ts_reported": "yesterday"},
+		})
+		if err == nil || !strings.Contains(err.Error(), "invalid value 'yesterday'") {
+			t.Fatalf("expected invalid value error, got: %v", err)
+		}
+		if len(sent) != 0 {
+			t.Errorf("expected no edit requests, got %+v", sent)
+		}
+	})
+}
@@ -1,7 +1,7 @@
 package tools
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 
 	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
@@ -39,14 +39,16 @@ IMPORTANT: Incident type IDs from this tool are required for the create_incident
 
 func (t *ListIncidentTypesTool) InputSchema() map[string]interface{} {
 	return map[string]interface{}{
-		"type":                 "object",
-		"properties":           map[string]interface{}{},
+		"type": "object",
+		"properties": map[string]interface{}{
+			"fields": fieldsProperty(),
+		},
 		"additionalProperties": false,
 	}
 }
 
-func (t *ListIncidentTypesTool) Execute(args map[string]interface{}) (string, error) {
-	result, err := t.client.ListIncidentTypes()
+func (t *ListIncidentTypesTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	result, err := t.client.ListIncidentTypes(ctx)
 	if err != nil {
 		return "", fmt.Errorf("failed to list incident types: %w", err)
 	}
@@ -66,11 +68,5 @@ func (t *ListIncidentTypesTool) Execute(args map[string]interface{}) (string, er
 		output += "\n"
 	}
 
-	// Also return the raw JSON
-	jsonOutput, err := json.MarshalIndent(result, "", "  ")
-	if err != nil {
-		return output, nil
-	}
-
-	return output + "\nRaw JSON:\n" + string(jsonOutput), nil
+	return withRawJSON(output, result, args)
 }
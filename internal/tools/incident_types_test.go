@@ -0,0 +1,89 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestListIncidentTypesTool_Schema(t *testing.T) {
+	tool := &ListIncidentTypesTool{}
+
+	if tool.Name() != "list_incident_types" {
+		t.Errorf("expected name 'list_incident_types', got %s", tool.Name())
+	}
+
+	schema := tool.InputSchema()
+	if schema["type"] != "object" {
+		t.Error("schema type should be 'object'")
+	}
+	properties := schema["properties"].(map[string]interface{})
+	if _, ok := properties["fields"]; !ok || len(properties) != 1 {
+		t.Errorf("expected only the fields parameter, got %v", properties)
+	}
+}
+
+func TestListIncidentTypesTool_Execute(t *testing.T) {
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/incident_types" {
+			t.Errorf("unexpected request: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `{"incident_types": [
+			{"id": "type_default", "name": "Default", "description": "Standard incidents", "is_default": true},
+			{"id": "type_security", "name": "Security", "private_incidents_only": true}
+		]}`)
+	})
+
+	result, err := NewListIncidentTypesTool(client).Execute(context.Background(), map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, expected := range []string{
+		"Found 2 incident types",
+		"ID: type_default", "Name: Default", "Default: Yes",
+		"ID: type_security", "Name: Security",
+		`"private_incidents_only": true`,
+	} {
+		if !strings.Contains(result, expected) {
+			t.Errorf("expected output to contain %q, got:\n%s", expected, result)
+		}
+	}
+}
+
+func TestListIncidentTypesTool_Fields(t *testing.T) {
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"incident_types": [
+			{"id": "type_default", "name": "Default", "description": "Standard incidents", "is_default": true},
+			{"id": "type_security", "name": "Security", "private_incidents_only": true}
+		]}`)
+	})
+
+	result, err := NewListIncidentTypesTool(client).Execute(context.Background(), map[string]interface{}{
+		"fields": "id,name",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// With fields requested only the filtered JSON is returned
+	var parsed struct {
+		IncidentTypes []map[string]interface{} `json:"incident_types"`
+	}
+	if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+		t.Fatalf("expected JSON result, got %v: %s", err, result)
+	}
+	if len(parsed.IncidentTypes) != 2 {
+		t.Fatalf("expected 2 incident types, got %d", len(parsed.IncidentTypes))
+	}
+	for _, incidentType := range parsed.IncidentTypes {
+		if len(incidentType) != 2 || incidentType["id"] == nil || incidentType["name"] == nil {
+			t.Errorf("expected each incident type to have only id and name, got %v", incidentType)
+		}
+	}
+}
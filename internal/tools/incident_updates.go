@@ -1,8 +1,10 @@
 package tools
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
 )
@@ -31,11 +33,13 @@ USAGE WORKFLOW:
 PARAMETERS:
 - incident_id: Optional. Filter updates by specific incident ID
 - page_size: Number of results (default 25, max 250)
+- after: Optional. Pagination cursor from a previous response's pagination_meta.after
 
 EXAMPLES:
 - List all updates: {}
 - List for incident: {"incident_id": "01HXYZ..."}
-- Paginated list: {"incident_id": "01HXYZ...", "page_size": 50}`
+- Paginated list: {"incident_id": "01HXYZ...", "page_size": 50}
+- Next page: {"incident_id": "01HXYZ...", "after": "01HABC..."}`
 }
 
 func (t *ListIncidentUpdatesTool) InputSchema() map[string]interface{} {
@@ -51,12 +55,17 @@ func (t *ListIncidentUpdatesTool) InputSchema() map[string]interface{} {
 				"description": "Number of results per page (max 250)",
 				"default":     25,
 			},
+			"after": map[string]interface{}{
+				"type":        "string",
+				"description": "Pagination cursor from a previous response",
+			},
+			"fields": fieldsProperty(),
 		},
 		"additionalProperties": false,
 	}
 }
 
-func (t *ListIncidentUpdatesTool) Execute(args map[string]interface{}) (string, error) {
+func (t *ListIncidentUpdatesTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
 	opts := &incidentio.ListIncidentUpdatesOptions{}
 
 	if incidentID, ok := args["incident_id"].(string); ok {
@@ -65,18 +74,16 @@ func (t *ListIncidentUpdatesTool) Execute(args map[string]interface{}) (string,
 	if pageSize, ok := args["page_size"].(float64); ok {
 		opts.PageSize = int(pageSize)
 	}
-
-	resp, err := t.client.ListIncidentUpdates(opts)
-	if err != nil {
-		return "", err
+	if after, ok := args["after"].(string); ok {
+		opts.After = after
 	}
 
-	result, err := json.MarshalIndent(resp, "", "  ")
+	resp, err := t.client.ListIncidentUpdates(ctx, opts)
 	if err != nil {
-		return "", fmt.Errorf("failed to format response: %w", err)
+		return "", err
 	}
 
-	return string(result), nil
+	return formatJSONResult(resp, args)
 }
 
 // GetIncidentUpdateTool gets a specific incident update
@@ -115,29 +122,25 @@ func (t *GetIncidentUpdateTool) InputSchema() map[string]interface{} {
 				"type":        "string",
 				"description": "The incident update ID",
 			},
+			"fields": fieldsProperty(),
 		},
 		"required":             []interface{}{"id"},
 		"additionalProperties": false,
 	}
 }
 
-func (t *GetIncidentUpdateTool) Execute(args map[string]interface{}) (string, error) {
+func (t *GetIncidentUpdateTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
 	id, ok := args["id"].(string)
 	if !ok || id == "" {
 		return "", fmt.Errorf("id parameter is required")
 	}
 
-	update, err := t.client.GetIncidentUpdate(id)
+	update, err := t.client.GetIncidentUpdate(ctx, id)
 	if err != nil {
 		return "", err
 	}
 
-	result, err := json.MarshalIndent(update, "", "  ")
-	if err != nil {
-		return "", fmt.Errorf("failed to format response: %w", err)
-	}
-
-	return string(result), nil
+	return formatJSONResult(update, args)
 }
 
 // CreateIncidentUpdateTool creates a new incident update
@@ -157,17 +160,20 @@ func (t *CreateIncidentUpdateTool) Description() string {
 	return `Create a new incident update (status message) to communicate progress during an incident.
 
 USAGE WORKFLOW:
-1. Get incident ID from list_incidents or get_incident
+1. Identify the incident by ID, reference or Slack channel
 2. Compose status message describing current state or actions taken
 3. Post update to incident timeline and notifications
 
 PARAMETERS:
-- incident_id: Required. The incident ID to post update to
+- incident_id: Required. The incident to post the update to. Accepts the same formats as get_incident: full ID, reference (INC-123 or 123), Slack channel ID or Slack channel name
 - message: Required. The status message text
 
 EXAMPLES:
 - Post update: {"incident_id": "01HXYZ...", "message": "Database failover completed. Services recovering."}
-- Brief update: {"incident_id": "01HXYZ...", "message": "Investigating root cause"}`
+- By reference: {"incident_id": "INC-123", "message": "Investigating root cause"}
+- By Slack channel: {"incident_id": "20251020-aws-outage-ci-impaired", "message": "Mitigation deployed"}
+
+Returns the created update including its author and timestamps.`
 }
 
 func (t *CreateIncidentUpdateTool) InputSchema() map[string]interface{} {
@@ -176,7 +182,7 @@ func (t *CreateIncidentUpdateTool) InputSchema() map[string]interface{} {
 		"properties": map[string]interface{}{
 			"incident_id": map[string]interface{}{
 				"type":        "string",
-				"description": "The incident ID to post the update to",
+				"description": "Incident to post the update to: full ID, reference (INC-123 or 123), Slack channel ID, or Slack channel name",
 			},
 			"message": map[string]interface{}{
 				"type":        "string",
@@ -188,23 +194,28 @@ func (t *CreateIncidentUpdateTool) InputSchema() map[string]interface{} {
 	}
 }
 
-func (t *CreateIncidentUpdateTool) Execute(args map[string]interface{}) (string, error) {
+func (t *CreateIncidentUpdateTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
 	incidentID, ok := args["incident_id"].(string)
 	if !ok || incidentID == "" {
 		return "", fmt.Errorf("incident_id parameter is required")
 	}
 
 	message, ok := args["message"].(string)
-	if !ok || message == "" {
+	if !ok || strings.TrimSpace(message) == "" {
 		return "", fmt.Errorf("message parameter is required")
 	}
 
+	incidentID, err := resolveIncidentID(ctx, t.client, incidentID)
+	if err != nil {
+		return "", err
+	}
+
 	req := &incidentio.CreateIncidentUpdateRequest{
 		IncidentID: incidentID,
 		Message:    message,
 	}
 
-	update, err := t.client.CreateIncidentUpdate(req)
+	update, err := t.client.CreateIncidentUpdate(ctx, req)
 	if err != nil {
 		return "", err
 	}
@@ -259,13 +270,13 @@ func (t *DeleteIncidentUpdateTool) InputSchema() map[string]interface{} {
 	}
 }
 
-func (t *DeleteIncidentUpdateTool) Execute(args map[string]interface{}) (string, error) {
+func (t *DeleteIncidentUpdateTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
 	id, ok := args["id"].(string)
 	if !ok || id == "" {
 		return "", fmt.Errorf("id parameter is required")
 	}
 
-	if err := t.client.DeleteIncidentUpdate(id); err != nil {
+	if err := t.client.DeleteIncidentUpdate(ctx, id); err != nil {
 		return "", err
 	}
 
@@ -0,0 +1,140 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+const incidentUpdatesTestIncidentID = "01HXYZABCDEFGHJKMNPQRSTVWX"
+
+// newIncidentUpdatesTestClient serves a single incident with reference INC-42
+// and Slack channel 20241201-db-outage, and records the body of every posted update
+func newIncidentUpdatesTestClient(t *testing.T, posted *[]map[string]interface{}) *incidentio.Client {
+	t.Helper()
+
+	incident := fmt.Sprintf(`{"id": %q, "reference": "INC-42", "slack_channel_id": "C0123ABCD", "slack_channel_name": "20241201-db-outage"}`, incidentUpdatesTestIncidentID)
+
+	return newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/incident_updates":
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode request body: %v", err)
+			}
+			*posted = append(*posted, body)
+			fmt.Fprintf(w, `{"incident_update": {
+				"id": "upd_1",
+				"incident_id": %q,
+				"message": %q,
+				"author": {"id": "user_1", "name": "Jane Doe"},
+				"created_at": "2024-12-01T10:00:00Z",
+				"updated_at": "2024-12-01T10:00:00Z"
+			}}`, body["incident_id"], body["message"])
+		case r.URL.Path == "/incidents":
+			fmt.Fprintf(w, `{"incidents": [%s], "pagination_meta": {"page_size": 250}}`, incident)
+		case r.URL.Path == "/incidents/42" || r.URL.Path == "/incidents/"+incidentUpdatesTestIncidentID:
+			fmt.Fprintf(w, `{"incident": %s}`, incident)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error": {"message": "not found"}}`)
+		}
+	})
+}
+
+func TestCreateIncidentUpdateTool_Execute(t *testing.T) {
+	tests := []struct {
+		name       string
+		identifier string
+	}{
+		{name: "incident ID", identifier: incidentUpdatesTestIncidentID},
+		{name: "reference", identifier: "INC-42"},
+		{name: "Slack channel ID", identifier: "C0123ABCD"},
+		{name: "Slack channel name", identifier: "20241201-db-outage"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var posted []map[string]interface{}
+			tool := NewCreateIncidentUpdateTool(newIncidentUpdatesTestClient(t, &posted))
+
+			result, err := tool.Execute(context.Background(), map[string]interface{}{
+				"incident_id": tt.identifier,
+				"message":     "Database failover completed",
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(posted) != 1 {
+				t.Fatalf("expected 1 posted update, got %d", len(posted))
+			}
+			if posted[0]["message"] != "Database failover completed" {
+				t.Errorf("expected message in request body, got %v", posted[0]["message"])
+			}
+			if posted[0]["incident_id"] != incidentUpdatesTestIncidentID {
+				t.Errorf("expected incident_id %s in request body, got %v", incidentUpdatesTestIncidentID, posted[0]["incident_id"])
+			}
+
+			var update incidentio.IncidentUpdate
+			if err := json.Unmarshal([]byte(result), &update); err != nil {
+				t.Fatalf("failed to parse result: %v", err)
+			}
+			if update.Author == nil || update.Author.Name != "Jane Doe" {
+				t.Errorf("expected author in result, got %+v", update.Author)
+			}
+			if update.CreatedAt.IsZero() {
+				t.Error("expected created_at in result")
+			}
+		})
+	}
+}
+
+func TestCreateIncidentUpdateTool_RejectsEmptyMessage(t *testing.T) {
+	for _, message := range []interface{}{nil, "", "   "} {
+		var posted []map[string]interface{}
+		tool := NewCreateIncidentUpdateTool(newIncidentUpdatesTestClient(t, &posted))
+
+		args := map[string]interface{}{"incident_id": incidentUpdatesTestIncidentID}
+		if message != nil {
+			args["message"] = message
+		}
+
+		if _, err := tool.Execute(context.Background(), args); err == nil {
+			t.Errorf("expected error for message %q", message)
+		}
+		if len(posted) != 0 {
+			t.Errorf("expected no update to be posted for message %q", message)
+		}
+	}
+}
+
+func TestListIncidentUpdatesTool_Pagination(t *testing.T) {
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("incident_id") != "inc_1" || query.Get("after") != "upd_2" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"incident_updates": [{"id": "upd_3", "incident_id": "inc_1", "message": "Resolved"}], "pagination_meta": {"page_size": 25}}`)
+	})
+
+	result, err := NewListIncidentUpdatesTool(client).Execute(context.Background(), map[string]interface{}{
+		"incident_id": "inc_1",
+		"after":       "upd_2",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var response incidentio.ListIncidentUpdatesResponse
+	if err := json.Unmarshal([]byte(result), &response); err != nil {
+		t.Fatalf("failed to parse result: %v", err)
+	}
+	if len(response.IncidentUpdates) != 1 || response.IncidentUpdates[0].ID != "upd_3" {
+		t.Errorf("expected upd_3, got %+v", response.IncidentUpdates)
+	}
+}
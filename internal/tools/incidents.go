@@ -1,9 +1,14 @@
 package tools
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"math"
+	"regexp"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
@@ -12,10 +17,26 @@ import (
 // ListIncidentsTool lists incidents from incident.io
 type ListIncidentsTool struct {
 	client *incidentio.Client
+	// pause waits between pages when auto-pagination is rate limited.
+	// Tests replace it to avoid real delays.
+	pause func(ctx context.Context, d time.Duration) error
+	// now resolves relative date filters such as "now-7d". Tests replace it
+	// with a fixed clock.
+	now func() time.Time
+	// defaults apply when the arguments omit page_size or fields
+	defaults listIncidentsDefaults
 }
 
 func NewListIncidentsTool(client *incidentio.Client) *ListIncidentsTool {
-	return &ListIncidentsTool{client: client}
+	tool := newListIncidentsTool(client)
+	tool.defaults = listIncidentsDefaultsFromEnv()
+	return tool
+}
+
+// newListIncidentsTool returns a ListIncidentsTool with the built-in
+// defaults, for tools that reuse its filtering and pagination
+func newListIncidentsTool(client *incidentio.Client) *ListIncidentsTool {
+	return &ListIncidentsTool{client: client, pause: pauseContext, now: time.Now}
 }
 
 func (t *ListIncidentsTool) Name() string {
@@ -42,7 +63,7 @@ USAGE:
 5. For manual pagination, use 'after' parameter with the value from pagination_meta.after in previous response
 
 PARAMETERS:
-- page_size: Number of results (default 25, max 250). Set to 0 or omit for auto-pagination.
+- page_size: Number of results (max 250). Set to 0 for auto-pagination. When omitted, the server's configured default is used, which is auto-pagination unless set.
 - after: The incident ID to start pagination after. Use the exact value from pagination_meta.after in previous response.
 - status: Status values in array OR comma-separated string format. Accepts friendly aliases OR direct API categories:
   * Format: Array ["active", "triage"] OR comma-separated string "active,triage,learning"
@@ -58,11 +79,16 @@ PARAMETERS:
   * By ID: "01K56QEGAD95K9K5ZQ9CCPF6EF" (full UUID format)
   * Invalid severities will return helpful error with all available options
   * Examples: ["Critical"], ["sev_1", "sev_2"], "Critical,High"
+- severity_gte / severity_lte: Minimum / maximum severity by rank, as a name or ID
+  * Example: {"severity_gte": "Major"} matches Major and anything more severe, such as Critical
+- mode: Incident mode in array OR comma-separated string format: standard, retrospective, tutorial
+  * Multiple values match any of them (OR logic)
+  * Example: ["standard"] to exclude retrospective and tutorial incidents
 - fields: Comma-separated list of fields to include in response (reduces context usage)
   * Top-level: "id,name,summary,reference"
   * Nested: "severity.name,incident_status.category,incident_type.name"
   * Default: "id,reference,name,permalink,created_at,updated_at,slack_channel_id"
-  * Omit or leave empty to use default fields
+  * Omit or leave empty to use default fields (the server's configured default, or id, reference, name, permalink, created_at, updated_at, slack_channel_id)
 - created_at_gte: Filter incidents created on or after this date (ISO 8601 format)
   * Example: "2024-12-01" or "2024-12-01T00:00:00Z"
   * Useful for finding incidents created since a specific date
@@ -81,6 +107,37 @@ PARAMETERS:
 - updated_at_range: Filter incidents updated within a date range (tilde-separated dates)
   * Example: "2024-12-01~2024-12-31"
   * More efficient than using both gte and lte for date ranges
+- Dates may be a date ("2024-12-01"), an RFC 3339 time, or relative to the current time:
+  "today", "yesterday", "now", or "now-" followed by a number of minutes (m), hours (h),
+  days (d) or weeks (w), e.g. "now-24h" or "now-7d". Prefer relative dates over computing
+  them yourself. Times are converted to UTC.
+  Invalid dates and ranges that end before they start are rejected.
+- timezone: IANA time zone for date-only filters, e.g. "America/Los_Angeles" (default UTC)
+  * A date covers that whole day in the zone: gte from its start, lte to its end
+- custom_field_id, custom_field_value: Only incidents whose select custom field is set to one of these options
+  * custom_field_id accepts the field's ID or name
+  * custom_field_value accepts option IDs or labels, e.g. "Engineering"; labels are mapped to option IDs
+  * Unknown labels return an error listing the field's options
+- sort: Order results by "created_at", "updated_at" or "severity_rank"
+  * Append the direction to set both at once, e.g. "created_at.asc" or "updated_at.desc"
+  * created_at is sorted by the API
+  * updated_at and severity_rank are sorted CLIENT-SIDE after fetching, so they only
+    order the whole result set with auto-pagination; with page_size set they order the current page only
+  * severity_rank orders by the severity's rank value; incidents without a severity sort last
+- sort_direction: "asc" or "desc" (default "desc")
+- auto_paginate: Set to true to follow pagination_meta.after internally and return every matching incident in one response
+  * Uses page_size as the per-request page size (default 250)
+  * Stops after max_results incidents and sets truncated=true if more incidents matched
+  * If incident.io rate limits a page, waits for the Retry-After delay before requesting it again
+- max_results: Safety cap on incidents collected with auto_paginate (default 1000, max 10000)
+- include_latest_update: Set to true to embed each incident's most recent update as latest_update
+  * COST: makes one extra API call per incident, plus one per further 250 updates it has (up to 5 incidents in parallel)
+  * Capped at the first 50 incidents in the result; later incidents have latest_update set to null
+  * Combine with page_size (e.g. 25) to keep the number of extra calls small
+- output_format: "json" (default) or "csv"
+  * csv returns a header row plus one row per incident, with the fields (or the default fields) as columns in the order given
+  * Nested fields such as "severity.name" become their own column; "-" exclusions and "*" cannot be used
+  * pagination_meta and truncated are not included, so use json for manual pagination
 
 VALIDATION:
 - Status categories are validated against your org's incident.io configuration
@@ -98,6 +155,8 @@ PAGINATION:
   4. Next request: {"page_size": 25, "after": "<value from pagination_meta.after>"}
   5. Repeat until pagination_meta.after is empty (no more pages)
 - NOTE: total_record_count shows the total number of incidents matching your filters.
+- Prefer auto_paginate over manual pagination when you need every matching incident:
+  {"status": "closed", "auto_paginate": true} returns all pages at once with a truncated flag
 
 EXAMPLES:
 - List all active incidents (uses default fields): {"status": ["active"]} or {"status": "active"}
@@ -107,6 +166,8 @@ EXAMPLES:
 - List triaging and active (string): {"status": "triage,active,learning"}
 - List closed incidents: {"status": ["closed"]} or {"status": "closed"}
 - Comma-separated severities: {"severity": "Critical,High,Medium"}
+- Only real incidents (no tutorials or retrospectives): {"mode": ["standard"]}
+- Incidents affecting a team: {"custom_field_id": "Affected Team", "custom_field_value": "Engineering"}
 - List with custom fields: {"status": "active", "fields": "id,name,severity.name,incident_status.category"}
 - List incidents created after December 1st, 2024: {"created_at_gte": "2024-12-01"}
 - List incidents created before December 31st, 2024: {"created_at_lte": "2024-12-31"}
@@ -114,6 +175,11 @@ EXAMPLES:
 - List incidents updated in the last week: {"updated_at_gte": "2024-12-15"}
 - List active incidents from specific date range: {"status": "active", "created_at_range": "2024-12-01~2024-12-08"}
 - Manual pagination: {"page_size": 10, "after": "01K7RPHSXGPM1V07NPW8V6J6RZ"}
+- All closed incidents this year: {"status": "closed", "created_at_gte": "2024-01-01", "auto_paginate": true}
+- Oldest incidents first: {"sort": "created_at.asc"}
+- Active incidents by severity: {"status": "active", "sort": "severity_rank"}
+- Status board with latest updates: {"status": "active", "page_size": 25, "include_latest_update": true}
+- Spreadsheet export: {"status": "closed", "auto_paginate": true, "output_format": "csv", "fields": "reference,name,severity.name,created_at"}
 
 NOTE: Both status and severity are validated against live API data. If you receive an error about invalid values, the error message will list all available options for your organization.`
 }
@@ -124,23 +190,44 @@ func (t *ListIncidentsTool) InputSchema() map[string]interface{} {
 		"properties": map[string]interface{}{
 			"page_size": map[string]interface{}{
 				"type":        "integer",
-				"description": "Number of results per page (max 250, default 25). Set to 0 or omit for automatic pagination through all results.",
-				"default":     25,
+				"description": "Number of results per page (max 250). Set to 0 for automatic pagination through all results. When omitted, the server's configured default is used (automatic pagination unless set).",
 			},
 			"after": map[string]interface{}{
 				"type":        "string",
 				"description": "The incident ID to start pagination after. IMPORTANT: Use the EXACT value from pagination_meta.after field in the previous response (e.g., \"01K7RPHSXGPM1V07NPW8V6J6RZ\"). This tells the API to return incidents after this ID. Only used with manual pagination when page_size > 0.",
 			},
 			"status": map[string]interface{}{
-				"type":        "array",
+				"type":        []string{"array", "string"},
 				"items":       map[string]interface{}{"type": "string"},
 				"description": "Filter by incident status. Accepts BOTH array format [\"active\", \"triage\"] AND comma-separated string \"active,triage,learning\". Accepts aliases (\"active\" → \"live\", \"resolved\" → \"closed\") OR direct categories (live, triage, learning, closed, merged, declined, canceled, paused). Case-insensitive. Validated against your org's configuration. Invalid values return helpful errors with available options and aliases. Multiple values match any of them (OR logic). Examples: [\"active\"], [\"live\"], [\"triage\", \"active\"], \"active,triage,learning\"",
 			},
 			"severity": map[string]interface{}{
-				"type":        "array",
+				"type":        []string{"array", "string"},
 				"items":       map[string]interface{}{"type": "string"},
 				"description": "Filter by severity. Accepts BOTH array format [\"Critical\", \"High\"] AND comma-separated string \"Critical,High,Medium\". Accepts severity names (\"Critical\", \"High\", \"sev_1\", etc.) AND full IDs. Tool automatically maps names to IDs. Multiple values will match any of them (OR logic). Examples: [\"Critical\"], [\"sev_1\", \"sev_2\"], [\"Critical\", \"High\"], \"Critical,High\"",
 			},
+			"severity_gte": map[string]interface{}{
+				"type":        "string",
+				"description": "Only incidents at least this severe, by severity rank. Accepts a severity name or ID. Example: \"Major\" matches Major and Critical",
+			},
+			"severity_lte": map[string]interface{}{
+				"type":        "string",
+				"description": "Only incidents at most this severe, by severity rank. Accepts a severity name or ID. Example: \"Minor\" matches Minor and anything less severe",
+			},
+			"mode": map[string]interface{}{
+				"type":        []string{"array", "string"},
+				"items":       map[string]interface{}{"type": "string", "enum": incidentModes},
+				"description": "Filter by incident mode (standard, retrospective, tutorial). Accepts an array or a comma-separated string. Multiple values match any of them (OR logic).",
+			},
+			"custom_field_id": map[string]interface{}{
+				"type":        "string",
+				"description": "Filter by a select custom field, by ID or name. Requires custom_field_value.",
+			},
+			"custom_field_value": map[string]interface{}{
+				"type":        []string{"array", "string"},
+				"items":       map[string]interface{}{"type": "string"},
+				"description": "Options of custom_field_id to match, by option ID or label (e.g. \"Engineering\"). Accepts an array or a comma-separated string. Multiple values match any of them (OR logic).",
+			},
 			"fields": map[string]interface{}{
 				"type":        "string",
 				"description": GetIncidentFieldsDescription(),
@@ -148,43 +235,355 @@ func (t *ListIncidentsTool) InputSchema() map[string]interface{} {
 			},
 			"created_at_gte": map[string]interface{}{
 				"type":        "string",
-				"description": "Filter incidents created on or after this date (ISO 8601 format). Example: \"2024-12-01\" or \"2024-12-01T00:00:00Z\"",
+				"description": "Filter incidents created on or after this date (ISO 8601 format). Example: \"2024-12-01\" or \"2024-12-01T00:00:00Z\", or relative: \"now-7d\", \"today\"",
 			},
 			"created_at_lte": map[string]interface{}{
 				"type":        "string",
-				"description": "Filter incidents created on or before this date (ISO 8601 format). Example: \"2024-12-31\" or \"2024-12-31T23:59:59Z\"",
+				"description": "Filter incidents created on or before this date (ISO 8601 format). Example: \"2024-12-31\" or \"2024-12-31T23:59:59Z\", or relative: \"now-7d\", \"today\"",
 			},
 			"created_at_range": map[string]interface{}{
 				"type":        "string",
-				"description": "Filter incidents created within a date range using tilde-separated dates (ISO 8601 format). Example: \"2024-12-01~2024-12-31\"",
+				"description": "Filter incidents created within a date range using tilde-separated dates (ISO 8601 format). Example: \"2024-12-01~2024-12-31\" or \"now-7d~now\"",
 			},
 			"updated_at_gte": map[string]interface{}{
 				"type":        "string",
-				"description": "Filter incidents updated on or after this date (ISO 8601 format). Example: \"2024-12-01\" or \"2024-12-01T00:00:00Z\"",
+				"description": "Filter incidents updated on or after this date (ISO 8601 format). Example: \"2024-12-01\" or \"2024-12-01T00:00:00Z\", or relative: \"now-7d\", \"today\"",
 			},
 			"updated_at_lte": map[string]interface{}{
 				"type":        "string",
-				"description": "Filter incidents updated on or before this date (ISO 8601 format). Example: \"2024-12-31\" or \"2024-12-31T23:59:59Z\"",
+				"description": "Filter incidents updated on or before this date (ISO 8601 format). Example: \"2024-12-31\" or \"2024-12-31T23:59:59Z\", or relative: \"now-7d\", \"today\"",
 			},
 			"updated_at_range": map[string]interface{}{
 				"type":        "string",
-				"description": "Filter incidents updated within a date range using tilde-separated dates (ISO 8601 format). Example: \"2024-12-01~2024-12-31\"",
+				"description": "Filter incidents updated within a date range using tilde-separated dates (ISO 8601 format). Example: \"2024-12-01~2024-12-31\" or \"now-7d~now\"",
+			},
+			"timezone": map[string]interface{}{
+				"type":        "string",
+				"description": "IANA time zone used to interpret date-only filters, e.g. \"America/Los_Angeles\". Defaults to UTC.",
+			},
+			"sort": map[string]interface{}{
+				"type":        "string",
+				"description": "Sort results by field, optionally with a direction such as \"created_at.asc\" or \"updated_at.desc\". created_at is sorted by the API; updated_at and severity_rank are sorted client-side after fetching (whole result set with auto-pagination, current page only with page_size).",
+				"enum":        incidentSorts(),
+			},
+			"sort_direction": map[string]interface{}{
+				"type":        "string",
+				"description": "Sort direction (asc or desc)",
+				"enum":        []string{"asc", "desc"},
+				"default":     "desc",
+			},
+			"auto_paginate": map[string]interface{}{
+				"type":        "boolean",
+				"description": "Follow pagination internally and return all matching incidents (up to max_results) in a single response. Sets truncated=true if the cap was hit.",
+				"default":     false,
+			},
+			"max_results": map[string]interface{}{
+				"type":        "integer",
+				"description": "Maximum number of incidents to collect when auto_paginate is true (default 1000, max 10000)",
+				"default":     defaultAutoPaginateLimit,
+			},
+			"include_latest_update": map[string]interface{}{
+				"type":        "boolean",
+				"description": "Embed each incident's most recent update as latest_update. Costs at least one extra API call per incident, one per 250 updates, and only applies to the first 50 incidents.",
+				"default":     false,
 			},
+			"output_format": outputFormatProperty(),
 		},
 	}
 }
 
-func (t *ListIncidentsTool) Execute(args map[string]interface{}) (string, error) {
+func (t *ListIncidentsTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	outputFormat, err := parseOutputFormat(args)
+	if err != nil {
+		return "", err
+	}
+
 	opts := &incidentio.ListIncidentsOptions{}
 
+	autoPaginate, _ := args["auto_paginate"].(bool)
 	if pageSize, ok := args["page_size"].(float64); ok {
 		opts.PageSize = int(pageSize)
+	} else if !autoPaginate {
+		opts.PageSize = t.defaults.pageSize
 	}
 
 	if after, ok := args["after"].(string); ok {
 		opts.After = after
 	}
 
+	if err := t.applyFilters(ctx, args, opts); err != nil {
+		return "", err
+	}
+
+	// Handle sort parameters - created_at is sorted by the API, everything else client-side
+	sortField, _ := args["sort"].(string)
+	sortDirection, _ := args["sort_direction"].(string)
+	if field, direction, ok := strings.Cut(sortField, "."); ok {
+		if direction != "asc" && direction != "desc" {
+			return "", fmt.Errorf("invalid sort '%s'. The direction after '.' must be 'asc' or 'desc'", sortField)
+		}
+		if sortDirection != "" && sortDirection != direction {
+			return "", fmt.Errorf("sort '%s' conflicts with sort_direction '%s'", sortField, sortDirection)
+		}
+		sortField, sortDirection = field, direction
+	}
+	if sortDirection == "" {
+		sortDirection = "desc"
+	}
+	if sortDirection != "asc" && sortDirection != "desc" {
+		return "", fmt.Errorf("invalid sort_direction '%s'. Must be 'asc' or 'desc'", sortDirection)
+	}
+	descending := sortDirection == "desc"
+
+	switch sortField {
+	case "":
+	case "created_at":
+		if descending {
+			opts.SortBy = "created_at_newest_first"
+		} else {
+			opts.SortBy = "created_at_oldest_first"
+		}
+	case "updated_at", "severity_rank":
+	default:
+		return "", fmt.Errorf("invalid sort '%s'. Available sorts: created_at, updated_at, severity_rank", sortField)
+	}
+
+	maxResults := defaultAutoPaginateLimit
+	if value, ok := args["max_results"].(float64); ok {
+		maxResults = int(value)
+	}
+	if maxResults < 1 || maxResults > maxAutoPaginateLimit {
+		return "", fmt.Errorf("max_results must be between 1 and %d, got %d", maxAutoPaginateLimit, maxResults)
+	}
+
+	var resp *incidentio.ListIncidentsResponse
+	truncated := false
+	if autoPaginate {
+		resp, truncated, err = t.autoPaginate(ctx, opts, maxResults)
+	} else {
+		resp, err = t.client.ListIncidents(ctx, opts)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if sortField == "updated_at" || sortField == "severity_rank" {
+		sortIncidents(resp.Incidents, sortField, descending)
+	}
+
+	// Apply field filtering with default fields if not specified
+	fieldsStr, ok := args["fields"].(string)
+	if !ok || fieldsStr == "" {
+		fieldsStr = t.defaults.fields
+	}
+	if fieldsStr == "" {
+		fieldsStr = defaultIncidentListFields
+	}
+
+	var data interface{} = resp
+
+	// Optionally embed each incident's most recent update (one extra API call per incident)
+	if includeLatestUpdate, _ := args["include_latest_update"].(bool); includeLatestUpdate {
+		withUpdates, err := t.attachLatestUpdates(ctx, resp)
+		if err != nil {
+			return "", err
+		}
+		if !includesField(fieldsStr, "latest_update") {
+			fieldsStr += ",latest_update"
+		}
+		data = withUpdates
+	}
+
+	if autoPaginate {
+		data, err = withTopLevelField(data, "truncated", truncated)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if outputFormat == "csv" {
+		return formatCSVResult(data, "incidents", fieldsStr, nil)
+	}
+	return FilterFields(data, fieldsStr)
+}
+
+const (
+	// defaultAutoPaginateLimit is the default cap on incidents collected by auto_paginate
+	defaultAutoPaginateLimit = 1000
+	// maxAutoPaginateLimit is the largest max_results accepted by auto_paginate
+	maxAutoPaginateLimit = 10000
+	// maxAutoPaginatePages caps the pages fetched by one paginated call. Mode
+	// and severity rank filters run on each page after it is fetched, so a
+	// page can hold no matching incidents while more pages follow.
+	maxAutoPaginatePages = 200
+)
+
+// autoPaginate follows pagination_meta.after until every matching incident has
+// been fetched, limit incidents have been collected or maxAutoPaginatePages
+// pages have been fetched. The returned flag is true when either cap cut off
+// further pages.
+func (t *ListIncidentsTool) autoPaginate(ctx context.Context, opts *incidentio.ListIncidentsOptions, limit int) (*incidentio.ListIncidentsResponse, bool, error) {
+	pageOpts := *opts
+	if pageOpts.PageSize <= 0 {
+		pageOpts.PageSize = 250
+	}
+
+	var incidents []incidentio.Incident
+	totalRecordCount := 0
+	guard := incidentio.NewPaginationGuard("incidents", pageOpts.After)
+	for page := 1; ; page++ {
+		resp, err := t.listPage(ctx, &pageOpts)
+		if err != nil {
+			return nil, false, err
+		}
+
+		incidents = append(incidents, resp.Incidents...)
+		if resp.PaginationMeta.TotalRecordCount > 0 {
+			totalRecordCount = resp.PaginationMeta.TotalRecordCount
+		}
+
+		// A page can be empty once filtered, so only the cursor says whether
+		// the API has more
+		morePages := resp.PaginationMeta.After != ""
+
+		if len(incidents) >= limit {
+			truncated := len(incidents) > limit || morePages
+			incidents = incidents[:limit]
+			return newAutoPaginatedResponse(incidents, pageOpts.PageSize, totalRecordCount), truncated, nil
+		}
+		if !morePages || page >= maxAutoPaginatePages {
+			return newAutoPaginatedResponse(incidents, pageOpts.PageSize, totalRecordCount), morePages, nil
+		}
+		if err := guard.Next(resp.PaginationMeta.After, len(resp.Incidents)); err != nil {
+			return nil, false, err
+		}
+		reportIncidentProgress(ctx, len(incidents), totalRecordCount, limit)
+		pageOpts.After = resp.PaginationMeta.After
+	}
+}
+
+// reportIncidentProgress reports how many incidents have been fetched so far,
+// out of the matching total when the API reports it, capped at limit
+func reportIncidentProgress(ctx context.Context, fetched, totalRecordCount, limit int) {
+	if totalRecordCount == 0 {
+		ReportProgress(ctx, fetched, 0, fmt.Sprintf("Fetched %d incidents", fetched))
+		return
+	}
+	total := totalRecordCount
+	if total > limit {
+		total = limit
+	}
+	ReportProgress(ctx, fetched, total, fmt.Sprintf("Fetched %d of %d incidents", fetched, total))
+}
+
+const (
+	// maxRateLimitPauses caps how many times a single page is retried after
+	// the client gives up on a rate limit
+	maxRateLimitPauses = 3
+	// maxRateLimitPause caps a single wait when Retry-After is very long
+	maxRateLimitPause = 60 * time.Second
+)
+
+// listPage fetches one page of incidents while paginating. When the client
+// has exhausted its own retries on a rate limit, the page is requested again
+// after the Retry-After delay, so long pagination runs slow down instead of
+// failing part way through.
+func (t *ListIncidentsTool) listPage(ctx context.Context, opts *incidentio.ListIncidentsOptions) (*incidentio.ListIncidentsResponse, error) {
+	for pauses := 0; ; pauses++ {
+		resp, err := t.client.ListIncidents(ctx, opts)
+		retryAfter, rateLimited := incidentio.IsRateLimited(err)
+		if !rateLimited || retryAfter <= 0 || pauses >= maxRateLimitPauses {
+			return resp, err
+		}
+
+		if retryAfter > maxRateLimitPause {
+			retryAfter = maxRateLimitPause
+		}
+		pause := t.pause
+		if pause == nil {
+			pause = pauseContext
+		}
+		if err := pause(ctx, retryAfter); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// pauseContext waits for d, returning early with the context's error if it
+// is cancelled first
+func pauseContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func newAutoPaginatedResponse(incidents []incidentio.Incident, pageSize, totalRecordCount int) *incidentio.ListIncidentsResponse {
+	if totalRecordCount == 0 {
+		totalRecordCount = len(incidents)
+	}
+
+	resp := &incidentio.ListIncidentsResponse{Incidents: incidents}
+	resp.PaginationMeta.PageSize = pageSize
+	resp.PaginationMeta.TotalRecordCount = totalRecordCount
+	return resp
+}
+
+// withTopLevelField adds a key to the JSON object representation of data
+func withTopLevelField(data interface{}, key string, value interface{}) (map[string]interface{}, error) {
+	jsonBytes, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(jsonBytes, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	result[key] = value
+	return result, nil
+}
+
+// incidentModes are the incident modes accepted by the mode filter
+var incidentModes = []string{"standard", "retrospective", "tutorial"}
+
+func isIncidentMode(mode string) bool {
+	for _, m := range incidentModes {
+		if m == mode {
+			return true
+		}
+	}
+	return false
+}
+
+// applyFilters parses the status, severity, mode and date filter arguments
+// shared by list_incidents, export_incidents and search_incidents into opts
+func (t *ListIncidentsTool) applyFilters(ctx context.Context, args map[string]interface{}, opts *incidentio.ListIncidentsOptions) error {
+	// Date filters are checked first, as they need no API calls
+	loc, err := parseTimezone(args)
+	if err != nil {
+		return err
+	}
+	now := time.Now
+	if t.now != nil {
+		now = t.now
+	}
+	dates := dateParser{loc: loc, now: now()}
+	opts.CreatedAtGTE, opts.CreatedAtLTE, opts.CreatedAtRange, err = dates.parseFilters(args, "created_at")
+	if err != nil {
+		return err
+	}
+	opts.UpdatedAtGTE, opts.UpdatedAtLTE, opts.UpdatedAtRange, err = dates.parseFilters(args, "updated_at")
+	if err != nil {
+		return err
+	}
+
 	// Handle status parameter - supports both array and comma-separated string
 	var statusInputs []string
 	if statuses, ok := args["status"].([]interface{}); ok {
@@ -206,9 +605,9 @@ func (t *ListIncidentsTool) Execute(args map[string]interface{}) (string, error)
 
 	// Validate status categories against API
 	if len(statusInputs) > 0 {
-		validatedStatuses, err := t.validateStatusCategories(statusInputs)
+		validatedStatuses, err := t.validateStatusCategories(ctx, statusInputs)
 		if err != nil {
-			return "", fmt.Errorf("failed to validate status categories: %w", err)
+			return fmt.Errorf("failed to validate status categories: %w", err)
 		}
 		opts.Status = validatedStatuses
 	}
@@ -234,52 +633,159 @@ func (t *ListIncidentsTool) Execute(args map[string]interface{}) (string, error)
 
 	// Map severity names to IDs
 	if len(severityInputs) > 0 {
-		mappedSeverities, err := t.mapSeveritiesToIDs(severityInputs)
+		mappedSeverities, err := t.mapSeveritiesToIDs(ctx, severityInputs)
 		if err != nil {
-			return "", fmt.Errorf("failed to map severities: %w", err)
+			return fmt.Errorf("failed to map severities: %w", err)
 		}
 		opts.Severity = mappedSeverities
 	}
 
-	// Handle date filter parameters for created_at
-	if createdAtGTE, ok := args["created_at_gte"].(string); ok && createdAtGTE != "" {
-		opts.CreatedAtGTE = createdAtGTE
+	// Handle severity_gte/severity_lte - bounds on the severity's rank
+	gteInput, _ := args["severity_gte"].(string)
+	lteInput, _ := args["severity_lte"].(string)
+	if gteInput != "" || lteInput != "" {
+		var inputs []string
+		for _, input := range []string{gteInput, lteInput} {
+			if input != "" {
+				inputs = append(inputs, input)
+			}
+		}
+		bounds, err := t.findSeverities(ctx, inputs)
+		if err != nil {
+			return fmt.Errorf("failed to map severities: %w", err)
+		}
+		if gteInput != "" {
+			opts.SeverityGTE = &bounds[0]
+		}
+		if lteInput != "" {
+			opts.SeverityLTE = &bounds[len(bounds)-1]
+		}
+		if opts.SeverityGTE != nil && opts.SeverityLTE != nil && opts.SeverityGTE.Rank > opts.SeverityLTE.Rank {
+			return fmt.Errorf("invalid severity range: severity_gte (%s) is more severe than severity_lte (%s)", opts.SeverityGTE.Name, opts.SeverityLTE.Name)
+		}
+	}
+
+	// Handle mode parameter - supports both array and comma-separated string
+	var modeInputs []string
+	if modes, ok := args["mode"].([]interface{}); ok {
+		for _, m := range modes {
+			if str, ok := m.(string); ok {
+				modeInputs = append(modeInputs, str)
+			}
+		}
+	} else if modeStr, ok := args["mode"].(string); ok {
+		for _, m := range strings.Split(modeStr, ",") {
+			trimmed := strings.TrimSpace(m)
+			if trimmed != "" {
+				modeInputs = append(modeInputs, trimmed)
+			}
+		}
 	}
-	if createdAtLTE, ok := args["created_at_lte"].(string); ok && createdAtLTE != "" {
-		opts.CreatedAtLTE = createdAtLTE
+
+	for _, mode := range modeInputs {
+		normalized := strings.ToLower(mode)
+		if !isIncidentMode(normalized) {
+			return fmt.Errorf("invalid mode '%s'. Available modes: %s", mode, strings.Join(incidentModes, ", "))
+		}
+		opts.Mode = append(opts.Mode, normalized)
 	}
-	if createdAtRange, ok := args["created_at_range"].(string); ok && createdAtRange != "" {
-		opts.CreatedAtRange = createdAtRange
+
+	// Handle custom_field_id/custom_field_value - option labels are mapped to IDs
+	fieldInput, _ := args["custom_field_id"].(string)
+	valueInputs := stringListArgument(args["custom_field_value"])
+	if fieldInput != "" || len(valueInputs) > 0 {
+		if fieldInput == "" || len(valueInputs) == 0 {
+			return fmt.Errorf("custom_field_id and custom_field_value must be given together")
+		}
+		fieldID, optionIDs, err := t.resolveCustomFieldFilter(ctx, fieldInput, valueInputs)
+		if err != nil {
+			return err
+		}
+		opts.CustomFields = map[string][]string{fieldID: optionIDs}
 	}
 
-	// Handle date filter parameters for updated_at
-	if updatedAtGTE, ok := args["updated_at_gte"].(string); ok && updatedAtGTE != "" {
-		opts.UpdatedAtGTE = updatedAtGTE
+	return nil
+}
+
+// stringListArgument reads an argument given as an array of strings or as a
+// comma-separated string
+func stringListArgument(value interface{}) []string {
+	var result []string
+	switch v := value.(type) {
+	case []interface{}:
+		for _, item := range v {
+			if str, ok := item.(string); ok && strings.TrimSpace(str) != "" {
+				result = append(result, strings.TrimSpace(str))
+			}
+		}
+	case string:
+		for _, item := range strings.Split(v, ",") {
+			if trimmed := strings.TrimSpace(item); trimmed != "" {
+				result = append(result, trimmed)
+			}
+		}
 	}
-	if updatedAtLTE, ok := args["updated_at_lte"].(string); ok && updatedAtLTE != "" {
-		opts.UpdatedAtLTE = updatedAtLTE
+	return result
+}
+
+// resourceID matches incident.io resource IDs, which are ULIDs such as
+// "01HXYZ7ABCDEFGHJKMNPQRSTVW"
+var resourceID = regexp.MustCompile(`^[0-9A-HJKMNP-TV-Z]{26}$`)
+
+func looksLikeID(value string) bool {
+	return resourceID.MatchString(value)
+}
+
+// resolveCustomFieldFilter maps a custom field name and option labels to IDs.
+// When the field and every value already look like IDs no API call is made.
+func (t *ListIncidentsTool) resolveCustomFieldFilter(ctx context.Context, fieldInput string, valueInputs []string) (string, []string, error) {
+	allIDs := looksLikeID(fieldInput)
+	for _, value := range valueInputs {
+		allIDs = allIDs && looksLikeID(value)
 	}
-	if updatedAtRange, ok := args["updated_at_range"].(string); ok && updatedAtRange != "" {
-		opts.UpdatedAtRange = updatedAtRange
+	if allIDs {
+		return fieldInput, valueInputs, nil
 	}
 
-	resp, err := t.client.ListIncidents(opts)
-	if err != nil {
-		return "", err
+	var field incidentio.CustomField
+	if looksLikeID(fieldInput) {
+		fetched, err := t.client.GetCustomField(ctx, fieldInput)
+		if err != nil {
+			if incidentio.IsNotFound(err) {
+				return "", nil, fmt.Errorf("custom field not found: %s", fieldInput)
+			}
+			return "", nil, fmt.Errorf("failed to fetch custom field options: %w", err)
+		}
+		field = *fetched
+	} else {
+		customFields, err := t.client.ListCustomFields(ctx)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to fetch custom fields: %w", err)
+		}
+		if field, err = findCustomField(customFields.CustomFields, fieldInput); err != nil {
+			return "", nil, err
+		}
 	}
 
-	// Apply field filtering with default fields if not specified
-	fieldsStr, ok := args["fields"].(string)
-	if !ok || fieldsStr == "" {
-		fieldsStr = "id,reference,name,permalink,created_at,updated_at,slack_channel_id"
+	if field.FieldType != "single_select" && field.FieldType != "multi_select" {
+		return "", nil, fmt.Errorf("custom field %s (%s) is %s; only single_select and multi_select fields can be filtered on", field.Name, field.ID, field.FieldType)
+	}
+
+	optionIDs := make([]string, len(valueInputs))
+	for i, value := range valueInputs {
+		optionID, err := resolveCustomFieldOption(field, value)
+		if err != nil {
+			return "", nil, err
+		}
+		optionIDs[i] = optionID
 	}
-	return FilterFields(resp, fieldsStr)
+	return field.ID, optionIDs, nil
 }
 
 // validateStatusCategories validates status categories against API and uses exact API values
-func (t *ListIncidentsTool) validateStatusCategories(inputs []string) ([]string, error) {
+func (t *ListIncidentsTool) validateStatusCategories(ctx context.Context, inputs []string) ([]string, error) {
 	// Fetch all incident statuses to get valid categories
-	statuses, err := t.client.ListIncidentStatuses()
+	statuses, err := t.client.ListIncidentStatuses(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch incident statuses for validation: %w", err)
 	}
@@ -350,37 +856,48 @@ func (t *ListIncidentsTool) formatAvailableCategories(statuses []incidentio.Inci
 }
 
 // mapSeveritiesToIDs fetches the severity list and maps names to IDs
-func (t *ListIncidentsTool) mapSeveritiesToIDs(inputs []string) ([]string, error) {
+func (t *ListIncidentsTool) mapSeveritiesToIDs(ctx context.Context, inputs []string) ([]string, error) {
+	severities, err := t.findSeverities(ctx, inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]string, len(severities))
+	for i, sev := range severities {
+		result[i] = sev.ID
+	}
+	return result, nil
+}
+
+// findSeverities fetches the severity list and looks up each input by ID, or
+// by name ignoring case
+func (t *ListIncidentsTool) findSeverities(ctx context.Context, inputs []string) ([]incidentio.Severity, error) {
 	// Fetch all severities
-	severities, err := t.client.ListSeverities()
+	severities, err := t.client.ListSeverities(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch severities for mapping: %w", err)
 	}
 
-	// Build name-to-ID and ID-to-ID maps
-	nameToID := make(map[string]string)
-	idToID := make(map[string]string)
+	// Build name and ID lookups
+	byName := make(map[string]incidentio.Severity)
+	byID := make(map[string]incidentio.Severity)
 	for _, sev := range severities.Severities {
-		// Map by name (case-insensitive)
-		nameToID[strings.ToLower(sev.Name)] = sev.ID
-		// Map by ID (for passthrough)
-		idToID[sev.ID] = sev.ID
+		byName[strings.ToLower(sev.Name)] = sev
+		byID[sev.ID] = sev
 	}
 
 	// Map each input
-	var result []string
+	var result []incidentio.Severity
 	for _, input := range inputs {
-		inputLower := strings.ToLower(input)
-
 		// Try as ID first (direct match)
-		if id, ok := idToID[input]; ok {
-			result = append(result, id)
+		if sev, ok := byID[input]; ok {
+			result = append(result, sev)
 			continue
 		}
 
 		// Try as name (case-insensitive)
-		if id, ok := nameToID[inputLower]; ok {
-			result = append(result, id)
+		if sev, ok := byName[strings.ToLower(input)]; ok {
+			result = append(result, sev)
 			continue
 		}
 
@@ -400,13 +917,175 @@ func (t *ListIncidentsTool) formatAvailableSeverities(severities []incidentio.Se
 	return strings.Join(names, ", ")
 }
 
+// incidentSortFields are the fields list_incidents can sort by
+var incidentSortFields = []string{"created_at", "updated_at", "severity_rank"}
+
+// incidentSorts lists the accepted sort values: each field on its own and
+// with an explicit direction, such as "created_at.asc"
+func incidentSorts() []string {
+	sorts := make([]string, 0, 3*len(incidentSortFields))
+	for _, field := range incidentSortFields {
+		sorts = append(sorts, field, field+".asc", field+".desc")
+	}
+	return sorts
+}
+
+// sortIncidents sorts incidents in place for sorts the API doesn't support.
+// Incidents without a severity always sort last when sorting by severity_rank.
+func sortIncidents(incidents []incidentio.Incident, field string, descending bool) {
+	sort.SliceStable(incidents, func(i, j int) bool {
+		a, b := incidents[i], incidents[j]
+
+		switch field {
+		case "severity_rank":
+			aHasSeverity, bHasSeverity := a.Severity.ID != "", b.Severity.ID != ""
+			if aHasSeverity != bHasSeverity {
+				return aHasSeverity
+			}
+			if descending {
+				return a.Severity.Rank > b.Severity.Rank
+			}
+			return a.Severity.Rank < b.Severity.Rank
+		case "updated_at":
+			if descending {
+				return a.UpdatedAt.After(b.UpdatedAt)
+			}
+			return a.UpdatedAt.Before(b.UpdatedAt)
+		}
+
+		return false
+	})
+}
+
+const (
+	// latestUpdateConcurrency bounds the number of incident update lookups in flight at once
+	latestUpdateConcurrency = 5
+	// maxLatestUpdateIncidents caps how many incidents get their latest update attached
+	maxLatestUpdateIncidents = 50
+	// latestUpdatePageSize is the page size used when looking for an incident's latest update
+	latestUpdatePageSize = 250
+)
+
+// incidentWithLatestUpdate is an incident with its most recent update embedded
+type incidentWithLatestUpdate struct {
+	incidentio.Incident
+	LatestUpdate *incidentio.IncidentUpdate `json:"latest_update"`
+}
+
+// listIncidentsWithLatestUpdateResponse mirrors ListIncidentsResponse with latest updates attached
+type listIncidentsWithLatestUpdateResponse struct {
+	Incidents []incidentWithLatestUpdate `json:"incidents"`
+	incidentio.ListResponse
+}
+
+// attachLatestUpdates fetches the most recent update for the first
+// maxLatestUpdateIncidents incidents, running at most latestUpdateConcurrency
+// lookups concurrently. The first failed lookup cancels the rest.
+func (t *ListIncidentsTool) attachLatestUpdates(ctx context.Context, resp *incidentio.ListIncidentsResponse) (*listIncidentsWithLatestUpdateResponse, error) {
+	result := &listIncidentsWithLatestUpdateResponse{
+		Incidents:    make([]incidentWithLatestUpdate, len(resp.Incidents)),
+		ListResponse: resp.ListResponse,
+	}
+	for i, incident := range resp.Incidents {
+		result.Incidents[i].Incident = incident
+	}
+
+	count := len(result.Incidents)
+	if count > maxLatestUpdateIncidents {
+		count = maxLatestUpdateIncidents
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	sem := make(chan struct{}, latestUpdateConcurrency)
+
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		go func(incident *incidentWithLatestUpdate) {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			update, err := t.latestUpdate(ctx, incident.ID)
+			if err != nil {
+				errOnce.Do(func() {
+					firstErr = fmt.Errorf("failed to get latest update for incident %s: %w", incident.Reference, err)
+					cancel()
+				})
+				return
+			}
+			incident.LatestUpdate = update
+		}(&result.Incidents[i])
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return result, nil
+}
+
+// latestUpdate returns the newest update for an incident, or nil if it has
+// none. The API has no way to ask for only the newest update, so every page
+// is checked.
+func (t *ListIncidentsTool) latestUpdate(ctx context.Context, incidentID string) (*incidentio.IncidentUpdate, error) {
+	opts := &incidentio.ListIncidentUpdatesOptions{
+		IncidentID: incidentID,
+		PageSize:   latestUpdatePageSize,
+	}
+
+	var latest *incidentio.IncidentUpdate
+	guard := incidentio.NewPaginationGuard("incident updates", "")
+	for page := 1; ; page++ {
+		resp, err := t.client.ListIncidentUpdates(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range resp.IncidentUpdates {
+			if latest == nil || resp.IncidentUpdates[i].CreatedAt.After(latest.CreatedAt) {
+				latest = &resp.IncidentUpdates[i]
+			}
+		}
+
+		if resp.PaginationMeta.After == "" || page >= maxAutoPaginatePages {
+			return latest, nil
+		}
+		if err := guard.Next(resp.PaginationMeta.After, len(resp.IncidentUpdates)); err != nil {
+			return nil, err
+		}
+		opts.After = resp.PaginationMeta.After
+	}
+}
+
+// includesField reports whether a comma-separated field list selects a field
+// or any of its nested fields
+func includesField(fieldsStr, field string) bool {
+	for _, f := range strings.Split(fieldsStr, ",") {
+		f = strings.TrimSpace(f)
+		if f == field || strings.HasPrefix(f, field+".") {
+			return true
+		}
+	}
+	return false
+}
+
 // GetIncidentTool retrieves a specific incident
 type GetIncidentTool struct {
 	client *incidentio.Client
+	// now is the end of an open incident's duration. Tests replace it
+	now func() time.Time
 }
 
 func NewGetIncidentTool(client *incidentio.Client) *GetIncidentTool {
-	return &GetIncidentTool{client: client}
+	return &GetIncidentTool{client: client, now: time.Now}
 }
 
 func (t *GetIncidentTool) Name() string {
@@ -431,7 +1110,7 @@ RECOMMENDED WORKFLOW:
 3. Use THIS TOOL (get_incident) with ANY of the identifier formats to retrieve COMPLETE information:
    - Full incident details (status, severity, timeline, assignments, custom fields)
    - Related entities (incident type, status details, severity details)
-   - All timestamps and metadata
+   - All timestamps and metadata, including incident_timestamp_values (e.g. impact started, reported at)
    - Complete incident history and context
 4. Optionally use 'fields' parameter to limit response if you only need specific fields
 
@@ -452,6 +1131,21 @@ PARAMETERS:
   * Top-level: "id,name,summary,reference"
   * Nested: "severity.name,incident_status.category,incident_type.name"
   * Omit to return all fields
+  * Only applies to the json format
+- format: "json" (default) returns the incident as JSON; "summary" returns a compact human-readable block
+  with reference, name, status, severity, lead, Slack channel, created/updated times and duration
+- include_alerts: Set to true to embed the alerts attached to the incident under "alerts"
+- include_follow_ups: Set to true to embed the incident's follow-ups under "follow_ups"
+- include_actions: Set to true to embed the incident's actions under "actions"
+  * All three are off by default to keep responses small; each makes extra API calls
+  * They only apply to the json format
+  * fields applies to the embedded items too, e.g. "id,name,follow_ups.title,follow_ups.status"
+
+COMPUTED DURATION:
+The JSON output includes duration_seconds and a readable duration such as "2h 15m", measured from the
+"Detected" timestamp (or created_at) to the "Resolved" or "Closed" timestamp. duration_from and duration_to
+name the timestamps used. For an incident that is still open, duration_open is true and the duration is
+the time elapsed so far.
 
 EXAMPLES:
 - Get by full ID: {"incident_id": "01HXYZ..."}
@@ -459,6 +1153,9 @@ EXAMPLES:
 - Get by Slack channel ID: {"incident_id": "C123456789"}
 - Get by Slack channel name: {"incident_id": "20251020-aws-outage-ci-impaired"}
 - Get with selected fields: {"incident_id": "INC-123", "fields": "id,name,severity.name,incident_status.category"}
+- Get a summary for chat: {"incident_id": "INC-123", "format": "summary"}
+- Get with the alerts that triggered it: {"incident_id": "INC-123", "include_alerts": true}
+- Get everything for a retrospective: {"incident_id": "INC-123", "include_follow_ups": true, "include_actions": true}
 
 PERFORMANCE NOTES:
 - Using incident ID or reference is most efficient (direct API call)
@@ -477,13 +1174,34 @@ func (t *GetIncidentTool) InputSchema() map[string]interface{} {
 				"type":        "string",
 				"description": GetIncidentFieldsDescription(),
 			},
+			"format": map[string]interface{}{
+				"type":        "string",
+				"description": "Output format: json returns the incident data, summary returns a compact human-readable block",
+				"enum":        incidentOutputFormats,
+				"default":     "json",
+			},
+			"include_alerts": map[string]interface{}{
+				"type":        "boolean",
+				"description": "Embed the alerts attached to the incident under \"alerts\". Makes extra API calls, so it is off by default.",
+				"default":     false,
+			},
+			"include_follow_ups": map[string]interface{}{
+				"type":        "boolean",
+				"description": "Embed the incident's follow-ups under \"follow_ups\". Makes an extra API call, so it is off by default.",
+				"default":     false,
+			},
+			"include_actions": map[string]interface{}{
+				"type":        "boolean",
+				"description": "Embed the incident's actions under \"actions\". Makes extra API calls, so it is off by default.",
+				"default":     false,
+			},
 		},
 		"required":             []interface{}{"incident_id"},
 		"additionalProperties": false,
 	}
 }
 
-func (t *GetIncidentTool) Execute(args map[string]interface{}) (string, error) {
+func (t *GetIncidentTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
 	identifier, ok := args["incident_id"].(string)
 	if !ok || identifier == "" {
 		argDetails := make(map[string]interface{})
@@ -493,26 +1211,113 @@ func (t *GetIncidentTool) Execute(args map[string]interface{}) (string, error) {
 		return "", fmt.Errorf("incident_id parameter is required and must be a non-empty string. Received parameters: %+v", argDetails)
 	}
 
+	format, _ := args["format"].(string)
+	if format == "" {
+		format = "json"
+	}
+	if format != "json" && format != "summary" {
+		return "", fmt.Errorf("unsupported format '%s'. Supported formats: %s", format, strings.Join(incidentOutputFormats, ", "))
+	}
+	fieldsStr, _ := args["fields"].(string)
+	if format == "summary" && fieldsStr != "" {
+		return "", fmt.Errorf("fields can only be used with the json format")
+	}
+	includeAlerts, _ := args["include_alerts"].(bool)
+	includeFollowUps, _ := args["include_follow_ups"].(bool)
+	includeActions, _ := args["include_actions"].(bool)
+	if format == "summary" && (includeAlerts || includeFollowUps || includeActions) {
+		return "", fmt.Errorf("include_alerts, include_follow_ups and include_actions can only be used with the json format")
+	}
+
 	// Resolve identifier to actual incident ID if needed
-	incidentID, err := t.ResolveIncidentIdentifier(identifier)
+	incidentID, err := t.ResolveIncidentIdentifier(ctx, identifier)
 	if err != nil {
 		return "", err
 	}
 
-	incident, err := t.client.GetIncident(incidentID)
+	incident, err := t.client.GetIncident(ctx, incidentID)
 	if err != nil {
 		return "", err
 	}
 
-	// Apply field filtering if requested
-	fieldsStr, _ := args["fields"].(string)
-	return FilterFields(incident, fieldsStr)
+	now := time.Now
+	if t.now != nil {
+		now = t.now
+	}
+	duration := computeIncidentDuration(incident, now())
+
+	if format == "summary" {
+		return formatIncidentSummary(incident, duration), nil
+	}
+
+	result := &incidentWithRelated{
+		incidentWithDuration: &incidentWithDuration{Incident: *incident, incidentDuration: duration},
+	}
+
+	// Related items are looked up by the incident's ID, which a reference such as "123" is not
+	if includeAlerts {
+		alerts, err := t.client.ListAlertsForIncident(ctx, incident.ID, &incidentio.ListAlertsForIncidentOptions{AutoPaginate: true})
+		if err != nil {
+			return "", fmt.Errorf("failed to list alerts for incident: %w", err)
+		}
+		result.Alerts = &alerts.Alerts
+	}
+	if includeFollowUps {
+		followUps, err := t.client.ListFollowUps(ctx, &incidentio.ListFollowUpsOptions{
+			IncidentID: incident.ID,
+			PageSize:   maxEmbeddedFollowUps,
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to list follow-ups for incident: %w", err)
+		}
+		result.FollowUps = &followUps.FollowUps
+	}
+	if includeActions {
+		actions, err := t.client.ListActions(ctx, &incidentio.ListActionsOptions{IncidentID: incident.ID})
+		if err != nil {
+			return "", fmt.Errorf("failed to list actions for incident: %w", err)
+		}
+		result.Actions = &actions.Actions
+	}
+
+	if fieldsStr == "" {
+		return FilterFields(result, "")
+	}
+
+	// Filter the incident as a single record: FilterFields would take an
+	// embedded "alerts" or "actions" array for the collection to filter
+	fields, err := parseFieldList(fieldsStr)
+	if err != nil {
+		return "", err
+	}
+	record, err := projectFields(result, fields)
+	if err != nil {
+		return "", err
+	}
+	output, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal filtered data: %w", err)
+	}
+	return string(output), nil
+}
+
+// maxEmbeddedFollowUps is the number of follow-ups include_follow_ups embeds,
+// the largest page the API returns
+const maxEmbeddedFollowUps = 250
+
+// incidentWithRelated is get_incident's JSON output. The related items are
+// only set, and only output, when requested.
+type incidentWithRelated struct {
+	*incidentWithDuration
+	Alerts    *[]incidentio.Alert    `json:"alerts,omitempty"`
+	FollowUps *[]incidentio.FollowUp `json:"follow_ups,omitempty"`
+	Actions   *[]incidentio.Action   `json:"actions,omitempty"`
 }
 
 // ResolveIncidentIdentifier resolves various identifier formats to an incident ID
 // Supports: incident ID (01FDAG4SAP5TYPT98WGR2N7), reference (INC-123 or just 123),
 // Slack channel ID (C123456789), or Slack channel name (20251020-aws-outage-ci-impaired)
-func (t *GetIncidentTool) ResolveIncidentIdentifier(identifier string) (string, error) {
+func (t *GetIncidentTool) ResolveIncidentIdentifier(ctx context.Context, identifier string) (string, error) {
 	// Check if it's already a full incident ID (starts with 01 and is alphanumeric)
 	if strings.HasPrefix(identifier, "01") && len(identifier) > 20 {
 		return identifier, nil
@@ -523,26 +1328,46 @@ func (t *GetIncidentTool) ResolveIncidentIdentifier(identifier string) (string,
 		return identifier, nil
 	}
 
-	// Check if it's a reference format (INC-123)
-	if strings.HasPrefix(strings.ToUpper(identifier), "INC-") {
+	// Check if it's a reference format (INC-123). Slack channel names such as
+	// inc-123-database-outage share the prefix, so the rest must be numeric.
+	upper := strings.ToUpper(identifier)
+	if strings.HasPrefix(upper, "INC-") && isNumericReference(strings.TrimPrefix(upper, "INC-")) {
 		// Extract numeric part and let API handle it
-		numericPart := strings.TrimPrefix(strings.ToUpper(identifier), "INC-")
-		return numericPart, nil
+		return strings.TrimPrefix(upper, "INC-"), nil
 	}
 
 	// Check if it's a Slack channel ID (starts with C and is alphanumeric)
 	if strings.HasPrefix(identifier, "C") && len(identifier) > 5 && isAlphanumeric(identifier) {
-		return t.lookupIncidentBySlackChannelID(identifier)
+		return t.lookupIncidentBySlackChannelID(ctx, identifier)
 	}
 
 	// Otherwise, treat as Slack channel name
-	return t.lookupIncidentBySlackChannelName(identifier)
+	return t.lookupIncidentBySlackChannelName(ctx, identifier)
+}
+
+// resolveIncidentID resolves an incident reference or Slack channel to a full
+// incident ID. References are looked up because endpoints other than
+// GET /incidents/{id} only accept the incident's ID.
+func resolveIncidentID(ctx context.Context, client *incidentio.Client, identifier string) (string, error) {
+	incidentID, err := NewGetIncidentTool(client).ResolveIncidentIdentifier(ctx, identifier)
+	if err != nil {
+		return "", err
+	}
+	if !isNumericReference(incidentID) {
+		return incidentID, nil
+	}
+
+	incident, err := client.GetIncident(ctx, incidentID)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve incident %s: %w", identifier, err)
+	}
+	return incident.ID, nil
 }
 
 // lookupIncidentBySlackChannelID finds incident ID by Slack channel ID
-func (t *GetIncidentTool) lookupIncidentBySlackChannelID(channelID string) (string, error) {
+func (t *GetIncidentTool) lookupIncidentBySlackChannelID(ctx context.Context, channelID string) (string, error) {
 	// Use list_incidents with minimal fields to find the incident
-	resp, err := t.client.ListIncidents(&incidentio.ListIncidentsOptions{
+	resp, err := t.client.ListIncidents(ctx, &incidentio.ListIncidentsOptions{
 		PageSize: 250, // Use max page size for efficiency
 	})
 	if err != nil {
@@ -560,9 +1385,9 @@ func (t *GetIncidentTool) lookupIncidentBySlackChannelID(channelID string) (stri
 }
 
 // lookupIncidentBySlackChannelName finds incident ID by Slack channel name
-func (t *GetIncidentTool) lookupIncidentBySlackChannelName(channelName string) (string, error) {
+func (t *GetIncidentTool) lookupIncidentBySlackChannelName(ctx context.Context, channelName string) (string, error) {
 	// Use list_incidents with minimal fields to find the incident
-	resp, err := t.client.ListIncidents(&incidentio.ListIncidentsOptions{
+	resp, err := t.client.ListIncidents(ctx, &incidentio.ListIncidentsOptions{
 		PageSize: 250, // Use max page size for efficiency
 	})
 	if err != nil {
@@ -632,14 +1457,28 @@ PARAMETERS:
 - incident_type_id: Optional. Type ID (from list_incident_types)
 - incident_status_id: Optional. Status ID (from list_incident_statuses)
 - mode: Optional. Incident mode (standard, retrospective, tutorial), default: standard
-- visibility: Optional. Visibility (public, private), default: public
+- visibility: Optional. Visibility (public, private), default: public, or private when incident_type_id only allows private incidents
 - slack_channel_name_override: Optional. Custom Slack channel name
+- slack_team_id: Optional. Slack workspace (team) ID to create the channel in, for organizations with several workspaces
+- retrospective_options: Optional, only with mode retrospective. Details of a past incident being recorded:
+  * postmortem_document_url: Link to an existing postmortem document
+  * slack_channel_id: Existing Slack channel to use instead of creating one
+  * external_id: Incident number to use, e.g. when importing from another tool
+- role_assignments: Optional. Roles to assign on creation, each with incident_role_id or role_name, and user_id or user_email
+- custom_fields: Optional. Map of custom field ID or name (from list_custom_fields) to an array of values
+  * Select fields: option IDs or option labels, e.g. {"Affected service": ["Payments"]}
+  * Text, numeric and link fields: literal values, e.g. {"Root cause": ["Bad deploy"]}
+  * Use this to set fields your organization requires before an incident can be created
+- idempotency_key: Optional. Reuse the same key when retrying a create so incident.io does not create a duplicate
 
 EXAMPLES:
 - Minimal incident: {"name": "API outage in production"}
+- With an incident lead: {"name": "API outage in production", "role_assignments": [{"role_name": "Incident Lead", "user_email": "jane@example.com"}]}
+- Record a past incident with its postmortem: {"name": "November checkout outage", "mode": "retrospective", "retrospective_options": {"postmortem_document_url": "https://docs.example.com/postmortems/checkout"}}
+- With custom fields: {"name": "Checkout errors", "custom_fields": {"Affected service": "Payments", "Customer impact": ["EU card payments"]}}
 - Full configuration: {"name": "Database unavailable", "severity_id": "01HXYZ...", "incident_type_id": "01HABC...", "incident_status_id": "01HDEF...", "summary": "Primary database not responding"}
 
-IMPORTANT: Tool automatically generates idempotency keys. If severity, type, or status IDs are not provided, helpful error messages suggest using list_severities, list_incident_types, and list_incident_statuses.`
+IMPORTANT: Tool generates a unique idempotency key unless one is given. If severity, type, or status IDs are not provided, helpful error messages suggest using list_severities, list_incident_types, and list_incident_statuses.`
 }
 
 func (t *CreateIncidentTool) InputSchema() map[string]interface{} {
@@ -674,33 +1513,99 @@ func (t *CreateIncidentTool) InputSchema() map[string]interface{} {
 			"mode": map[string]interface{}{
 				"type":        "string",
 				"description": "The incident mode (standard, retrospective, tutorial)",
-				"enum":        []string{"standard", "retrospective", "tutorial"},
+				"enum":        incidentModes,
 				"default":     "standard",
 			},
 			"visibility": map[string]interface{}{
 				"type":        "string",
 				"description": "The incident visibility (public, private)",
-				"enum":        []string{"public", "private"},
+				"enum":        incidentVisibilities,
 				"default":     "public",
 			},
 			"slack_channel_name_override": map[string]interface{}{
 				"type":        "string",
 				"description": "Override the auto-generated Slack channel name",
 			},
+			"slack_team_id": map[string]interface{}{
+				"type":        "string",
+				"description": "Slack workspace (team) ID to create the incident channel in",
+			},
+			"retrospective_options": map[string]interface{}{
+				"type":        "object",
+				"description": "Options for a retrospective incident. Requires mode retrospective.",
+				"properties": map[string]interface{}{
+					"external_id": map[string]interface{}{
+						"type":        "integer",
+						"description": "Incident number to use instead of the next one",
+						"minimum":     1,
+					},
+					"postmortem_document_url": map[string]interface{}{
+						"type":        "string",
+						"description": "Link to an existing postmortem document (absolute http or https URL)",
+					},
+					"slack_channel_id": map[string]interface{}{
+						"type":        "string",
+						"description": "Existing Slack channel to use for the incident",
+					},
+				},
+				"additionalProperties": false,
+			},
+			"idempotency_key": map[string]interface{}{
+				"type":        "string",
+				"description": "Key identifying this create request. Retrying with the same key returns the original incident instead of creating a duplicate. Generated if omitted",
+			},
+			"custom_fields": map[string]interface{}{
+				"type":        "object",
+				"description": "Map of custom field ID or name to an array of values, or a single value. Use option IDs or labels for select fields and literal values for text, numeric and link fields.",
+				"additionalProperties": map[string]interface{}{
+					"type":  []string{"array", "string", "number"},
+					"items": map[string]interface{}{"type": []string{"string", "number"}},
+				},
+			},
+			"role_assignments": map[string]interface{}{
+				"type":        "array",
+				"description": "Incident roles to assign on creation. Identify each role by incident_role_id or role_name, and each user by user_id or user_email.",
+				"items": map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"incident_role_id": map[string]interface{}{
+							"type":        "string",
+							"description": "The incident role ID (from list_incident_roles)",
+						},
+						"role_name": map[string]interface{}{
+							"type":        "string",
+							"description": "The incident role name or shortform, e.g. \"Incident Lead\"",
+						},
+						"user_id": map[string]interface{}{
+							"type":        "string",
+							"description": "The user ID to assign",
+						},
+						"user_email": map[string]interface{}{
+							"type":        "string",
+							"description": "The email of the user to assign",
+						},
+					},
+					"additionalProperties": false,
+				},
+			},
 		},
 		"required":             []interface{}{"name"},
 		"additionalProperties": false,
 	}
 }
 
-func (t *CreateIncidentTool) Execute(args map[string]interface{}) (string, error) {
+func (t *CreateIncidentTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
 	name, ok := args["name"].(string)
 	if !ok {
 		return "", fmt.Errorf("name parameter is required")
 	}
 
-	// Generate idempotency key using timestamp and name
-	idempotencyKey := fmt.Sprintf("mcp-%d-%s", time.Now().UnixNano(), name)
+	// Use the caller's idempotency key so retries are safe, otherwise
+	// generate one using timestamp and name
+	idempotencyKey, _ := args["idempotency_key"].(string)
+	if idempotencyKey == "" {
+		idempotencyKey = fmt.Sprintf("mcp-%d-%s", time.Now().UnixNano(), name)
+	}
 
 	req := &incidentio.CreateIncidentRequest{
 		IdempotencyKey: idempotencyKey,
@@ -721,19 +1626,70 @@ func (t *CreateIncidentTool) Execute(args map[string]interface{}) (string, error
 	if typeID, ok := args["incident_type_id"].(string); ok {
 		req.IncidentTypeID = typeID
 	}
-	if mode, ok := args["mode"].(string); ok {
+	if mode, ok := args["mode"].(string); ok && mode != "" {
+		mode = strings.ToLower(strings.TrimSpace(mode))
+		if !isIncidentMode(mode) {
+			return "", fmt.Errorf("invalid mode '%s'. Allowed modes: %s", args["mode"], strings.Join(incidentModes, ", "))
+		}
 		req.Mode = mode
 	}
-	if visibility, ok := args["visibility"].(string); ok {
+	visibility, _ := args["visibility"].(string)
+	if visibility != "" {
+		visibility = strings.ToLower(strings.TrimSpace(visibility))
+		if !isIncidentVisibility(visibility) {
+			return "", fmt.Errorf("invalid visibility '%s'. Allowed visibilities: %s", args["visibility"], strings.Join(incidentVisibilities, ", "))
+		}
 		req.Visibility = visibility
 	}
+	if req.IncidentTypeID != "" {
+		if err := t.checkIncidentTypeVisibility(ctx, req, visibility != ""); err != nil {
+			return "", err
+		}
+	}
 	if slackOverride, ok := args["slack_channel_name_override"].(string); ok {
 		req.SlackChannelNameOverride = slackOverride
 	}
+	if slackTeamID, ok := args["slack_team_id"].(string); ok {
+		req.SlackTeamID = slackTeamID
+	}
+	if raw, ok := args["retrospective_options"].(map[string]interface{}); ok && len(raw) > 0 {
+		if req.Mode != "retrospective" {
+			return "", fmt.Errorf("retrospective_options can only be used with mode retrospective, got mode '%s'", req.Mode)
+		}
+		options, err := parseRetrospectiveOptions(raw)
+		if err != nil {
+			return "", err
+		}
+		req.RetrospectiveIncidentOptions = options
+	}
+	if customFields, ok := args["custom_fields"].(map[string]interface{}); ok && len(customFields) > 0 {
+		definitions, err := t.client.ListCustomFields(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to list custom fields: %w", err)
+		}
+
+		entries, err := buildCustomFieldEntries(definitions.CustomFields, customFields)
+		if err != nil {
+			return "", err
+		}
+		req.CustomFieldEntries = entries
+	}
 
 	// Check if critical fields are missing and provide helpful suggestions
 	var suggestions []string
 
+	if inputs, ok := args["role_assignments"].([]interface{}); ok && len(inputs) > 0 {
+		assignments, roles, err := resolveRoleAssignments(ctx, t.client, inputs)
+		if err != nil {
+			return "", err
+		}
+		req.IncidentRoleAssignments = assignments
+
+		if missing := missingRequiredRoles(roles, assignments); len(missing) > 0 {
+			suggestions = append(suggestions, fmt.Sprintf("Required roles not assigned: %s. Use assign_incident_role to assign them.", strings.Join(missing, ", ")))
+		}
+	}
+
 	if req.SeverityID == "" {
 		suggestions = append(suggestions, "severity_id is not set. Use list_severities to see available options.")
 	}
@@ -746,12 +1702,12 @@ func (t *CreateIncidentTool) Execute(args map[string]interface{}) (string, error
 		suggestions = append(suggestions, "incident_status_id is not set. Use list_incident_statuses to see available options.")
 	}
 
-	incident, err := t.client.CreateIncident(req)
+	incident, err := t.client.CreateIncident(ctx, req)
 	if err != nil {
 		// If the error is related to missing required fields, provide more helpful error message
 		errMsg := err.Error()
 		if len(suggestions) > 0 && (strings.Contains(errMsg, "severity") || strings.Contains(errMsg, "incident_type") || strings.Contains(errMsg, "incident_status")) {
-			return "", fmt.Errorf("%s\n\nSuggestions:\n%s", errMsg, strings.Join(suggestions, "\n"))
+			return "", fmt.Errorf("%w\n\nSuggestions:\n%s", err, strings.Join(suggestions, "\n"))
 		}
 		return "", err
 	}
@@ -769,6 +1725,65 @@ func (t *CreateIncidentTool) Execute(args map[string]interface{}) (string, error
 	return string(result), nil
 }
 
+// incidentVisibilities are the visibilities an incident can be created with
+var incidentVisibilities = []string{"public", "private"}
+
+func isIncidentVisibility(visibility string) bool {
+	for _, v := range incidentVisibilities {
+		if v == visibility {
+			return true
+		}
+	}
+	return false
+}
+
+// checkIncidentTypeVisibility makes sure an incident of a type that only
+// allows private incidents is created private. When the caller did not choose
+// a visibility it is set to private; an explicit public visibility is
+// rejected. Unknown types are left for the API to report.
+func (t *CreateIncidentTool) checkIncidentTypeVisibility(ctx context.Context, req *incidentio.CreateIncidentRequest, explicit bool) error {
+	types, err := t.client.ListIncidentTypes(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list incident types: %w", err)
+	}
+
+	for _, incidentType := range types.IncidentTypes {
+		if incidentType.ID != req.IncidentTypeID || !incidentType.PrivateIncidentsOnly || req.Visibility == "private" {
+			continue
+		}
+		if explicit {
+			return fmt.Errorf("incident type %s (%s) only allows private incidents. Set visibility to private or choose another incident type", incidentType.Name, incidentType.ID)
+		}
+		req.Visibility = "private"
+	}
+	return nil
+}
+
+// parseRetrospectiveOptions reads the retrospective_options argument of
+// create_incident
+func parseRetrospectiveOptions(raw map[string]interface{}) (*incidentio.RetrospectiveIncidentOptionsRequest, error) {
+	options := &incidentio.RetrospectiveIncidentOptionsRequest{}
+
+	if value, ok := raw["external_id"]; ok {
+		externalID, isNumber := value.(float64)
+		if !isNumber || externalID < 1 || externalID != math.Trunc(externalID) {
+			return nil, fmt.Errorf("retrospective_options.external_id must be a positive integer, got %v", value)
+		}
+		options.ExternalID = int64(externalID)
+	}
+	if postmortemURL, _ := raw["postmortem_document_url"].(string); postmortemURL != "" {
+		if err := validateDocumentURL(postmortemURL); err != nil {
+			return nil, err
+		}
+		options.PostmortemDocumentURL = postmortemURL
+	}
+	if slackChannelID, _ := raw["slack_channel_id"].(string); slackChannelID != "" {
+		options.SlackChannelID = slackChannelID
+	}
+
+	return options, nil
+}
+
 // UpdateIncidentTool updates an existing incident
 type UpdateIncidentTool struct {
 	client *incidentio.Client
@@ -783,7 +1798,7 @@ func (t *UpdateIncidentTool) Name() string {
 }
 
 func (t *UpdateIncidentTool) Description() string {
-	return `Update an existing incident's properties (name, summary, status, severity).
+	return `Update an existing incident's properties (name, summary, status, severity, custom fields).
 
 USAGE WORKFLOW:
 1. Get incident ID from list_incidents or get_incident
@@ -797,10 +1812,15 @@ PARAMETERS:
 - summary: Optional. New incident summary
 - incident_status_id: Optional. New status ID (from list_incident_statuses)
 - severity_id: Optional. New severity ID (from list_severities)
+- custom_fields: Optional. Map of custom field ID or name (from list_custom_fields) to an array of values
+  * Select fields: option IDs or option labels, e.g. {"01FIELD...": ["01OPTION..."]}
+  * Text, numeric and link fields: literal values, e.g. {"01FIELD...": ["Bad deploy"]}
+  * An empty array clears the field
 
 EXAMPLES:
 - Update status: {"incident_id": "01HXYZ...", "incident_status_id": "status_456"}
 - Update severity: {"incident_id": "01HXYZ...", "severity_id": "sev_789"}
+- Set a custom field: {"incident_id": "01HXYZ...", "custom_fields": {"01FIELD...": ["01OPTION..."]}}
 - Update multiple fields: {"incident_id": "01HXYZ...", "name": "Updated name", "summary": "Updated summary"}
 
 IMPORTANT: At least one field to update must be provided.`
@@ -830,13 +1850,21 @@ func (t *UpdateIncidentTool) InputSchema() map[string]interface{} {
 				"type":        "string",
 				"description": "Update the severity ID",
 			},
+			"custom_fields": map[string]interface{}{
+				"type":        "object",
+				"description": "Map of custom field ID or name to an array of values, or a single value. Use option IDs or labels for select fields and literal values for text, numeric and link fields.",
+				"additionalProperties": map[string]interface{}{
+					"type":  []string{"array", "string", "number"},
+					"items": map[string]interface{}{"type": []string{"string", "number"}},
+				},
+			},
 		},
 		"required":             []interface{}{"incident_id"},
 		"additionalProperties": false,
 	}
 }
 
-func (t *UpdateIncidentTool) Execute(args map[string]interface{}) (string, error) {
+func (t *UpdateIncidentTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
 
 	id, ok := args["incident_id"].(string)
 	if !ok || id == "" {
@@ -866,12 +1894,25 @@ func (t *UpdateIncidentTool) Execute(args map[string]interface{}) (string, error
 		req.SeverityID = severityID
 		hasUpdate = true
 	}
+	if customFields, ok := args["custom_fields"].(map[string]interface{}); ok && len(customFields) > 0 {
+		definitions, err := t.client.ListCustomFields(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to list custom fields: %w", err)
+		}
+
+		entries, err := buildCustomFieldEntries(definitions.CustomFields, customFields)
+		if err != nil {
+			return "", err
+		}
+		req.CustomFieldEntries = entries
+		hasUpdate = true
+	}
 
 	if !hasUpdate {
 		return "", fmt.Errorf("at least one field to update must be provided")
 	}
 
-	incident, err := t.client.UpdateIncident(id, req)
+	incident, err := t.client.UpdateIncident(ctx, id, req)
 	if err != nil {
 		return "", err
 	}
@@ -1,8 +1,17 @@
 package tools
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
 )
 
 // Helper function to check if a string contains a substring (case-insensitive)
@@ -10,6 +19,24 @@ func contains(s, substr string) bool {
 	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
 }
 
+// newMockServerClient starts a test server with the given handler and returns
+// a client pointed at it
+func newMockServerClient(t *testing.T, handler http.HandlerFunc) *incidentio.Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	t.Setenv("INCIDENT_IO_API_KEY", "test-key")
+	t.Setenv("INCIDENT_IO_BASE_URL", server.URL)
+
+	client, err := incidentio.NewClient()
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	return client
+}
+
 func TestCreateIncidentTool_Execute(t *testing.T) {
 	tool := &CreateIncidentTool{}
 
@@ -19,7 +46,7 @@ func TestCreateIncidentTool_Execute(t *testing.T) {
 			"summary": "Test Summary",
 		}
 
-		_, err := tool.Execute(args)
+		_, err := tool.Execute(context.Background(), args)
 		if err == nil {
 			t.Error("Expected error for missing name parameter")
 		}
@@ -34,7 +61,7 @@ func TestCreateIncidentTool_Execute(t *testing.T) {
 			"name": 123, // Not a string
 		}
 
-		_, err := tool.Execute(args)
+		_, err := tool.Execute(context.Background(), args)
 		if err == nil {
 			t.Error("Expected error for wrong type name parameter")
 		}
@@ -81,3 +108,1464 @@ func TestCreateIncidentTool_Schema(t *testing.T) {
 		t.Error("Schema should require only 'name'")
 	}
 }
+
+func TestSortIncidents_SeverityRank(t *testing.T) {
+	newIncident := func(id string, severityID string, rank int) incidentio.Incident {
+		incident := incidentio.Incident{ID: id}
+		incident.Severity.ID = severityID
+		incident.Severity.Rank = rank
+		return incident
+	}
+
+	fixtures := func() []incidentio.Incident {
+		return []incidentio.Incident{
+			newIncident("inc_minor", "sev_minor", 1),
+			newIncident("inc_none", "", 0),
+			newIncident("inc_critical", "sev_critical", 3),
+			newIncident("inc_major", "sev_major", 2),
+			newIncident("inc_major_2", "sev_major", 2),
+		}
+	}
+
+	tests := []struct {
+		name       string
+		descending bool
+		expected   []string
+	}{
+		{
+			name:       "descending",
+			descending: true,
+			expected:   []string{"inc_critical", "inc_major", "inc_major_2", "inc_minor", "inc_none"},
+		},
+		{
+			name:       "ascending",
+			descending: false,
+			expected:   []string{"inc_minor", "inc_major", "inc_major_2", "inc_critical", "inc_none"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			incidents := fixtures()
+			sortIncidents(incidents, "severity_rank", tt.descending)
+
+			for i, id := range tt.expected {
+				if incidents[i].ID != id {
+					t.Errorf("position %d: expected %s, got %s", i, id, incidents[i].ID)
+				}
+			}
+		})
+	}
+}
+
+func TestSortIncidents_UpdatedAt(t *testing.T) {
+	base := time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)
+	incidents := []incidentio.Incident{
+		{ID: "inc_middle", UpdatedAt: base.Add(time.Hour)},
+		{ID: "inc_oldest", UpdatedAt: base},
+		{ID: "inc_newest", UpdatedAt: base.Add(2 * time.Hour)},
+	}
+
+	sortIncidents(incidents, "updated_at", true)
+
+	expected := []string{"inc_newest", "inc_middle", "inc_oldest"}
+	for i, id := range expected {
+		if incidents[i].ID != id {
+			t.Errorf("position %d: expected %s, got %s", i, id, incidents[i].ID)
+		}
+	}
+}
+
+func TestListIncidentsTool_InvalidSort(t *testing.T) {
+	tool := &ListIncidentsTool{}
+
+	_, err := tool.Execute(context.Background(), map[string]interface{}{"sort": "name"})
+	if err == nil {
+		t.Fatal("Expected error for unsupported sort")
+	}
+	if !contains(err.Error(), "severity_rank") {
+		t.Errorf("Expected error to list available sorts, got: %v", err)
+	}
+
+	_, err = tool.Execute(context.Background(), map[string]interface{}{"sort": "created_at", "sort_direction": "sideways"})
+	if err == nil {
+		t.Fatal("Expected error for invalid sort_direction")
+	}
+}
+
+func TestListIncidentsTool_SortWithDirection(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       map[string]interface{}
+		wantSortBy string
+		wantOrder  []string
+	}{
+		{
+			name:       "created_at sorted by the API",
+			args:       map[string]interface{}{"sort": "created_at.asc"},
+			wantSortBy: "created_at_oldest_first",
+			wantOrder:  []string{"inc_1", "inc_2", "inc_3"},
+		},
+		{
+			name:      "updated_at sorted client-side",
+			args:      map[string]interface{}{"sort": "updated_at.desc"},
+			wantOrder: []string{"inc_2", "inc_3", "inc_1"},
+		},
+		{
+			name:      "updated_at sorted client-side with auto-pagination",
+			args:      map[string]interface{}{"sort": "updated_at.asc", "auto_paginate": true},
+			wantOrder: []string{"inc_1", "inc_3", "inc_2"},
+		},
+		{
+			name:      "matching sort_direction",
+			args:      map[string]interface{}{"sort": "updated_at.desc", "sort_direction": "desc"},
+			wantOrder: []string{"inc_2", "inc_3", "inc_1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sortBy string
+			client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+				sortBy = r.URL.Query().Get("sort_by")
+				fmt.Fprint(w, `{
+					"incidents": [
+						{"id": "inc_1", "updated_at": "2024-12-01T10:00:00Z"},
+						{"id": "inc_2", "updated_at": "2024-12-03T10:00:00Z"},
+						{"id": "inc_3", "updated_at": "2024-12-02T10:00:00Z"}
+					],
+					"pagination_meta": {"page_size": 25}
+				}`)
+			})
+
+			args := map[string]interface{}{"fields": "id"}
+			for key, value := range tt.args {
+				args[key] = value
+			}
+			result, err := NewListIncidentsTool(client).Execute(context.Background(), args)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if sortBy != tt.wantSortBy {
+				t.Errorf("expected sort_by %q, got %q", tt.wantSortBy, sortBy)
+			}
+
+			var response struct {
+				Incidents []struct {
+					ID string `json:"id"`
+				} `json:"incidents"`
+			}
+			if err := json.Unmarshal([]byte(result), &response); err != nil {
+				t.Fatalf("failed to parse result: %v\n%s", err, result)
+			}
+			var order []string
+			for _, incident := range response.Incidents {
+				order = append(order, incident.ID)
+			}
+			if !reflect.DeepEqual(order, tt.wantOrder) {
+				t.Errorf("expected order %v, got %v", tt.wantOrder, order)
+			}
+		})
+	}
+}
+
+func TestListIncidentsTool_InvalidSortWithDirection(t *testing.T) {
+	tool := &ListIncidentsTool{}
+
+	tests := []struct {
+		name    string
+		args    map[string]interface{}
+		wantErr string
+	}{
+		{name: "unknown direction", args: map[string]interface{}{"sort": "created_at.up"}, wantErr: "must be 'asc' or 'desc'"},
+		{name: "unknown field", args: map[string]interface{}{"sort": "name.asc"}, wantErr: "invalid sort 'name'"},
+		{name: "conflicting sort_direction", args: map[string]interface{}{"sort": "created_at.asc", "sort_direction": "desc"}, wantErr: "conflicts with sort_direction 'desc'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tool.Execute(context.Background(), tt.args)
+			if err == nil || !contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestListIncidentsTool_IncludeLatestUpdate(t *testing.T) {
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/incidents":
+			fmt.Fprint(w, `{
+				"incidents": [
+					{"id": "inc_1", "reference": "INC-1", "name": "Database down"},
+					{"id": "inc_2", "reference": "INC-2", "name": "API errors"},
+					{"id": "inc_3", "reference": "INC-3", "name": "Quiet incident"}
+				],
+				"pagination_meta": {"page_size": 25}
+			}`)
+		case "/incident_updates":
+			incidentID := r.URL.Query().Get("incident_id")
+			if incidentID == "inc_3" {
+				fmt.Fprint(w, `{"incident_updates": [], "pagination_meta": {"page_size": 25}}`)
+				return
+			}
+			fmt.Fprintf(w, `{
+				"incident_updates": [
+					{"id": "%[1]s_old", "incident_id": "%[1]s", "message": "Investigating", "created_at": "2024-12-01T10:00:00Z"},
+					{"id": "%[1]s_new", "incident_id": "%[1]s", "message": "Fix deployed for %[1]s", "created_at": "2024-12-01T12:00:00Z"}
+				],
+				"pagination_meta": {"page_size": 25}
+			}`, incidentID)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	tool := NewListIncidentsTool(client)
+	result, err := tool.Execute(context.Background(), map[string]interface{}{
+		"page_size":             float64(25),
+		"fields":                "id,reference",
+		"include_latest_update": true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var response struct {
+		Incidents []struct {
+			ID           string                     `json:"id"`
+			LatestUpdate *incidentio.IncidentUpdate `json:"latest_update"`
+		} `json:"incidents"`
+	}
+	if err := json.Unmarshal([]byte(result), &response); err != nil {
+		t.Fatalf("failed to parse result: %v\n%s", err, result)
+	}
+	if len(response.Incidents) != 3 {
+		t.Fatalf("expected 3 incidents, got %d", len(response.Incidents))
+	}
+
+	for _, incident := range response.Incidents[:2] {
+		if incident.LatestUpdate == nil {
+			t.Errorf("expected latest update for %s", incident.ID)
+			continue
+		}
+		if incident.LatestUpdate.ID != incident.ID+"_new" {
+			t.Errorf("expected newest update %s_new for %s, got %s", incident.ID, incident.ID, incident.LatestUpdate.ID)
+		}
+	}
+	if response.Incidents[2].LatestUpdate != nil {
+		t.Errorf("expected no latest update for inc_3, got %+v", response.Incidents[2].LatestUpdate)
+	}
+}
+
+func TestListIncidentsTool_IncludeLatestUpdateChecksEveryPage(t *testing.T) {
+	var afters []string
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/incidents":
+			fmt.Fprint(w, `{"incidents": [{"id": "inc_1", "reference": "INC-1"}], "pagination_meta": {"page_size": 25}}`)
+		case "/incident_updates":
+			after := r.URL.Query().Get("after")
+			afters = append(afters, after)
+			if after == "" {
+				fmt.Fprint(w, `{
+					"incident_updates": [
+						{"id": "upd_2", "incident_id": "inc_1", "message": "Mitigated", "created_at": "2024-12-01T11:00:00Z"},
+						{"id": "upd_1", "incident_id": "inc_1", "message": "Investigating", "created_at": "2024-12-01T10:00:00Z"}
+					],
+					"pagination_meta": {"after": "upd_1", "page_size": 2}
+				}`)
+				return
+			}
+			fmt.Fprint(w, `{
+				"incident_updates": [
+					{"id": "upd_3", "incident_id": "inc_1", "message": "Resolved", "created_at": "2024-12-01T12:00:00Z"}
+				],
+				"pagination_meta": {"page_size": 2}
+			}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	result, err := NewListIncidentsTool(client).Execute(context.Background(), map[string]interface{}{
+		"fields":                "id",
+		"include_latest_update": true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The newest update is on the second page
+	if !reflect.DeepEqual(afters, []string{"", "upd_1"}) {
+		t.Errorf("expected both pages of updates to be fetched, got afters %v", afters)
+	}
+	if !strings.Contains(result, `"id": "upd_3"`) || strings.Contains(result, `"id": "upd_2"`) {
+		t.Errorf("expected upd_3 as the latest update, got: %s", result)
+	}
+}
+
+func TestListIncidentsTool_IncludeLatestUpdateError(t *testing.T) {
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/incidents":
+			fmt.Fprint(w, `{"incidents": [{"id": "inc_1", "reference": "INC-1"}], "pagination_meta": {"page_size": 25}}`)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, `{"error": {"message": "boom"}}`)
+		}
+	})
+
+	tool := NewListIncidentsTool(client)
+	_, err := tool.Execute(context.Background(), map[string]interface{}{
+		"page_size":             float64(25),
+		"include_latest_update": true,
+	})
+	if err == nil {
+		t.Fatal("expected error when an update lookup fails")
+	}
+	if !strings.Contains(err.Error(), "INC-1") {
+		t.Errorf("expected error to name the incident, got: %v", err)
+	}
+}
+
+// newPagedIncidentsClient serves five incidents over pages of two, following
+// the after cursor, and counts the list requests made
+func newPagedIncidentsClient(t *testing.T, requests *int) *incidentio.Client {
+	t.Helper()
+
+	pages := map[string]string{
+		"":      `{"incidents": [{"id": "inc_1"}, {"id": "inc_2"}], "pagination_meta": {"after": "inc_2", "page_size": 2, "total_record_count": 5}}`,
+		"inc_2": `{"incidents": [{"id": "inc_3"}, {"id": "inc_4"}], "pagination_meta": {"after": "inc_4", "page_size": 2, "total_record_count": 5}}`,
+		"inc_4": `{"incidents": [{"id": "inc_5"}], "pagination_meta": {"page_size": 2, "total_record_count": 5}}`,
+	}
+
+	return newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/incidents" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		*requests++
+
+		page, ok := pages[r.URL.Query().Get("after")]
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		fmt.Fprint(w, page)
+	})
+}
+
+func TestListIncidentsTool_AutoPaginate(t *testing.T) {
+	requests := 0
+	tool := NewListIncidentsTool(newPagedIncidentsClient(t, &requests))
+
+	result, err := tool.Execute(context.Background(), map[string]interface{}{
+		"page_size":     float64(2),
+		"auto_paginate": true,
+		"fields":        "id",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var response struct {
+		Incidents []struct {
+			ID string `json:"id"`
+		} `json:"incidents"`
+		PaginationMeta struct {
+			TotalRecordCount int `json:"total_record_count"`
+		} `json:"pagination_meta"`
+		Truncated bool `json:"truncated"`
+	}
+	if err := json.Unmarshal([]byte(result), &response); err != nil {
+		t.Fatalf("failed to parse result: %v\n%s", err, result)
+	}
+
+	if requests != 3 {
+		t.Errorf("expected 3 page requests, got %d", requests)
+	}
+	if len(response.Incidents) != 5 {
+		t.Fatalf("expected all 5 incidents, got %d", len(response.Incidents))
+	}
+	for i, incident := range response.Incidents {
+		if expected := fmt.Sprintf("inc_%d", i+1); incident.ID != expected {
+			t.Errorf("position %d: expected %s, got %s", i, expected, incident.ID)
+		}
+	}
+	if response.Truncated {
+		t.Error("expected truncated to be false")
+	}
+	if response.PaginationMeta.TotalRecordCount != 5 {
+		t.Errorf("expected total_record_count 5, got %d", response.PaginationMeta.TotalRecordCount)
+	}
+}
+
+func TestListIncidentsTool_AutoPaginateContinuesPastFilteredPages(t *testing.T) {
+	// The mode filter runs on each page, so the first page keeps nothing
+	pages := map[string]string{
+		"":      `{"incidents": [{"id": "inc_1", "mode": "tutorial"}, {"id": "inc_2", "mode": "tutorial"}], "pagination_meta": {"after": "inc_2", "page_size": 2}}`,
+		"inc_2": `{"incidents": [{"id": "inc_3", "mode": "standard"}, {"id": "inc_4", "mode": "tutorial"}], "pagination_meta": {"page_size": 2}}`,
+	}
+	requests := 0
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, pages[r.URL.Query().Get("after")])
+	})
+
+	result, err := NewListIncidentsTool(client).Execute(context.Background(), map[string]interface{}{
+		"page_size":     float64(2),
+		"auto_paginate": true,
+		"mode":          []interface{}{"standard"},
+		"fields":        "id",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var response struct {
+		Incidents []struct {
+			ID string `json:"id"`
+		} `json:"incidents"`
+	}
+	if err := json.Unmarshal([]byte(result), &response); err != nil {
+		t.Fatalf("failed to parse result: %v\n%s", err, result)
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 page requests, got %d", requests)
+	}
+	if len(response.Incidents) != 1 || response.Incidents[0].ID != "inc_3" {
+		t.Errorf("expected only inc_3, got %+v", response.Incidents)
+	}
+}
+
+func TestListIncidentsTool_AutoPaginateTruncates(t *testing.T) {
+	requests := 0
+	tool := NewListIncidentsTool(newPagedIncidentsClient(t, &requests))
+
+	result, err := tool.Execute(context.Background(), map[string]interface{}{
+		"page_size":     float64(2),
+		"auto_paginate": true,
+		"max_results":   float64(3),
+		"fields":        "id",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var response struct {
+		Incidents []struct {
+			ID string `json:"id"`
+		} `json:"incidents"`
+		Truncated bool `json:"truncated"`
+	}
+	if err := json.Unmarshal([]byte(result), &response); err != nil {
+		t.Fatalf("failed to parse result: %v\n%s", err, result)
+	}
+
+	if requests != 2 {
+		t.Errorf("expected 2 page requests, got %d", requests)
+	}
+	if len(response.Incidents) != 3 {
+		t.Errorf("expected 3 incidents, got %d", len(response.Incidents))
+	}
+	if !response.Truncated {
+		t.Error("expected truncated to be true")
+	}
+}
+
+func TestListIncidentsTool_AutoPaginateRateLimited(t *testing.T) {
+	// Disable client retries so the rate limit reaches auto-pagination
+	t.Setenv("INCIDENT_IO_MAX_RETRIES", "0")
+
+	rateLimited := false
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		after := r.URL.Query().Get("after")
+		if after == "inc_2" && !rateLimited {
+			rateLimited = true
+			w.Header().Set("Retry-After", "7")
+			w.Header().Set("X-RateLimit-Limit", "1200")
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, `{"type": "rate_limited", "status": 429}`)
+			return
+		}
+
+		switch after {
+		case "":
+			fmt.Fprint(w, `{"incidents": [{"id": "inc_1"}, {"id": "inc_2"}], "pagination_meta": {"after": "inc_2", "page_size": 2, "total_record_count": 3}}`)
+		default:
+			fmt.Fprint(w, `{"incidents": [{"id": "inc_3"}], "pagination_meta": {"page_size": 2, "total_record_count": 3}}`)
+		}
+	})
+
+	var pauses []time.Duration
+	tool := NewListIncidentsTool(client)
+	tool.pause = func(ctx context.Context, d time.Duration) error {
+		pauses = append(pauses, d)
+		return nil
+	}
+
+	result, err := tool.Execute(context.Background(), map[string]interface{}{
+		"page_size":     float64(2),
+		"auto_paginate": true,
+		"fields":        "id",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(pauses) != 1 || pauses[0] != 7*time.Second {
+		t.Errorf("expected a single 7s pause, got %v", pauses)
+	}
+	if !strings.Contains(result, "inc_3") {
+		t.Errorf("expected incidents after the rate limit, got: %s", result)
+	}
+}
+
+func TestListIncidentsTool_AutoPaginateTimeoutPerPage(t *testing.T) {
+	// Each page is well within the timeout, but all pages together exceed it
+	t.Setenv("INCIDENT_IO_HTTP_TIMEOUT", "150ms")
+
+	pages := map[string]string{
+		"":      `{"incidents": [{"id": "inc_1"}], "pagination_meta": {"after": "inc_1", "page_size": 1, "total_record_count": 3}}`,
+		"inc_1": `{"incidents": [{"id": "inc_2"}], "pagination_meta": {"after": "inc_2", "page_size": 1, "total_record_count": 3}}`,
+		"inc_2": `{"incidents": [{"id": "inc_3"}], "pagination_meta": {"page_size": 1, "total_record_count": 3}}`,
+	}
+	requests := 0
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		time.Sleep(75 * time.Millisecond)
+		fmt.Fprint(w, pages[r.URL.Query().Get("after")])
+	})
+
+	result, err := NewListIncidentsTool(client).Execute(context.Background(), map[string]interface{}{
+		"page_size":     float64(1),
+		"auto_paginate": true,
+		"fields":        "id",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requests != 3 || !strings.Contains(result, "inc_3") {
+		t.Errorf("expected all 3 pages to be fetched, got %d requests:\n%s", requests, result)
+	}
+}
+
+func TestListIncidentsTool_AutoPaginateStuckCursor(t *testing.T) {
+	// The second page points back at its own cursor, which would loop forever
+	requests := 0
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests > 5 {
+			t.Fatalf("pagination did not stop, %d requests made", requests)
+		}
+		switch r.URL.Query().Get("after") {
+		case "":
+			fmt.Fprint(w, `{"incidents": [{"id": "inc_1"}, {"id": "inc_2"}], "pagination_meta": {"after": "inc_2", "page_size": 2}}`)
+		default:
+			fmt.Fprint(w, `{"incidents": [{"id": "inc_3"}, {"id": "inc_4"}], "pagination_meta": {"after": "inc_2", "page_size": 2}}`)
+		}
+	})
+
+	_, err := NewListIncidentsTool(client).Execute(context.Background(), map[string]interface{}{
+		"page_size":     float64(2),
+		"auto_paginate": true,
+	})
+	if err == nil {
+		t.Fatal("expected an error for a repeated cursor")
+	}
+	if !strings.Contains(err.Error(), `returned cursor "inc_2" again`) {
+		t.Errorf("expected error to name the repeated cursor, got: %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 page requests, got %d", requests)
+	}
+}
+
+func TestListIncidentsTool_InvalidMaxResults(t *testing.T) {
+	tool := &ListIncidentsTool{}
+
+	_, err := tool.Execute(context.Background(), map[string]interface{}{
+		"auto_paginate": true,
+		"max_results":   float64(0),
+	})
+	if err == nil {
+		t.Fatal("expected error for max_results of 0")
+	}
+}
+
+func TestListIncidentsTool_ModeFilter(t *testing.T) {
+	var modeParams []string
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		modeParams = r.URL.Query()["mode[one_of]"]
+		fmt.Fprint(w, `{
+			"incidents": [
+				{"id": "inc_real", "mode": "standard"},
+				{"id": "inc_retro", "mode": "retrospective"}
+			],
+			"pagination_meta": {"page_size": 25, "total_record_count": 2}
+		}`)
+	})
+	tool := NewListIncidentsTool(client)
+
+	t.Run("filters by standard", func(t *testing.T) {
+		result, err := tool.Execute(context.Background(), map[string]interface{}{
+			"mode":      []interface{}{"standard"},
+			"page_size": float64(25),
+			"fields":    "id",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(modeParams) != 1 || modeParams[0] != "standard" {
+			t.Errorf("expected mode[one_of]=standard, got %v", modeParams)
+		}
+
+		var response struct {
+			Incidents []struct {
+				ID string `json:"id"`
+			} `json:"incidents"`
+		}
+		if err := json.Unmarshal([]byte(result), &response); err != nil {
+			t.Fatalf("failed to parse result: %v", err)
+		}
+		if len(response.Incidents) != 1 || response.Incidents[0].ID != "inc_real" {
+			t.Errorf("expected only inc_real, got %+v", response.Incidents)
+		}
+	})
+
+	t.Run("accepts comma-separated modes", func(t *testing.T) {
+		_, err := tool.Execute(context.Background(), map[string]interface{}{
+			"mode":      "Standard, retrospective",
+			"page_size": float64(25),
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(modeParams) != 2 || modeParams[0] != "standard" || modeParams[1] != "retrospective" {
+			t.Errorf("expected mode[one_of]=standard&mode[one_of]=retrospective, got %v", modeParams)
+		}
+	})
+
+	t.Run("rejects unknown mode", func(t *testing.T) {
+		_, err := tool.Execute(context.Background(), map[string]interface{}{"mode": "drill"})
+		if err == nil || !strings.Contains(err.Error(), "invalid mode 'drill'") || !strings.Contains(err.Error(), "standard, retrospective, tutorial") {
+			t.Fatalf("expected invalid mode error listing modes, got %v", err)
+		}
+	})
+}
+
+func TestListIncidentsTool_CustomFieldFilter(t *testing.T) {
+	const (
+		teamFieldID   = "01J0TEAMF1E7D0000000000000"
+		engineeringID = "01J0PT10NENG00000000000000"
+		sreID         = "01J0PT10NSRE00000000000000"
+	)
+
+	var filterParams []string
+	var fieldLookups int
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/custom_fields/" + teamFieldID:
+			fieldLookups++
+			fmt.Fprintf(w, `{"custom_field": {"id": %q, "name": "Affected Team", "field_type": "multi_select", "options": [
+				{"id": %[2]q, "custom_field_id": %[1]q, "value": "Engineering"},
+				{"id": %[3]q, "custom_field_id": %[1]q, "value": "SRE"}
+			]}}`, teamFieldID, engineeringID, sreID)
+		case "/incidents":
+			filterParams = r.URL.Query()["custom_field["+teamFieldID+"][one_of]"]
+			fmt.Fprint(w, `{"incidents": [], "pagination_meta": {"page_size": 25, "total_record_count": 0}}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	tool := NewListIncidentsTool(client)
+
+	t.Run("maps labels to option IDs", func(t *testing.T) {
+		fieldLookups = 0
+		_, err := tool.Execute(context.Background(), map[string]interface{}{
+			"custom_field_id":    teamFieldID,
+			"custom_field_value": "engineering, SRE",
+			"page_size":          float64(25),
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(filterParams, []string{engineeringID, sreID}) {
+			t.Errorf("expected custom_field[%s][one_of] to be the option IDs, got %v", teamFieldID, filterParams)
+		}
+		if fieldLookups != 1 {
+			t.Errorf("expected the custom field to be fetched once, got %d", fieldLookups)
+		}
+	})
+
+	t.Run("passes option IDs through", func(t *testing.T) {
+		fieldLookups = 0
+		_, err := tool.Execute(context.Background(), map[string]interface{}{
+			"custom_field_id":    teamFieldID,
+			"custom_field_value": []interface{}{sreID},
+			"page_size":          float64(25),
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(filterParams, []string{sreID}) {
+			t.Errorf("expected custom_field[%s][one_of]=%s, got %v", teamFieldID, sreID, filterParams)
+		}
+		if fieldLookups != 0 {
+			t.Errorf("expected no custom field lookup for option IDs, got %d", fieldLookups)
+		}
+	})
+
+	t.Run("rejects an unknown label", func(t *testing.T) {
+		_, err := tool.Execute(context.Background(), map[string]interface{}{
+			"custom_field_id":    teamFieldID,
+			"custom_field_value": "Marketing",
+		})
+		if err == nil || !strings.Contains(err.Error(), "invalid option 'Marketing'") ||
+			!strings.Contains(err.Error(), "Engineering") || !strings.Contains(err.Error(), "SRE") {
+			t.Fatalf("expected unknown label error listing the options, got %v", err)
+		}
+	})
+
+	t.Run("requires both arguments", func(t *testing.T) {
+		_, err := tool.Execute(context.Background(), map[string]interface{}{"custom_field_value": "SRE"})
+		if err == nil || !strings.Contains(err.Error(), "must be given together") {
+			t.Fatalf("expected missing custom_field_id error, got %v", err)
+		}
+	})
+}
+
+const updateIncidentCustomFields = `{
+	"custom_fields": [
+		{"id": "cf_team", "name": "Affected Team", "field_type": "single_select", "required": "never",
+			"options": [
+				{"id": "opt_payments", "custom_field_id": "cf_team", "value": "Payments"},
+				{"id": "opt_search", "custom_field_id": "cf_team", "value": "Search"}
+			]},
+		{"id": "cf_root_cause", "name": "Root Cause", "field_type": "text", "required": "before_closure"}
+	]
+}`
+
+func TestUpdateIncidentTool_CustomFields(t *testing.T) {
+	tests := []struct {
+		name           string
+		customFields   map[string]interface{}
+		expectedValues map[string][]map[string]interface{}
+		errorContains  []string
+	}{
+		{
+			name:         "single_select by option ID",
+			customFields: map[string]interface{}{"cf_team": []interface{}{"opt_search"}},
+			expectedValues: map[string][]map[string]interface{}{
+				"cf_team": {{"value_option_id": "opt_search"}},
+			},
+		},
+		{
+			name:         "text field",
+			customFields: map[string]interface{}{"cf_root_cause": []interface{}{"Bad deploy"}},
+			expectedValues: map[string][]map[string]interface{}{
+				"cf_root_cause": {{"value_text": "Bad deploy"}},
+			},
+		},
+		{
+			name:          "invalid select option",
+			customFields:  map[string]interface{}{"cf_team": []interface{}{"opt_missing"}},
+			errorContains: []string{"invalid option 'opt_missing'", "opt_payments (Payments)", "opt_search (Search)"},
+		},
+		{
+			name:          "unknown custom field",
+			customFields:  map[string]interface{}{"cf_missing": []interface{}{"x"}},
+			errorContains: []string{"unknown custom field 'cf_missing'", "cf_team (Affected Team)"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var editBody struct {
+				Incident struct {
+					CustomFieldEntries []struct {
+						CustomFieldID string                   `json:"custom_field_id"`
+						Values        []map[string]interface{} `json:"values"`
+					} `json:"custom_field_entries"`
+				} `json:"incident"`
+			}
+			editRequests := 0
+
+			client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/custom_fields":
+					fmt.Fprint(w, updateIncidentCustomFields)
+				case "/incidents/inc_1/actions/edit":
+					editRequests++
+					if err := json.NewDecoder(r.Body).Decode(&editBody); err != nil {
+						t.Errorf("failed to decode request body: %v", err)
+					}
+					fmt.Fprint(w, `{"incident": {"id": "inc_1", "name": "Database outage"}}`)
+				default:
+					t.Errorf("unexpected request: %s", r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+
+			_, err := NewUpdateIncidentTool(client).Execute(context.Background(), map[string]interface{}{
+				"incident_id":   "inc_1",
+				"custom_fields": tt.customFields,
+			})
+
+			if len(tt.errorContains) > 0 {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				for _, expected := range tt.errorContains {
+					if !strings.Contains(err.Error(), expected) {
+						t.Errorf("expected error to contain %q, got: %v", expected, err)
+					}
+				}
+				if editRequests != 0 {
+					t.Errorf("expected no edit requests, got %d", editRequests)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			entries := editBody.Incident.CustomFieldEntries
+			if len(entries) != len(tt.expectedValues) {
+				t.Fatalf("expected %d custom field entries, got %+v", len(tt.expectedValues), entries)
+			}
+			for _, entry := range entries {
+				expected, ok := tt.expectedValues[entry.CustomFieldID]
+				if !ok {
+					t.Errorf("unexpected custom field entry %s", entry.CustomFieldID)
+					continue
+				}
+				if fmt.Sprint(entry.Values) != fmt.Sprint(expected) {
+					t.Errorf("custom field %s: expected values %v, got %v", entry.CustomFieldID, expected, entry.Values)
+				}
+			}
+		})
+	}
+}
+
+func TestCreateIncidentTool_RoleAssignments(t *testing.T) {
+	tests := []struct {
+		name          string
+		assignment    map[string]interface{}
+		expected      incidentio.CreateRoleAssignmentRequest
+		errorContains []string
+	}{
+		{
+			name:       "commander by email",
+			assignment: map[string]interface{}{"role_name": "incident commander", "user_email": "jane@example.com"},
+			expected:   incidentio.CreateRoleAssignmentRequest{IncidentRoleID: "role_commander", UserID: "user_jane"},
+		},
+		{
+			name:       "commander by raw IDs",
+			assignment: map[string]interface{}{"incident_role_id": "role_commander", "user_id": "user_bob"},
+			expected:   incidentio.CreateRoleAssignmentRequest{IncidentRoleID: "role_commander", UserID: "user_bob"},
+		},
+		{
+			name:          "unknown role name",
+			assignment:    map[string]interface{}{"role_name": "Scribe", "user_id": "user_bob"},
+			errorContains: []string{"no incident role named 'Scribe'", "Incident Commander (role_commander)"},
+		},
+		{
+			name:          "missing user",
+			assignment:    map[string]interface{}{"incident_role_id": "role_commander"},
+			errorContains: []string{"either user_id or user_email is required"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var created incidentio.CreateIncidentRequest
+			createRequests := 0
+
+			client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/incident_roles":
+					fmt.Fprint(w, `{"incident_roles": [
+						{"id": "role_commander", "name": "Incident Commander", "shortform": "commander", "role_type": "lead", "required": true},
+						{"id": "role_comms", "name": "Communications Lead", "shortform": "comms", "role_type": "custom"}
+					]}`)
+				case "/users":
+					fmt.Fprint(w, `{"users": [{"id": "user_jane", "name": "Jane Doe", "email": "jane@example.com"}]}`)
+				case "/incidents":
+					createRequests++
+					if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+						t.Errorf("failed to decode request body: %v", err)
+					}
+					fmt.Fprint(w, `{"incident": {"id": "inc_1", "name": "Database outage"}}`)
+				default:
+					t.Errorf("unexpected request: %s", r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+
+			result, err := NewCreateIncidentTool(client).Execute(context.Background(), map[string]interface{}{
+				"name":             "Database outage",
+				"role_assignments": []interface{}{tt.assignment},
+			})
+
+			if len(tt.errorContains) > 0 {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				for _, expected := range tt.errorContains {
+					if !strings.Contains(err.Error(), expected) {
+						t.Errorf("expected error to contain %q, got: %v", expected, err)
+					}
+				}
+				if createRequests != 0 {
+					t.Errorf("expected no create requests, got %d", createRequests)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(created.IncidentRoleAssignments) != 1 || created.IncidentRoleAssignments[0] != tt.expected {
+				t.Errorf("expected role assignments [%+v], got %+v", tt.expected, created.IncidentRoleAssignments)
+			}
+			if strings.Contains(result, "Required roles not assigned") {
+				t.Errorf("expected no missing required roles note, got: %s", result)
+			}
+		})
+	}
+}
+
+func TestCreateIncidentTool_IdempotencyKey(t *testing.T) {
+	var keys []string
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body incidentio.CreateIncidentRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode request body: %v", err)
+		}
+		keys = append(keys, body.IdempotencyKey)
+		fmt.Fprint(w, `{"incident": {"id": "inc_1", "name": "Database outage"}}`)
+	})
+	tool := NewCreateIncidentTool(client)
+
+	create := func(args map[string]interface{}) {
+		t.Helper()
+		args["name"] = "Database outage"
+		if _, err := tool.Execute(context.Background(), args); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	// A caller-provided key is sent verbatim on every retry
+	create(map[string]interface{}{"idempotency_key": "deploy-42-outage"})
+	create(map[string]interface{}{"idempotency_key": "deploy-42-outage"})
+	// Without one, each call gets its own generated key
+	create(map[string]interface{}{})
+	create(map[string]interface{}{})
+
+	if len(keys) != 4 {
+		t.Fatalf("expected 4 create requests, got %d", len(keys))
+	}
+	if keys[0] != "deploy-42-outage" || keys[1] != "deploy-42-outage" {
+		t.Errorf("expected provided key to be sent, got %q and %q", keys[0], keys[1])
+	}
+	if keys[2] == "" || keys[2] == keys[3] {
+		t.Errorf("expected unique generated keys, got %q and %q", keys[2], keys[3])
+	}
+}
+
+func TestCreateIncidentTool_CustomFields(t *testing.T) {
+	tests := []struct {
+		name          string
+		customFields  map[string]interface{}
+		expected      []incidentio.CustomFieldEntryRequest
+		errorContains []string
+	}{
+		{
+			name:         "single_select by field name and option label",
+			customFields: map[string]interface{}{"affected team": "payments"},
+			expected: []incidentio.CustomFieldEntryRequest{
+				{CustomFieldID: "cf_team", Values: []interface{}{map[string]interface{}{"value_option_id": "opt_payments"}}},
+			},
+		},
+		{
+			name:         "field ID and name mixed",
+			customFields: map[string]interface{}{"cf_team": []interface{}{"opt_search"}, "Root Cause": []interface{}{"Bad deploy"}},
+			expected: []incidentio.CustomFieldEntryRequest{
+				{CustomFieldID: "cf_root_cause", Values: []interface{}{map[string]interface{}{"value_text": "Bad deploy"}}},
+				{CustomFieldID: "cf_team", Values: []interface{}{map[string]interface{}{"value_option_id": "opt_search"}}},
+			},
+		},
+		{
+			name:          "unknown option label",
+			customFields:  map[string]interface{}{"Affected Team": "Checkout"},
+			errorContains: []string{"invalid option 'Checkout'", "opt_payments (Payments)"},
+		},
+		{
+			name:          "same field by ID and name",
+			customFields:  map[string]interface{}{"cf_team": "opt_search", "Affected Team": "Payments"},
+			errorContains: []string{"custom field Affected Team (cf_team) is set twice"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var created []incidentio.CreateIncidentRequest
+			client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/custom_fields":
+					fmt.Fprint(w, updateIncidentCustomFields)
+				case "/incidents":
+					var body incidentio.CreateIncidentRequest
+					if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+						t.Errorf("failed to decode request body: %v", err)
+					}
+					created = append(created, body)
+					fmt.Fprint(w, `{"incident": {"id": "inc_1", "name": "Checkout errors"}}`)
+				default:
+					t.Errorf("unexpected request: %s", r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+
+			_, err := NewCreateIncidentTool(client).Execute(context.Background(), map[string]interface{}{
+				"name":          "Checkout errors",
+				"custom_fields": tt.customFields,
+			})
+
+			if len(tt.errorContains) > 0 {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				for _, expected := range tt.errorContains {
+					if !strings.Contains(err.Error(), expected) {
+						t.Errorf("expected error to contain %q, got: %v", expected, err)
+					}
+				}
+				if len(created) != 0 {
+					t.Errorf("expected no create requests, got %d", len(created))
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(created) != 1 {
+				t.Fatalf("expected 1 create request, got %d", len(created))
+			}
+			if !reflect.DeepEqual(created[0].CustomFieldEntries, tt.expected) {
+				t.Errorf("expected custom field entries %+v, got %+v", tt.expected, created[0].CustomFieldEntries)
+			}
+		})
+	}
+}
+
+func TestCreateIncidentTool_RetrospectiveOptions(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		expected      *incidentio.RetrospectiveIncidentOptionsRequest
+		errorContains string
+	}{
+		{
+			name: "retrospective incident with a postmortem",
+			args: map[string]interface{}{
+				"mode":          "retrospective",
+				"slack_team_id": "T0123ABCDEF",
+				"retrospective_options": map[string]interface{}{
+					"postmortem_document_url": "https://docs.example.com/postmortems/checkout",
+					"external_id":             float64(42),
+				},
+			},
+			expected: &incidentio.RetrospectiveIncidentOptionsRequest{
+				ExternalID:            42,
+				PostmortemDocumentURL: "https://docs.example.com/postmortems/checkout",
+			},
+		},
+		{
+			name: "options without retrospective mode",
+			args: map[string]interface{}{
+				"retrospective_options": map[string]interface{}{"postmortem_document_url": "https://docs.example.com/postmortems/checkout"},
+			},
+			errorContains: "retrospective_options can only be used with mode retrospective, got mode 'standard'",
+		},
+		{
+			name: "relative postmortem URL",
+			args: map[string]interface{}{
+				"mode":                  "retrospective",
+				"retrospective_options": map[string]interface{}{"postmortem_document_url": "postmortems/checkout"},
+			},
+			errorContains: "postmortem_document_url must be an absolute http or https URL",
+		},
+		{
+			name: "fractional external ID",
+			args: map[string]interface{}{
+				"mode":                  "retrospective",
+				"retrospective_options": map[string]interface{}{"external_id": 4.5},
+			},
+			errorContains: "external_id must be a positive integer",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var created []incidentio.CreateIncidentRequest
+			client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+				var body incidentio.CreateIncidentRequest
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Errorf("failed to decode request body: %v", err)
+				}
+				created = append(created, body)
+				fmt.Fprint(w, `{"incident": {"id": "inc_1", "name": "November checkout outage", "mode": "retrospective"}}`)
+			})
+
+			tt.args["name"] = "November checkout outage"
+			_, err := NewCreateIncidentTool(client).Execute(context.Background(), tt.args)
+
+			if tt.errorContains != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errorContains) {
+					t.Fatalf("expected error containing %q, got: %v", tt.errorContains, err)
+				}
+				if len(created) != 0 {
+					t.Errorf("expected no create requests, got %d", len(created))
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(created) != 1 {
+				t.Fatalf("expected 1 create request, got %d", len(created))
+			}
+			if created[0].Mode != "retrospective" || created[0].SlackTeamID != "T0123ABCDEF" {
+				t.Errorf("expected a retrospective incident in team T0123ABCDEF, got mode %q and team %q", created[0].Mode, created[0].SlackTeamID)
+			}
+			if !reflect.DeepEqual(created[0].RetrospectiveIncidentOptions, tt.expected) {
+				t.Errorf("expected retrospective options %+v, got %+v", tt.expected, created[0].RetrospectiveIncidentOptions)
+			}
+		})
+	}
+}
+
+func TestCreateIncidentTool_ModeAndVisibility(t *testing.T) {
+	const incidentTypes = `{"incident_types": [
+		{"id": "type_security", "name": "Security", "private_incidents_only": true},
+		{"id": "type_production", "name": "Production", "private_incidents_only": false}
+	]}`
+
+	tests := []struct {
+		name               string
+		args               map[string]interface{}
+		expectedVisibility string
+		errorContains      []string
+	}{
+		{
+			name:          "invalid mode",
+			args:          map[string]interface{}{"mode": "retro"},
+			errorContains: []string{"invalid mode 'retro'", "standard, retrospective, tutorial"},
+		},
+		{
+			name:          "invalid visibility",
+			args:          map[string]interface{}{"visibility": "internal"},
+			errorContains: []string{"invalid visibility 'internal'", "public, private"},
+		},
+		{
+			name:               "private incident",
+			args:               map[string]interface{}{"visibility": "Private", "incident_type_id": "type_production"},
+			expectedVisibility: "private",
+		},
+		{
+			name:               "private-only type defaults to private",
+			args:               map[string]interface{}{"incident_type_id": "type_security"},
+			expectedVisibility: "private",
+		},
+		{
+			name:          "private-only type rejects public",
+			args:          map[string]interface{}{"visibility": "public", "incident_type_id": "type_security"},
+			errorContains: []string{"incident type Security (type_security) only allows private incidents"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var created []incidentio.CreateIncidentRequest
+			client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/incident_types":
+					fmt.Fprint(w, incidentTypes)
+				case "/incidents":
+					var body incidentio.CreateIncidentRequest
+					if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+						t.Errorf("failed to decode request body: %v", err)
+					}
+					created = append(created, body)
+					fmt.Fprint(w, `{"incident": {"id": "inc_1", "name": "Leaked credentials"}}`)
+				default:
+					t.Errorf("unexpected request: %s", r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+
+			tt.args["name"] = "Leaked credentials"
+			_, err := NewCreateIncidentTool(client).Execute(context.Background(), tt.args)
+
+			if len(tt.errorContains) > 0 {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				for _, expected := range tt.errorContains {
+					if !strings.Contains(err.Error(), expected) {
+						t.Errorf("expected error to contain %q, got: %v", expected, err)
+					}
+				}
+				if len(created) != 0 {
+					t.Errorf("expected no create requests, got %d", len(created))
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(created) != 1 || created[0].Visibility != tt.expectedVisibility {
+				t.Fatalf("expected one create request with visibility %s, got %+v", tt.expectedVisibility, created)
+			}
+		})
+	}
+}
+
+func TestGetIncidentTool_IncludeAlerts(t *testing.T) {
+	var alertQueries []string
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/incidents/123":
+			fmt.Fprint(w, `{"incident": {"id": "01HXYZINCIDENT0000000000000", "reference": "INC-123", "name": "Checkout errors"}}`)
+		case "/alerts":
+			alertQueries = append(alertQueries, r.URL.Query().Get("incident_id"))
+			fmt.Fprint(w, `{
+				"alerts": [
+					{"id": "alert_1", "title": "5xx rate above 5%", "status": "firing"},
+					{"id": "alert_2", "title": "Checkout latency p99", "status": "resolved"}
+				],
+				"pagination_meta": {"page_size": 50}
+			}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	tool := NewGetIncidentTool(client)
+
+	t.Run("embeds alerts when requested", func(t *testing.T) {
+		alertQueries = nil
+		result, err := tool.Execute(context.Background(), map[string]interface{}{
+			"incident_id":    "INC-123",
+			"include_alerts": true,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		// The alerts are filtered by the incident's ID, not the reference
+		if !reflect.DeepEqual(alertQueries, []string{"01HXYZINCIDENT0000000000000"}) {
+			t.Errorf("expected alerts to be listed for the incident ID, got %v", alertQueries)
+		}
+
+		var response struct {
+			ID       string `json:"id"`
+			Duration string `json:"duration"`
+			Alerts   []struct {
+				ID    string `json:"id"`
+				Title string `json:"title"`
+			} `json:"alerts"`
+		}
+		if err := json.Unmarshal([]byte(result), &response); err != nil {
+			t.Fatalf("failed to parse result: %v\n%s", err, result)
+		}
+		if response.ID != "01HXYZINCIDENT0000000000000" || response.Duration == "" {
+			t.Errorf("expected the incident fields alongside the alerts, got %+v", response)
+		}
+		if len(response.Alerts) != 2 || response.Alerts[0].ID != "alert_1" || response.Alerts[1].Title != "Checkout latency p99" {
+			t.Errorf("expected both alerts to be embedded, got %+v", response.Alerts)
+		}
+	})
+
+	t.Run("fields filter the embedded alerts", func(t *testing.T) {
+		result, err := tool.Execute(context.Background(), map[string]interface{}{
+			"incident_id":    "INC-123",
+			"include_alerts": true,
+			"fields":         "reference,alerts.title",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		var response map[string]interface{}
+		if err := json.Unmarshal([]byte(result), &response); err != nil {
+			t.Fatalf("failed to parse result: %v\n%s", err, result)
+		}
+		want := map[string]interface{}{
+			"reference": "INC-123",
+			"alerts": []interface{}{
+				map[string]interface{}{"title": "5xx rate above 5%"},
+				map[string]interface{}{"title": "Checkout latency p99"},
+			},
+		}
+		if !reflect.DeepEqual(response, want) {
+			t.Errorf("expected %v, got %v", want, response)
+		}
+	})
+
+	t.Run("omits alerts by default", func(t *testing.T) {
+		alertQueries = nil
+		result, err := tool.Execute(context.Background(), map[string]interface{}{"incident_id": "INC-123"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(alertQueries) != 0 {
+			t.Errorf("expected no alert requests, got %v", alertQueries)
+		}
+
+		var response map[string]interface{}
+		if err := json.Unmarshal([]byte(result), &response); err != nil {
+			t.Fatalf("failed to parse result: %v\n%s", err, result)
+		}
+		if _, ok := response["alerts"]; ok {
+			t.Errorf("expected no alerts key, got %v", response["alerts"])
+		}
+	})
+
+	t.Run("rejects the summary format", func(t *testing.T) {
+		_, err := tool.Execute(context.Background(), map[string]interface{}{
+			"incident_id":    "INC-123",
+			"format":         "summary",
+			"include_alerts": true,
+		})
+		if err == nil || !strings.Contains(err.Error(), "can only be used with the json format") {
+			t.Errorf("expected a format error, got %v", err)
+		}
+	})
+}
+
+func TestGetIncidentTool_IncludeFollowUpsAndActions(t *testing.T) {
+	const incidentID = "01HXYZINCIDENT0000000000000"
+
+	var requested []string
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/incidents/" + incidentID:
+			fmt.Fprintf(w, `{"incident": {"id": %q, "reference": "INC-123", "name": "Checkout errors"}}`, incidentID)
+		case "/follow_ups":
+			requested = append(requested, "follow_ups")
+			if got := r.URL.Query().Get("incident_id"); got != incidentID {
+				t.Errorf("expected follow-ups for %s, got %q", incidentID, got)
+			}
+			fmt.Fprint(w, `{"follow_ups": [{"id": "fu_1", "title": "Add checkout alerting", "status": "outstanding", "description": "Long text"}]}`)
+		case "/actions":
+			requested = append(requested, "actions")
+			if got := r.URL.Query().Get("incident_id"); got != incidentID {
+				t.Errorf("expected actions for %s, got %q", incidentID, got)
+			}
+			fmt.Fprint(w, `{"actions": [{"id": "act_1", "description": "Roll back deploy", "status": "completed"}], "pagination_meta": {"page_size": 250}}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	tool := NewGetIncidentTool(client)
+
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		wantRequested []string
+		wantKeys      []string
+		wantMissing   []string
+	}{
+		{
+			name:          "follow-ups only",
+			args:          map[string]interface{}{"include_follow_ups": true},
+			wantRequested: []string{"follow_ups"},
+			wantKeys:      []string{"follow_ups"},
+			wantMissing:   []string{"actions", "alerts"},
+		},
+		{
+			name:          "actions only",
+			args:          map[string]interface{}{"include_actions": true},
+			wantRequested: []string{"actions"},
+			wantKeys:      []string{"actions"},
+			wantMissing:   []string{"follow_ups", "alerts"},
+		},
+		{
+			name:          "both",
+			args:          map[string]interface{}{"include_follow_ups": true, "include_actions": true},
+			wantRequested: []string{"follow_ups", "actions"},
+			wantKeys:      []string{"follow_ups", "actions"},
+			wantMissing:   []string{"alerts"},
+		},
+		{
+			name:        "neither",
+			args:        map[string]interface{}{},
+			wantMissing: []string{"follow_ups", "actions", "alerts"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requested = nil
+			tt.args["incident_id"] = incidentID
+			result, err := tool.Execute(context.Background(), tt.args)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(requested, tt.wantRequested) {
+				t.Errorf("expected requests %v, got %v", tt.wantRequested, requested)
+			}
+
+			var response map[string]interface{}
+			if err := json.Unmarshal([]byte(result), &response); err != nil {
+				t.Fatalf("failed to parse result: %v\n%s", err, result)
+			}
+			for _, key := range tt.wantKeys {
+				if items, ok := response[key].([]interface{}); !ok || len(items) != 1 {
+					t.Errorf("expected one item under %s, got %v", key, response[key])
+				}
+			}
+			for _, key := range tt.wantMissing {
+				if _, ok := response[key]; ok {
+					t.Errorf("expected no %s key, got %v", key, response[key])
+				}
+			}
+		})
+	}
+
+	t.Run("fields filter the embedded items", func(t *testing.T) {
+		result, err := tool.Execute(context.Background(), map[string]interface{}{
+			"incident_id":        incidentID,
+			"include_follow_ups": true,
+			"fields":             "id,follow_ups.title",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		var response map[string]interface{}
+		if err := json.Unmarshal([]byte(result), &response); err != nil {
+			t.Fatalf("failed to parse result: %v\n%s", err, result)
+		}
+		want := map[string]interface{}{
+			"id":         incidentID,
+			"follow_ups": []interface{}{map[string]interface{}{"title": "Add checkout alerting"}},
+		}
+		if !reflect.DeepEqual(response, want) {
+			t.Errorf("expected %v, got %v", want, response)
+		}
+	})
+}
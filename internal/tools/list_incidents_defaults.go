@@ -0,0 +1,50 @@
+package tools
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/logging"
+)
+
+const (
+	// defaultIncidentListFields are the fields list_incidents returns when
+	// neither the fields argument nor INCIDENT_IO_DEFAULT_FIELDS is set
+	defaultIncidentListFields = "id,reference,name,permalink,created_at,updated_at,slack_channel_id"
+	// maxIncidentsPageSize is the largest page of incidents the API returns
+	maxIncidentsPageSize = 250
+)
+
+// listIncidentsDefaults are what list_incidents uses when its arguments omit
+// page_size or fields. A page size of 0 fetches every page.
+type listIncidentsDefaults struct {
+	pageSize int
+	fields   string
+}
+
+// listIncidentsDefaultsFromEnv reads INCIDENT_IO_DEFAULT_PAGE_SIZE and
+// INCIDENT_IO_DEFAULT_FIELDS. Invalid values are logged and the built-in
+// default is used, so a typo does not stop the server from starting.
+func listIncidentsDefaultsFromEnv() listIncidentsDefaults {
+	defaults := listIncidentsDefaults{fields: defaultIncidentListFields}
+
+	if value := strings.TrimSpace(os.Getenv("INCIDENT_IO_DEFAULT_PAGE_SIZE")); value != "" {
+		pageSize, err := strconv.Atoi(value)
+		if err != nil || pageSize < 0 || pageSize > maxIncidentsPageSize {
+			logging.Warnf("INCIDENT_IO_DEFAULT_PAGE_SIZE must be an integer between 0 and %d, got %q. Fetching every page by default", maxIncidentsPageSize, value)
+		} else {
+			defaults.pageSize = pageSize
+		}
+	}
+
+	if value := strings.TrimSpace(os.Getenv("INCIDENT_IO_DEFAULT_FIELDS")); value != "" {
+		if _, err := parseFieldList(value); err != nil {
+			logging.Warnf("Invalid INCIDENT_IO_DEFAULT_FIELDS %q: %v. Using %s", value, err, defaultIncidentListFields)
+		} else {
+			defaults.fields = value
+		}
+	}
+
+	return defaults
+}
@@ -0,0 +1,133 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestListIncidentsDefaultsFromEnv(t *testing.T) {
+	tests := []struct {
+		name     string
+		pageSize string
+		fields   string
+		expected listIncidentsDefaults
+	}{
+		{
+			name:     "unset",
+			expected: listIncidentsDefaults{fields: defaultIncidentListFields},
+		},
+		{
+			name:     "valid values",
+			pageSize: "10",
+			fields:   "id,name",
+			expected: listIncidentsDefaults{pageSize: 10, fields: "id,name"},
+		},
+		{
+			name:     "page size above the API maximum",
+			pageSize: "500",
+			expected: listIncidentsDefaults{fields: defaultIncidentListFields},
+		},
+		{
+			name:     "negative page size",
+			pageSize: "-1",
+			expected: listIncidentsDefaults{fields: defaultIncidentListFields},
+		},
+		{
+			name:     "non-numeric page size",
+			pageSize: "ten",
+			expected: listIncidentsDefaults{fields: defaultIncidentListFields},
+		},
+		{
+			name:     "invalid fields",
+			fields:   "id,-name",
+			expected: listIncidentsDefaults{fields: defaultIncidentListFields},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("INCIDENT_IO_DEFAULT_PAGE_SIZE", tt.pageSize)
+			t.Setenv("INCIDENT_IO_DEFAULT_FIELDS", tt.fields)
+
+			if got := listIncidentsDefaultsFromEnv(); got != tt.expected {
+				t.Errorf("expected %+v, got %+v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestListIncidentsTool_EnvDefaults(t *testing.T) {
+	tests := []struct {
+		name             string
+		args             map[string]interface{}
+		expectedPageSize string
+		expectedFields   []string
+	}{
+		{
+			name:             "env defaults apply when args omit them",
+			args:             map[string]interface{}{},
+			expectedPageSize: "10",
+			expectedFields:   []string{"id", "name"},
+		},
+		{
+			name:             "explicit args override env defaults",
+			args:             map[string]interface{}{"page_size": float64(50), "fields": "id,reference"},
+			expectedPageSize: "50",
+			expectedFields:   []string{"id", "reference"},
+		},
+		{
+			name:             "auto_paginate uses the largest pages",
+			args:             map[string]interface{}{"auto_paginate": true},
+			expectedPageSize: "250",
+			expectedFields:   []string{"id", "name"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("INCIDENT_IO_DEFAULT_PAGE_SIZE", "10")
+			t.Setenv("INCIDENT_IO_DEFAULT_FIELDS", "id,name")
+
+			var pageSizes []string
+			client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+				pageSizes = append(pageSizes, r.URL.Query().Get("page_size"))
+				fmt.Fprint(w, `{
+					"incidents": [{"id": "inc_1", "reference": "INC-1", "name": "Checkout errors", "summary": "Card payments failed"}],
+					"pagination_meta": {"page_size": 10}
+				}`)
+			})
+
+			result, err := NewListIncidentsTool(client).Execute(context.Background(), tt.args)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(pageSizes) != 1 || pageSizes[0] != tt.expectedPageSize {
+				t.Errorf("expected one request with page_size %s, got %v", tt.expectedPageSize, pageSizes)
+			}
+
+			var parsed struct {
+				Incidents []map[string]interface{} `json:"incidents"`
+			}
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to parse result: %v", err)
+			}
+			if len(parsed.Incidents) != 1 {
+				t.Fatalf("expected 1 incident, got %d", len(parsed.Incidents))
+			}
+			var fields []string
+			for field := range parsed.Incidents[0] {
+				fields = append(fields, field)
+			}
+			sort.Strings(fields)
+			if !reflect.DeepEqual(fields, tt.expectedFields) {
+				t.Errorf("expected fields %v, got %v", tt.expectedFields, fields)
+			}
+		})
+	}
+}
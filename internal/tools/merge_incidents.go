@@ -0,0 +1,107 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+// MergeIncidentsTool merges a duplicate incident into another incident
+type MergeIncidentsTool struct {
+	client *incidentio.Client
+}
+
+func NewMergeIncidentsTool(client *incidentio.Client) *MergeIncidentsTool {
+	return &MergeIncidentsTool{client: client}
+}
+
+func (t *MergeIncidentsTool) Name() string {
+	return "merge_incidents"
+}
+
+func (t *MergeIncidentsTool) Description() string {
+	return `Merge a duplicate incident into another incident when the same outage spawned multiple incidents.
+
+USAGE WORKFLOW:
+1. Identify the duplicate (source) incident and the incident to keep (target) using list_incidents
+2. Call this tool with both identifiers
+3. The source incident is marked as merged and the target incident is returned
+
+IDENTIFIER FORMATS SUPPORTED:
+Both parameters accept the same formats as get_incident:
+1. Full incident ID: "01FDAG4SAP5TYPT98WGR2N7"
+2. Incident reference: "INC-123" or just "123"
+3. Slack channel ID: "C123456789"
+4. Slack channel name: "20251020-aws-outage-ci-impaired"
+
+PARAMETERS:
+- source_incident_id: Required. The duplicate incident that will be merged away
+- target_incident_id: Required. The incident that remains after the merge
+
+EXAMPLES:
+- Merge by reference: {"source_incident_id": "INC-124", "target_incident_id": "INC-123"}
+- Merge by Slack channel: {"source_incident_id": "C987654321", "target_incident_id": "INC-123"}
+
+IMPORTANT: An incident cannot be merged into itself, and incident.io rejects merging closed incidents. Merging cannot be undone from this tool.`
+}
+
+func (t *MergeIncidentsTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"source_incident_id": map[string]interface{}{
+				"type":        "string",
+				"description": "The duplicate incident to merge away. Accepts full ID, reference (INC-123 or 123), Slack channel ID, or Slack channel name.",
+			},
+			"target_incident_id": map[string]interface{}{
+				"type":        "string",
+				"description": "The incident to merge into. Accepts full ID, reference (INC-123 or 123), Slack channel ID, or Slack channel name.",
+			},
+		},
+		"required":             []interface{}{"source_incident_id", "target_incident_id"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *MergeIncidentsTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	sourceIdentifier, ok := args["source_incident_id"].(string)
+	if !ok || sourceIdentifier == "" {
+		return "", fmt.Errorf("source_incident_id parameter is required and must be a non-empty string")
+	}
+
+	targetIdentifier, ok := args["target_incident_id"].(string)
+	if !ok || targetIdentifier == "" {
+		return "", fmt.Errorf("target_incident_id parameter is required and must be a non-empty string")
+	}
+
+	// Reuse the get_incident identifier resolution for both incidents
+	getIncidentTool := NewGetIncidentTool(t.client)
+
+	sourceID, err := getIncidentTool.ResolveIncidentIdentifier(ctx, sourceIdentifier)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve source incident: %w", err)
+	}
+
+	targetID, err := getIncidentTool.ResolveIncidentIdentifier(ctx, targetIdentifier)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve target incident: %w", err)
+	}
+
+	if sourceID == targetID {
+		return "", fmt.Errorf("cannot merge incident %s into itself: source_incident_id and target_incident_id refer to the same incident", sourceIdentifier)
+	}
+
+	incident, err := t.client.MergeIncidents(ctx, sourceID, targetID)
+	if err != nil {
+		return "", fmt.Errorf("failed to merge incident %s into %s: %w", sourceIdentifier, targetIdentifier, err)
+	}
+
+	result, err := json.MarshalIndent(incident, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to format response: %w", err)
+	}
+
+	return string(result), nil
+}
@@ -0,0 +1,100 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestMergeIncidentsTool_Execute(t *testing.T) {
+	tests := []struct {
+		name           string
+		args           map[string]interface{}
+		mockStatusCode int
+		mockResponse   string
+		wantError      bool
+		errorContains  string
+		expectRequest  bool
+	}{
+		{
+			name: "successful merge by reference",
+			args: map[string]interface{}{
+				"source_incident_id": "INC-124",
+				"target_incident_id": "INC-123",
+			},
+			mockStatusCode: http.StatusOK,
+			mockResponse:   `{"incident": {"id": "01HXYZ1234567890ABCDEFGH", "reference": "INC-123", "name": "Database outage"}}`,
+			expectRequest:  true,
+		},
+		{
+			name: "self merge rejected after resolution",
+			args: map[string]interface{}{
+				"source_incident_id": "INC-123",
+				"target_incident_id": "123",
+			},
+			wantError:     true,
+			errorContains: "into itself",
+			expectRequest: false,
+		},
+		{
+			name: "API rejects merge with 422",
+			args: map[string]interface{}{
+				"source_incident_id": "INC-124",
+				"target_incident_id": "INC-123",
+			},
+			mockStatusCode: http.StatusUnprocessableEntity,
+			mockResponse:   `{"error": {"message": "Closed incidents cannot be merged"}}`,
+			wantError:      true,
+			errorContains:  "Closed incidents cannot be merged",
+			expectRequest:  true,
+		},
+		{
+			name: "missing target",
+			args: map[string]interface{}{
+				"source_incident_id": "INC-124",
+			},
+			wantError:     true,
+			errorContains: "target_incident_id",
+			expectRequest: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requested := false
+			client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+				requested = true
+				if r.Method != http.MethodPost || r.URL.Path != "/incidents/124/actions/merge" {
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				w.WriteHeader(tt.mockStatusCode)
+				fmt.Fprint(w, tt.mockResponse)
+			})
+
+			result, err := NewMergeIncidentsTool(client).Execute(context.Background(), tt.args)
+
+			if requested != tt.expectRequest {
+				t.Errorf("expected request to be sent: %v, got: %v", tt.expectRequest, requested)
+			}
+
+			if tt.wantError {
+				if err == nil {
+					t.Fatal("expected error but got none")
+				}
+				if !strings.Contains(err.Error(), tt.errorContains) {
+					t.Errorf("expected error containing %q, got: %v", tt.errorContains, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !strings.Contains(result, `"reference": "INC-123"`) {
+				t.Errorf("expected merged target incident in result, got: %s", result)
+			}
+		})
+	}
+}
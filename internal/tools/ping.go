@@ -0,0 +1,89 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+// PingTool checks that the incident.io API is reachable with the configured key
+type PingTool struct {
+	client *incidentio.Client
+	now    func() time.Time
+}
+
+func NewPingTool(client *incidentio.Client) *PingTool {
+	return &PingTool{client: client, now: time.Now}
+}
+
+func (t *PingTool) Name() string {
+	return "ping_incidentio"
+}
+
+func (t *PingTool) Description() string {
+	return `Check that the incident.io API is reachable and the API key works.
+
+Makes one cheap authenticated request and reports whether it succeeded and how long it took.
+Call this before other tools to confirm connectivity, or when calls fail unexpectedly.
+
+RETURNS:
+- ok: true when the API accepted the request
+- latency_ms: round-trip time of the request in milliseconds
+- status_code and reason: why the check failed, when ok is false
+
+EXAMPLES:
+- Check connectivity: {}`
+}
+
+func (t *PingTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type":                 "object",
+		"properties":           map[string]interface{}{},
+		"additionalProperties": false,
+	}
+}
+
+func (t *PingTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	start := t.now()
+	err := t.client.Ping(ctx)
+	latency := t.now().Sub(start)
+
+	// A failed check is a result, not a tool error, so the caller always
+	// gets ok and latency back
+	response := map[string]interface{}{
+		"ok":         err == nil,
+		"latency_ms": latency.Milliseconds(),
+	}
+	if err != nil {
+		response["reason"] = pingFailureReason(err)
+		var apiErr *incidentio.APIError
+		if errors.As(err, &apiErr) {
+			response["status_code"] = apiErr.StatusCode
+		}
+	}
+
+	result, err := json.MarshalIndent(response, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to format response: %w", err)
+	}
+	return string(result), nil
+}
+
+// pingFailureReason explains a failed connectivity check
+func pingFailureReason(err error) string {
+	var apiErr *incidentio.APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusUnauthorized:
+			return "the API key was rejected; check INCIDENT_IO_API_KEY: " + err.Error()
+		case http.StatusForbidden:
+			return "the API key lacks permission to list users: " + err.Error()
+		}
+	}
+	return err.Error()
+}
@@ -0,0 +1,82 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPingTool_Execute(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     int
+		body       string
+		wantOK     bool
+		wantStatus float64
+		wantReason string
+	}{
+		{
+			name:   "reachable",
+			status: http.StatusOK,
+			body:   `{"users": [{"id": "user_1", "name": "Alex Kim"}], "pagination_meta": {}}`,
+			wantOK: true,
+		},
+		{
+			name:       "rejected API key",
+			status:     http.StatusUnauthorized,
+			body:       `{"type": "authentication_error", "status": 401, "errors": [{"code": "unauthenticated", "message": "Invalid API key"}]}`,
+			wantStatus: http.StatusUnauthorized,
+			wantReason: "API key was rejected",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/users" || r.URL.Query().Get("page_size") != "1" {
+					t.Errorf("unexpected request: %s", r.URL)
+				}
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, tt.body)
+			})
+			tool := NewPingTool(client)
+			calls := 0
+			tool.now = func() time.Time {
+				calls++
+				return time.Date(2024, 12, 1, 9, 30, 0, 0, time.UTC).Add(time.Duration(calls*42) * time.Millisecond)
+			}
+
+			result, err := tool.Execute(context.Background(), map[string]interface{}{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var parsed map[string]interface{}
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to parse result: %v", err)
+			}
+			if parsed["ok"] != tt.wantOK {
+				t.Errorf("expected ok=%v, got %s", tt.wantOK, result)
+			}
+			if parsed["latency_ms"] != float64(42) {
+				t.Errorf("expected latency_ms=42, got %v", parsed["latency_ms"])
+			}
+			if tt.wantOK {
+				if _, exists := parsed["reason"]; exists {
+					t.Errorf("expected no reason on success, got %s", result)
+				}
+				return
+			}
+			if parsed["status_code"] != tt.wantStatus {
+				t.Errorf("expected status_code=%v, got %v", tt.wantStatus, parsed["status_code"])
+			}
+			if reason, _ := parsed["reason"].(string); !strings.Contains(reason, tt.wantReason) {
+				t.Errorf("expected reason containing %q, got %q", tt.wantReason, reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package tools
+
+import "context"
+
+// ProgressFunc receives progress updates from a long-running tool call, such
+// as an auto-paginated listing. total is 0 when it is not known.
+type ProgressFunc func(progress, total int, message string)
+
+type progressContextKey struct{}
+
+// WithProgress returns a context through which tools running on it report
+// progress to fn
+func WithProgress(ctx context.Context, fn ProgressFunc) context.Context {
+	return context.WithValue(ctx, progressContextKey{}, fn)
+}
+
+// ReportProgress sends a progress update to the ProgressFunc on ctx, if the
+// caller asked for progress. Tools call it freely; it does nothing otherwise.
+func ReportProgress(ctx context.Context, progress, total int, message string) {
+	if fn, ok := ctx.Value(progressContextKey{}).(ProgressFunc); ok && fn != nil {
+		fn(progress, total, message)
+	}
+}
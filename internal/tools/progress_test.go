@@ -0,0 +1,45 @@
+package tools
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+// progressUpdate is one call to a ProgressFunc
+type progressUpdate struct {
+	progress, total int
+	message         string
+}
+
+func TestListIncidentsTool_AutoPaginateReportsProgress(t *testing.T) {
+	requests := 0
+	tool := NewListIncidentsTool(newPagedIncidentsClient(t, &requests))
+
+	var updates []progressUpdate
+	ctx := WithProgress(context.Background(), func(progress, total int, message string) {
+		updates = append(updates, progressUpdate{progress, total, message})
+	})
+
+	_, err := tool.Execute(ctx, map[string]interface{}{
+		"page_size":     float64(2),
+		"auto_paginate": true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// One update before each further page is fetched
+	expected := []progressUpdate{
+		{2, 5, "Fetched 2 of 5 incidents"},
+		{4, 5, "Fetched 4 of 5 incidents"},
+	}
+	if !reflect.DeepEqual(updates, expected) {
+		t.Errorf("expected progress %+v, got %+v", expected, updates)
+	}
+}
+
+func TestReportProgressWithoutListener(t *testing.T) {
+	// Tools report progress unconditionally, so this must be a no-op
+	ReportProgress(context.Background(), 1, 2, "Fetched 1 of 2 incidents")
+}
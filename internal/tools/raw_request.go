@@ -0,0 +1,229 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+// rawRequestMethods are the HTTP methods RAW_REQUEST_ALLOWED_METHODS may list
+var rawRequestMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE"}
+
+// RawRequestMethodsFromEnv returns the HTTP methods incidentio_raw_request
+// may use, or nil when ENABLE_RAW_REQUESTS is not set and the tool should not
+// be registered. RAW_REQUEST_ALLOWED_METHODS is a comma-separated list that
+// defaults to GET only.
+func RawRequestMethodsFromEnv() ([]string, error) {
+	value := os.Getenv("ENABLE_RAW_REQUESTS")
+	if value == "" {
+		return nil, nil
+	}
+	enabled, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, fmt.Errorf("ENABLE_RAW_REQUESTS must be true or false, got %q", value)
+	}
+	if !enabled {
+		return nil, nil
+	}
+
+	allowed := os.Getenv("RAW_REQUEST_ALLOWED_METHODS")
+	if strings.TrimSpace(allowed) == "" {
+		return []string{"GET"}, nil
+	}
+
+	var methods []string
+	seen := map[string]bool{}
+	for _, method := range strings.Split(allowed, ",") {
+		method = strings.ToUpper(strings.TrimSpace(method))
+		if method == "" || seen[method] {
+			continue
+		}
+		if !isRawRequestMethod(method) {
+			return nil, fmt.Errorf("RAW_REQUEST_ALLOWED_METHODS contains unsupported method %q. Supported methods: %s", method, strings.Join(rawRequestMethods, ", "))
+		}
+		seen[method] = true
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+	return methods, nil
+}
+
+func isRawRequestMethod(method string) bool {
+	for _, m := range rawRequestMethods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// RawRequestTool sends requests to incident.io API endpoints that have no
+// dedicated tool yet
+type RawRequestTool struct {
+	client         *incidentio.Client
+	allowedMethods []string
+}
+
+func NewRawRequestTool(client *incidentio.Client, allowedMethods []string) *RawRequestTool {
+	return &RawRequestTool{client: client, allowedMethods: allowedMethods}
+}
+
+func (t *RawRequestTool) Name() string {
+	return "incidentio_raw_request"
+}
+
+func (t *RawRequestTool) Description() string {
+	return fmt.Sprintf(`Send a request to any incident.io API endpoint and return the raw response body.
+
+Use this ONLY for endpoints that no other tool covers. Dedicated tools validate arguments, resolve names to IDs and format results; this tool does none of that.
+
+PARAMETERS:
+- method: HTTP method. Allowed on this server: %[1]s (default GET)
+- path: API path including the version, starting with /v1/ or /v2/, e.g. "/v2/incident_types"
+- query: Query parameters as an object, e.g. {"page_size": 50}. Array values repeat the parameter
+- body: JSON request body (object or array). Not allowed with GET
+
+EXAMPLES:
+- List incident types: {"path": "/v2/incident_types"}
+- Page through users: {"path": "/v2/users", "query": {"page_size": 25, "after": "01HXYZ..."}}
+
+IMPORTANT: The request is made with the server's API key. Errors from the API are returned as tool errors.`, strings.Join(t.allowedMethods, ", "))
+}
+
+func (t *RawRequestTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"method": map[string]interface{}{
+				"type":        "string",
+				"description": "HTTP method",
+				"enum":        t.allowedMethods,
+				"default":     "GET",
+			},
+			"path": map[string]interface{}{
+				"type":        "string",
+				"description": "API path including the version, starting with /v1/ or /v2/ (e.g. \"/v2/incident_types\")",
+			},
+			"query": map[string]interface{}{
+				"type":        "object",
+				"description": "Query parameters. Array values repeat the parameter.",
+				"additionalProperties": map[string]interface{}{
+					"type": []string{"string", "number", "boolean", "array"},
+				},
+			},
+			"body": map[string]interface{}{
+				"type":        []string{"object", "array"},
+				"description": "JSON request body. Not allowed with GET.",
+			},
+		},
+		"required":             []interface{}{"path"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *RawRequestTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	method, _ := args["method"].(string)
+	method = strings.ToUpper(strings.TrimSpace(method))
+	if method == "" {
+		method = "GET"
+	}
+	if !t.isAllowed(method) {
+		return "", fmt.Errorf("method %s is not allowed. Allowed methods: %s (set RAW_REQUEST_ALLOWED_METHODS to change this)", method, strings.Join(t.allowedMethods, ", "))
+	}
+
+	path, _ := args["path"].(string)
+	if err := validateRawRequestPath(path); err != nil {
+		return "", err
+	}
+
+	params, err := rawRequestQuery(args["query"])
+	if err != nil {
+		return "", err
+	}
+
+	var body json.RawMessage
+	if value, ok := args["body"]; ok && value != nil {
+		if method == "GET" {
+			return "", fmt.Errorf("body cannot be sent with a GET request")
+		}
+		if body, err = json.Marshal(value); err != nil {
+			return "", fmt.Errorf("failed to marshal body: %w", err)
+		}
+	}
+
+	respBody, err := t.client.RawRequest(ctx, method, path, params, body)
+	if err != nil {
+		return "", err
+	}
+	return string(respBody), nil
+}
+
+func (t *RawRequestTool) isAllowed(method string) bool {
+	for _, m := range t.allowedMethods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// validateRawRequestPath only lets requests reach versioned API paths on the
+// configured host
+func validateRawRequestPath(path string) error {
+	if !strings.HasPrefix(path, "/v1/") && !strings.HasPrefix(path, "/v2/") {
+		return fmt.Errorf("invalid path '%s': it must start with /v1/ or /v2/, e.g. /v2/incident_types", path)
+	}
+	if strings.ContainsAny(path, "?#") {
+		return fmt.Errorf("invalid path '%s': pass query parameters in the query argument", path)
+	}
+	// Check the decoded path too, so %2e%2e cannot stand in for ..
+	decoded, err := url.PathUnescape(path)
+	if err != nil {
+		return fmt.Errorf("invalid path '%s': %w", path, err)
+	}
+	for _, segment := range strings.Split(decoded, "/") {
+		if segment == "." || segment == ".." {
+			return fmt.Errorf("invalid path '%s': relative segments are not allowed", path)
+		}
+	}
+	return nil
+}
+
+// rawRequestQuery converts the query argument to URL parameters
+func rawRequestQuery(value interface{}) (url.Values, error) {
+	if value == nil {
+		return nil, nil
+	}
+	query, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("query must be an object")
+	}
+
+	params := url.Values{}
+	for key, item := range query {
+		values, isList := item.([]interface{})
+		if !isList {
+			values = []interface{}{item}
+		}
+		for _, v := range values {
+			switch v := v.(type) {
+			case string:
+				params.Add(key, v)
+			case bool:
+				params.Add(key, strconv.FormatBool(v))
+			case float64:
+				params.Add(key, strconv.FormatFloat(v, 'f', -1, 64))
+			default:
+				return nil, fmt.Errorf("query parameter '%s' must be a string, number, boolean or an array of them", key)
+			}
+		}
+	}
+	return params, nil
+}
@@ -0,0 +1,117 @@
+package tools
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRawRequestTool_AllowedGet(t *testing.T) {
+	var gotMethod, gotPath, gotQuery, gotAuth string
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotMethod, gotPath, gotQuery = r.Method, r.URL.Path, r.URL.RawQuery
+		gotAuth = r.Header.Get("Authorization")
+		w.Write([]byte(`{"incident_types": [{"id": "type_1"}]}`))
+	})
+
+	tool := NewRawRequestTool(client, []string{"GET"})
+	result, err := tool.Execute(context.Background(), map[string]interface{}{
+		"path": "/v2/incident_types",
+		"query": map[string]interface{}{
+			"page_size": float64(50),
+			"status":    []interface{}{"live", "triage"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result != `{"incident_types": [{"id": "type_1"}]}` {
+		t.Errorf("expected the raw response body, got: %s", result)
+	}
+	if gotMethod != "GET" || gotPath != "/v2/incident_types" {
+		t.Errorf("expected GET /v2/incident_types, got %s %s", gotMethod, gotPath)
+	}
+	if gotQuery != "page_size=50&status=live&status=triage" {
+		t.Errorf("unexpected query: %s", gotQuery)
+	}
+	if gotAuth != "Bearer test-key" {
+		t.Errorf("expected the client's API key to be used, got %q", gotAuth)
+	}
+}
+
+func TestRawRequestTool_BlockedPost(t *testing.T) {
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+	})
+
+	tool := NewRawRequestTool(client, []string{"GET"})
+	_, err := tool.Execute(context.Background(), map[string]interface{}{
+		"method": "POST",
+		"path":   "/v2/incident_types",
+		"body":   map[string]interface{}{"name": "Security"},
+	})
+	if err == nil || !strings.Contains(err.Error(), "method POST is not allowed") {
+		t.Errorf("expected POST to be blocked, got: %v", err)
+	}
+}
+
+func TestRawRequestTool_InvalidArguments(t *testing.T) {
+	tool := NewRawRequestTool(nil, []string{"GET", "POST"})
+
+	tests := []struct {
+		name    string
+		args    map[string]interface{}
+		wantErr string
+	}{
+		{name: "unversioned path", args: map[string]interface{}{"path": "/incidents"}, wantErr: "must start with /v1/ or /v2/"},
+		{name: "absolute URL", args: map[string]interface{}{"path": "https://example.com/v2/incidents"}, wantErr: "must start with /v1/ or /v2/"},
+		{name: "parent segment", args: map[string]interface{}{"path": "/v2/../admin"}, wantErr: "relative segments"},
+		{name: "encoded parent segment", args: map[string]interface{}{"path": "/v2/%2e%2e/admin"}, wantErr: "relative segments"},
+		{name: "query in path", args: map[string]interface{}{"path": "/v2/incidents?page_size=1"}, wantErr: "query argument"},
+		{name: "body with GET", args: map[string]interface{}{"path": "/v2/incidents", "body": map[string]interface{}{}}, wantErr: "GET request"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tool.Execute(context.Background(), tt.args)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestRawRequestMethodsFromEnv(t *testing.T) {
+	tests := []struct {
+		name    string
+		enable  string
+		allowed string
+		want    []string
+		wantErr bool
+	}{
+		{name: "disabled by default"},
+		{name: "explicitly disabled", enable: "false", allowed: "GET,POST"},
+		{name: "GET only by default", enable: "true", want: []string{"GET"}},
+		{name: "custom allowlist", enable: "1", allowed: "post, get,POST", want: []string{"GET", "POST"}},
+		{name: "invalid flag", enable: "yes please", wantErr: true},
+		{name: "unsupported method", enable: "true", allowed: "GET,TRACE", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ENABLE_RAW_REQUESTS", tt.enable)
+			t.Setenv("RAW_REQUEST_ALLOWED_METHODS", tt.allowed)
+
+			got, err := RawRequestMethodsFromEnv()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got: %v", tt.wantErr, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected methods %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
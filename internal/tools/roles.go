@@ -1,8 +1,10 @@
 package tools
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
 )
@@ -30,12 +32,14 @@ USAGE WORKFLOW:
 
 PARAMETERS:
 - page_size: Number of results (default 25, max 250)
+- after: Pagination cursor from a previous response's pagination_meta.after
 
 EXAMPLES:
 - List all roles: {}
 - List with pagination: {"page_size": 50}
+- Next page: {"page_size": 50, "after": "01HXYZ..."}
 
-IMPORTANT: Role IDs from this tool are required for the assign_incident_role tool.`
+IMPORTANT: assign_incident_role accepts either a role ID from this tool or the role name.`
 }
 
 func (t *ListIncidentRolesTool) InputSchema() map[string]interface{} {
@@ -47,28 +51,32 @@ func (t *ListIncidentRolesTool) InputSchema() map[string]interface{} {
 				"description": "Number of results per page (max 250)",
 				"default":     25,
 			},
+			"after": map[string]interface{}{
+				"type":        "string",
+				"description": "Pagination cursor for the next page",
+			},
+			"fields": fieldsProperty(),
 		},
 	}
 }
 
-func (t *ListIncidentRolesTool) Execute(args map[string]interface{}) (string, error) {
+func (t *ListIncidentRolesTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
 	opts := &incidentio.ListIncidentRolesOptions{}
 
 	if pageSize, ok := args["page_size"].(float64); ok {
 		opts.PageSize = int(pageSize)
 	}
 
-	resp, err := t.client.ListIncidentRoles(opts)
-	if err != nil {
-		return "", err
+	if after, ok := args["after"].(string); ok {
+		opts.After = after
 	}
 
-	result, err := json.MarshalIndent(resp, "", "  ")
+	resp, err := t.client.ListIncidentRoles(ctx, opts)
 	if err != nil {
-		return "", fmt.Errorf("failed to format response: %w", err)
+		return "", err
 	}
 
-	return string(result), nil
+	return formatJSONResult(resp, args)
 }
 
 // ListUsersTool lists available users for role assignment
@@ -85,22 +93,27 @@ func (t *ListUsersTool) Name() string {
 }
 
 func (t *ListUsersTool) Description() string {
-	return `List all users available for incident role assignment (automatically paginated).
+	return `List users available for incident role assignment.
 
 USAGE WORKFLOW:
-1. Call to see all users in your organization
+1. Call to see users in your organization
 2. Optional: Filter by email to find specific user
 3. Use user IDs when assigning roles with assign_incident_role
 
 PARAMETERS:
-- page_size: Number of results (default 250, max 250)
+- page_size: Number of results per page (default 250, max 250)
+- after: Pagination cursor from a previous response's pagination_meta.after
 - email: Optional. Filter users by email address
+- auto_paginate: Set to true to follow pagination internally and return every user in one response
+  * Stops after 2500 users and sets truncated=true if there were more
 
 EXAMPLES:
-- List all users: {}
+- First page of users: {}
+- Next page: {"after": "01HXYZ..."}
+- All users: {"auto_paginate": true}
 - Find by email: {"email": "user@example.com"}
 
-IMPORTANT: User IDs from this tool are required for the assign_incident_role tool.`
+IMPORTANT: User IDs from this tool are required for the assign_incident_role tool. Use get_user to look up a single user by email.`
 }
 
 func (t *ListUsersTool) InputSchema() map[string]interface{} {
@@ -111,28 +124,48 @@ func (t *ListUsersTool) InputSchema() map[string]interface{} {
 				"type":        "integer",
 				"description": "Number of results per page (max 250)",
 				"default":     250,
+				"minimum":     1,
+				"maximum":     250,
+			},
+			"after": map[string]interface{}{
+				"type":        "string",
+				"description": "Pagination cursor for the next page",
 			},
 			"email": map[string]interface{}{
 				"type":        "string",
 				"description": "Filter users by email address",
 			},
+			"auto_paginate": map[string]interface{}{
+				"type":        "boolean",
+				"description": "Follow pagination internally and return all users (up to 2500) in a single response. Sets truncated=true if the cap was hit.",
+				"default":     false,
+			},
+			"fields": fieldsProperty(),
 		},
 		"additionalProperties": false,
 	}
 }
 
-func (t *ListUsersTool) Execute(args map[string]interface{}) (string, error) {
+func (t *ListUsersTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
 	opts := &incidentio.ListUsersOptions{}
 
 	if pageSize, ok := args["page_size"].(float64); ok {
 		opts.PageSize = int(pageSize)
 	}
 
+	if after, ok := args["after"].(string); ok {
+		opts.After = after
+	}
+
 	if email, ok := args["email"].(string); ok && email != "" {
 		opts.Email = email
 	}
 
-	resp, err := t.client.ListUsers(opts)
+	if autoPaginate, ok := args["auto_paginate"].(bool); ok {
+		opts.AutoPaginate = autoPaginate
+	}
+
+	resp, err := t.client.ListUsers(ctx, opts)
 	if err != nil {
 		return "", err
 	}
@@ -151,15 +184,88 @@ func (t *ListUsersTool) Execute(args map[string]interface{}) (string, error) {
 			user.Name, user.Email, user.ID, user.Role)
 	}
 
-	// Also include the raw JSON
-	jsonResult, err := json.MarshalIndent(resp, "", "  ")
-	if err != nil {
-		return output, nil // Return readable output even if JSON fails
+	switch {
+	case resp.Truncated:
+		output += fmt.Sprintf("\nStopped after %d users; more users exist. Use get_user or the email filter to find a specific user.\n", len(resp.Users))
+	case resp.PaginationMeta.After != "":
+		output += fmt.Sprintf("\nMore users available. Call again with after=%q or auto_paginate=true.\n", resp.PaginationMeta.After)
+	}
+
+	return withRawJSON(output, resp, args)
+}
+
+// GetUserTool fetches a single user by ID or email address
+type GetUserTool struct {
+	client *incidentio.Client
+}
+
+func NewGetUserTool(client *incidentio.Client) *GetUserTool {
+	return &GetUserTool{client: client}
+}
+
+func (t *GetUserTool) Name() string {
+	return "get_user"
+}
+
+func (t *GetUserTool) Description() string {
+	return `Get a single user by user ID or email address.
+
+USAGE WORKFLOW:
+1. Call with a user_id to see a user's details, or with an email to find their user ID
+2. Use the returned ID with role tools such as assign_incident_role
+
+PARAMETERS:
+- user_id: The user ID
+- email: The user's email address (case-insensitive, must match exactly)
+- One of user_id or email is required. If both are given, user_id is used
+
+EXAMPLES:
+- By ID: {"user_id": "01HXYZ..."}
+- By email: {"email": "jane@example.com"}`
+}
+
+func (t *GetUserTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"user_id": map[string]interface{}{
+				"type":        "string",
+				"description": "The user ID. Takes precedence over email",
+			},
+			"email": map[string]interface{}{
+				"type":        "string",
+				"description": "The user's email address",
+			},
+			"fields": fieldsProperty(),
+		},
+		"additionalProperties": false,
 	}
+}
 
-	output += "\n\nRaw JSON response:\n" + string(jsonResult)
+func (t *GetUserTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	var user *incidentio.UserDetailed
+	if userID, ok := args["user_id"].(string); ok && userID != "" {
+		found, err := t.client.GetUser(ctx, userID)
+		if err != nil {
+			if incidentio.IsNotFound(err) {
+				return "", fmt.Errorf("user not found: %s. Call list_users to see available users", userID)
+			}
+			return "", err
+		}
+		user = found
+	} else {
+		email, ok := args["email"].(string)
+		if !ok || email == "" {
+			return "", fmt.Errorf("either user_id or email parameter is required")
+		}
+		found, err := t.client.FindUserByEmail(ctx, email)
+		if err != nil {
+			return "", err
+		}
+		user = found
+	}
 
-	return output, nil
+	return formatJSONResult(user, args)
 }
 
 // AssignIncidentRoleTool assigns a role to a user for an incident
@@ -179,19 +285,25 @@ func (t *AssignIncidentRoleTool) Description() string {
 	return `Assign a specific incident role to a user for an incident.
 
 USAGE WORKFLOW:
-1. First call 'list_available_incident_roles' to get role IDs
-2. Call 'list_users' to get user IDs (or filter by email)
-3. Call this tool with incident ID, role ID, and user ID
+1. Name the role with role_name, or call 'list_available_incident_roles' to get role IDs
+2. Identify the user by email with assignee_email, or call 'list_users' to get their user ID
+3. Call this tool with incident ID, role, and the user
 
 PARAMETERS:
 - id: Required. The incident ID to assign role for
-- incident_role_id: Required. The role ID (from list_available_incident_roles)
-- user_id: Required. The user ID (from list_users)
+- incident_role_id: The role ID (from list_available_incident_roles)
+- role_name: The role name or shortform, e.g. "Incident Lead" (case-insensitive)
+- One of incident_role_id or role_name is required. If both are given, incident_role_id is used
+- user_id: The user ID (from list_users)
+- assignee_email: The user's email address, resolved to a user ID automatically
+- One of user_id or assignee_email is required. If both are given, user_id is used
 
 EXAMPLES:
-- Assign lead: {"id": "01HXYZ...", "incident_role_id": "role_123", "user_id": "user_456"}
+- Assign lead by ID: {"id": "01HXYZ...", "incident_role_id": "role_123", "user_id": "user_456"}
+- Assign lead by email: {"id": "01HXYZ...", "incident_role_id": "role_123", "assignee_email": "jane@example.com"}
+- Assign by role name: {"id": "01HXYZ...", "role_name": "Incident Lead", "assignee_email": "jane@example.com"}
 
-IMPORTANT: Use list_available_incident_roles and list_users to discover valid IDs before calling this tool.`
+IMPORTANT: If a role name is unknown or ambiguous, the error lists the matching or available roles.`
 }
 
 func (t *AssignIncidentRoleTool) InputSchema() map[string]interface{} {
@@ -204,19 +316,27 @@ func (t *AssignIncidentRoleTool) InputSchema() map[string]interface{} {
 			},
 			"incident_role_id": map[string]interface{}{
 				"type":        "string",
-				"description": "The incident role ID to assign",
+				"description": "The incident role ID to assign. Takes precedence over role_name",
+			},
+			"role_name": map[string]interface{}{
+				"type":        "string",
+				"description": "Name or shortform of the incident role to assign (case-insensitive)",
 			},
 			"user_id": map[string]interface{}{
 				"type":        "string",
-				"description": "The user ID to assign the role to",
+				"description": "The user ID to assign the role to. Takes precedence over assignee_email",
+			},
+			"assignee_email": map[string]interface{}{
+				"type":        "string",
+				"description": "Email address of the user to assign the role to",
 			},
 		},
-		"required":             []interface{}{"id", "incident_role_id", "user_id"},
+		"required":             []interface{}{"id"},
 		"additionalProperties": false,
 	}
 }
 
-func (t *AssignIncidentRoleTool) Execute(args map[string]interface{}) (string, error) {
+func (t *AssignIncidentRoleTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
 	argDetails := make(map[string]interface{})
 	for key, value := range args {
 		argDetails[key] = value
@@ -231,14 +351,14 @@ func (t *AssignIncidentRoleTool) Execute(args map[string]interface{}) (string, e
 		return "", fmt.Errorf("id parameter is required and must be a non-empty string. Received parameters: %+v", argDetails)
 	}
 
-	roleID, ok := args["incident_role_id"].(string)
-	if !ok {
-		return "", fmt.Errorf("incident_role_id parameter is required")
+	roleID, err := t.resolveRoleID(ctx, args)
+	if err != nil {
+		return "", err
 	}
 
-	userID, ok := args["user_id"].(string)
-	if !ok {
-		return "", fmt.Errorf("user_id parameter is required")
+	userID, err := t.resolveUserID(ctx, args)
+	if err != nil {
+		return "", err
 	}
 
 	// Create role assignment request using UpdateIncident
@@ -251,12 +371,68 @@ func (t *AssignIncidentRoleTool) Execute(args map[string]interface{}) (string, e
 		},
 	}
 
-	incident, err := t.client.UpdateIncident(incidentID, req)
+	incident, err := t.client.UpdateIncident(ctx, incidentID, req)
 	if err != nil {
 		return "", err
 	}
 
 	// Return just the role assignments part for clarity
+	response := map[string]interface{}{
+		"message":          fmt.Sprintf("Successfully assigned role to user for incident %s", incident.Name),
+		"incident_id":      incident.ID,
+		"incident_name":    incident.Name,
+		"role_assignments": summarizeRoleAssignments(incident),
+	}
+
+	result, err := json.MarshalIndent(response, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to format response: %w", err)
+	}
+
+	return string(result), nil
+}
+
+// resolveRoleID returns the incident_role_id argument if set, otherwise looks
+// up the role by role_name
+func (t *AssignIncidentRoleTool) resolveRoleID(ctx context.Context, args map[string]interface{}) (string, error) {
+	if roleID, ok := args["incident_role_id"].(string); ok && roleID != "" {
+		return roleID, nil
+	}
+
+	roleName, ok := args["role_name"].(string)
+	if !ok || roleName == "" {
+		return "", fmt.Errorf("either incident_role_id or role_name parameter is required")
+	}
+
+	role, err := t.client.FindIncidentRoleByName(ctx, roleName)
+	if err != nil {
+		return "", err
+	}
+	return role.ID, nil
+}
+
+// resolveUserID returns the user_id argument if set, otherwise looks up the
+// user by assignee_email
+func (t *AssignIncidentRoleTool) resolveUserID(ctx context.Context, args map[string]interface{}) (string, error) {
+	if userID, ok := args["user_id"].(string); ok && userID != "" {
+		return userID, nil
+	}
+
+	email, ok := args["assignee_email"].(string)
+	if !ok || email == "" {
+		return "", fmt.Errorf("either user_id or assignee_email parameter is required")
+	}
+
+	user, err := t.client.FindUserByEmail(ctx, email)
+	if err != nil {
+		return "", err
+	}
+	return user.ID, nil
+}
+
+// summarizeRoleAssignments returns the role and assignee of each of an
+// incident's role assignments. Vacant roles have no assignee.
+func summarizeRoleAssignments(incident *incidentio.Incident) []map[string]interface{} {
 	roleAssignments := make([]map[string]interface{}, 0)
 	for _, assignment := range incident.IncidentRoleAssignments {
 		roleData := map[string]interface{}{
@@ -278,12 +454,85 @@ func (t *AssignIncidentRoleTool) Execute(args map[string]interface{}) (string, e
 
 		roleAssignments = append(roleAssignments, roleData)
 	}
+	return roleAssignments
+}
+
+// UnassignIncidentRoleTool clears the assignee of an incident role
+type UnassignIncidentRoleTool struct {
+	client *incidentio.Client
+}
+
+func NewUnassignIncidentRoleTool(client *incidentio.Client) *UnassignIncidentRoleTool {
+	return &UnassignIncidentRoleTool{client: client}
+}
+
+func (t *UnassignIncidentRoleTool) Name() string {
+	return "unassign_incident_role"
+}
+
+func (t *UnassignIncidentRoleTool) Description() string {
+	return `Remove the current assignee from an incident role, leaving the role vacant.
+
+USAGE WORKFLOW:
+1. Call 'list_available_incident_roles' to get role IDs
+2. Call this tool with the incident ID and role ID
+3. Check the returned role assignments to confirm the role has no assignee
+
+PARAMETERS:
+- incident_id: Required. The incident ID
+- incident_role_id: Required. The role ID to clear (from list_available_incident_roles)
+
+EXAMPLES:
+- Clear the lead: {"incident_id": "01HXYZ...", "incident_role_id": "role_123"}`
+}
+
+func (t *UnassignIncidentRoleTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"incident_id": map[string]interface{}{
+				"type":        "string",
+				"description": "The incident ID",
+			},
+			"incident_role_id": map[string]interface{}{
+				"type":        "string",
+				"description": "The incident role ID to clear",
+			},
+		},
+		"required":             []interface{}{"incident_id", "incident_role_id"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *UnassignIncidentRoleTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	incidentID, ok := args["incident_id"].(string)
+	if !ok || incidentID == "" {
+		return "", fmt.Errorf("incident_id parameter is required")
+	}
+
+	roleID, ok := args["incident_role_id"].(string)
+	if !ok || roleID == "" {
+		return "", fmt.Errorf("incident_role_id parameter is required")
+	}
+
+	roles, err := t.client.ListAllIncidentRoles(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to list incident roles: %w", err)
+	}
+	if !hasIncidentRole(roles, roleID) {
+		return "", fmt.Errorf("unknown incident role %s. Call list_available_incident_roles to see valid role IDs", roleID)
+	}
+
+	incident, err := t.client.UnassignIncidentRole(ctx, incidentID, roleID)
+	if err != nil {
+		return "", err
+	}
 
 	response := map[string]interface{}{
-		"message":          fmt.Sprintf("Successfully assigned role to user for incident %s", incident.Name),
+		"message":          fmt.Sprintf("Successfully unassigned role for incident %s", incident.Name),
 		"incident_id":      incident.ID,
 		"incident_name":    incident.Name,
-		"role_assignments": roleAssignments,
+		"role_assignments": summarizeRoleAssignments(incident),
 	}
 
 	result, err := json.MarshalIndent(response, "", "  ")
@@ -293,3 +542,105 @@ func (t *AssignIncidentRoleTool) Execute(args map[string]interface{}) (string, e
 
 	return string(result), nil
 }
+
+func hasIncidentRole(roles []incidentio.IncidentRole, roleID string) bool {
+	for _, role := range roles {
+		if role.ID == roleID {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveRoleAssignments turns role_assignments arguments into role
+// assignment requests. Each item names a role by incident_role_id or
+// role_name, and a user by user_id or user_email. The incident roles are
+// returned alongside the assignments so callers can check required roles.
+func resolveRoleAssignments(ctx context.Context, client *incidentio.Client, inputs []interface{}) ([]incidentio.CreateRoleAssignmentRequest, []incidentio.IncidentRole, error) {
+	roles, err := client.ListAllIncidentRoles(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list incident roles: %w", err)
+	}
+
+	assignments := make([]incidentio.CreateRoleAssignmentRequest, 0, len(inputs))
+	for i, input := range inputs {
+		item, ok := input.(map[string]interface{})
+		if !ok {
+			return nil, nil, fmt.Errorf("role_assignments[%d] must be an object", i)
+		}
+
+		roleID, err := resolveIncidentRoleID(roles, item)
+		if err != nil {
+			return nil, nil, fmt.Errorf("role_assignments[%d]: %w", i, err)
+		}
+
+		userID, _ := item["user_id"].(string)
+		if userID == "" {
+			email, _ := item["user_email"].(string)
+			if email == "" {
+				return nil, nil, fmt.Errorf("role_assignments[%d]: either user_id or user_email is required", i)
+			}
+			user, err := client.FindUserByEmail(ctx, email)
+			if err != nil {
+				return nil, nil, fmt.Errorf("role_assignments[%d]: %w", i, err)
+			}
+			userID = user.ID
+		}
+
+		assignments = append(assignments, incidentio.CreateRoleAssignmentRequest{
+			IncidentRoleID: roleID,
+			UserID:         userID,
+		})
+	}
+
+	return assignments, roles, nil
+}
+
+// resolveIncidentRoleID returns the incident_role_id argument if it names a
+// known role, otherwise matches role_name against role names and shortforms
+func resolveIncidentRoleID(roles []incidentio.IncidentRole, item map[string]interface{}) (string, error) {
+	if roleID, _ := item["incident_role_id"].(string); roleID != "" {
+		if !hasIncidentRole(roles, roleID) {
+			return "", fmt.Errorf("incident role %s not found. Available roles: %s", roleID, formatAvailableRoles(roles))
+		}
+		return roleID, nil
+	}
+
+	roleName, _ := item["role_name"].(string)
+	if roleName == "" {
+		return "", fmt.Errorf("either incident_role_id or role_name is required")
+	}
+	role, err := incidentio.MatchIncidentRoleByName(roles, roleName)
+	if err != nil {
+		return "", err
+	}
+	return role.ID, nil
+}
+
+// missingRequiredRoles returns the names of required roles with no assignment
+func missingRequiredRoles(roles []incidentio.IncidentRole, assignments []incidentio.CreateRoleAssignmentRequest) []string {
+	assigned := make(map[string]bool, len(assignments))
+	for _, assignment := range assignments {
+		assigned[assignment.IncidentRoleID] = true
+	}
+
+	var missing []string
+	for _, role := range roles {
+		if role.Required && !assigned[role.ID] {
+			missing = append(missing, role.Name)
+		}
+	}
+	return missing
+}
+
+func formatAvailableRoles(roles []incidentio.IncidentRole) string {
+	if len(roles) == 0 {
+		return "none"
+	}
+
+	names := make([]string, len(roles))
+	for i, role := range roles {
+		names[i] = fmt.Sprintf("%s (%s)", role.Name, role.ID)
+	}
+	return strings.Join(names, ", ")
+}
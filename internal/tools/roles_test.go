@@ -0,0 +1,336 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+const rolesTestUsers = `[
+	{"id": "user_jane", "name": "Jane Doe", "email": "jane.doe@example.com"},
+	{"id": "user_janet", "name": "Janet Smith", "email": "janet@example.com"},
+	{"id": "user_bob", "name": "Bob Jones", "email": "bob@example.com"}
+]`
+
+// newAssignRoleTestClient serves incident roles, and users filtered by email
+// the way the API does (substring match), and records the user ID of every
+// role assignment
+func newAssignRoleTestClient(t *testing.T, assignedUserIDs *[]string) *incidentio.Client {
+	t.Helper()
+
+	var users []incidentio.UserDetailed
+	if err := json.Unmarshal([]byte(rolesTestUsers), &users); err != nil {
+		t.Fatalf("failed to parse test users: %v", err)
+	}
+
+	return newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch path := r.URL.Path; {
+		case path == "/incident_roles":
+			fmt.Fprint(w, `{"incident_roles": [
+				{"id": "role_lead", "name": "Incident Lead", "shortform": "lead"},
+				{"id": "role_comms", "name": "Communications Lead", "shortform": "comms"}
+			]}`)
+		case strings.HasPrefix(path, "/users/"):
+			id := strings.TrimPrefix(path, "/users/")
+			for _, user := range users {
+				if user.ID == id {
+					json.NewEncoder(w).Encode(map[string]interface{}{"user": user})
+					return
+				}
+			}
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"type": "not_found", "status": 404, "errors": [{"code": "not_found", "message": "User not found"}]}`)
+		case path == "/users":
+			email := strings.ToLower(r.URL.Query().Get("email"))
+			matched := []incidentio.UserDetailed{}
+			for _, user := range users {
+				if strings.Contains(user.Email, email) {
+					matched = append(matched, user)
+				}
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"users": matched})
+		case path == "/incidents/inc_1/actions/edit":
+			var body struct {
+				Incident struct {
+					IncidentRoleAssignments []incidentio.CreateRoleAssignmentRequest `json:"incident_role_assignments"`
+				} `json:"incident"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode request body: %v", err)
+			}
+			for _, assignment := range body.Incident.IncidentRoleAssignments {
+				*assignedUserIDs = append(*assignedUserIDs, assignment.UserID)
+			}
+			fmt.Fprint(w, `{"incident": {"id": "inc_1", "name": "Database outage"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+}
+
+func TestAssignIncidentRoleTool_ResolvesEmail(t *testing.T) {
+	tests := []struct {
+		name             string
+		args             map[string]interface{}
+		expectedUserID   string
+		expectedErrorHas []string
+	}{
+		{
+			name:           "resolves email to user ID",
+			args:           map[string]interface{}{"assignee_email": "Jane.Doe@example.com"},
+			expectedUserID: "user_jane",
+		},
+		{
+			name:           "user_id wins over assignee_email",
+			args:           map[string]interface{}{"user_id": "user_bob", "assignee_email": "jane.doe@example.com"},
+			expectedUserID: "user_bob",
+		},
+		{
+			name:             "ambiguous email",
+			args:             map[string]interface{}{"assignee_email": "jane"},
+			expectedErrorHas: []string{"ambiguous", "user_jane", "user_janet"},
+		},
+		{
+			name:             "no match lists close matches",
+			args:             map[string]interface{}{"assignee_email": "jane.doe@example.org"},
+			expectedErrorHas: []string{"no user found", "Close matches", "jane.doe@example.com"},
+		},
+		{
+			name:             "no user identifier",
+			args:             map[string]interface{}{},
+			expectedErrorHas: []string{"user_id or assignee_email"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var assigned []string
+			tool := NewAssignIncidentRoleTool(newAssignRoleTestClient(t, &assigned))
+
+			args := map[string]interface{}{"id": "inc_1", "incident_role_id": "role_lead"}
+			for key, value := range tt.args {
+				args[key] = value
+			}
+
+			_, err := tool.Execute(context.Background(), args)
+			if len(tt.expectedErrorHas) > 0 {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				for _, expected := range tt.expectedErrorHas {
+					if !strings.Contains(err.Error(), expected) {
+						t.Errorf("expected error to contain %q, got: %v", expected, err)
+					}
+				}
+				if len(assigned) != 0 {
+					t.Errorf("expected no role assignment, got %v", assigned)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(assigned) != 1 || assigned[0] != tt.expectedUserID {
+				t.Errorf("expected role assigned to %s, got %v", tt.expectedUserID, assigned)
+			}
+		})
+	}
+}
+
+func TestUnassignIncidentRoleTool_Execute(t *testing.T) {
+	newClient := func(t *testing.T, edits *[]map[string]interface{}) *incidentio.Client {
+		return newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/incident_roles":
+				fmt.Fprint(w, `{"incident_roles": [{"id": "role_lead", "name": "Incident Lead"}]}`)
+			case "/incidents/inc_1/actions/edit":
+				var body map[string]interface{}
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Errorf("failed to decode request body: %v", err)
+				}
+				*edits = append(*edits, body)
+				fmt.Fprint(w, `{"incident": {
+					"id": "inc_1",
+					"name": "Database outage",
+					"incident_role_assignments": [{"role": {"id": "role_lead", "name": "Incident Lead"}}]
+				}}`)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		})
+	}
+
+	t.Run("clears the assignee", func(t *testing.T) {
+		var edits []map[string]interface{}
+		tool := NewUnassignIncidentRoleTool(newClient(t, &edits))
+
+		result, err := tool.Execute(context.Background(), map[string]interface{}{
+			"incident_id":      "inc_1",
+			"incident_role_id": "role_lead",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(edits) != 1 {
+			t.Fatalf("expected 1 edit request, got %d", len(edits))
+		}
+		incident, _ := edits[0]["incident"].(map[string]interface{})
+		assignments, _ := incident["incident_role_assignments"].([]interface{})
+		if len(assignments) != 1 {
+			t.Fatalf("expected 1 role assignment in request, got %v", incident)
+		}
+		assignment, _ := assignments[0].(map[string]interface{})
+		if assignment["incident_role_id"] != "role_lead" {
+			t.Errorf("expected incident_role_id role_lead, got %v", assignment["incident_role_id"])
+		}
+		if _, ok := assignment["user_id"]; ok {
+			t.Errorf("expected user_id to be omitted, got %v", assignment)
+		}
+
+		var response struct {
+			RoleAssignments []map[string]interface{} `json:"role_assignments"`
+		}
+		if err := json.Unmarshal([]byte(result), &response); err != nil {
+			t.Fatalf("failed to parse result: %v", err)
+		}
+		if len(response.RoleAssignments) != 1 || response.RoleAssignments[0]["assignee"] != nil {
+			t.Errorf("expected vacant role in result, got %v", response.RoleAssignments)
+		}
+	})
+
+	t.Run("rejects unknown role", func(t *testing.T) {
+		var edits []map[string]interface{}
+		tool := NewUnassignIncidentRoleTool(newClient(t, &edits))
+
+		_, err := tool.Execute(context.Background(), map[string]interface{}{
+			"incident_id":      "inc_1",
+			"incident_role_id": "role_missing",
+		})
+		if err == nil || !strings.Contains(err.Error(), "unknown incident role role_missing") {
+			t.Fatalf("expected unknown role error, got %v", err)
+		}
+		if len(edits) != 0 {
+			t.Errorf("expected no edit requests, got %d", len(edits))
+		}
+	})
+}
+
+func TestGetUserTool_Execute(t *testing.T) {
+	var assigned []string
+	tool := NewGetUserTool(newAssignRoleTestClient(t, &assigned))
+
+	tests := []struct {
+		name    string
+		args    map[string]interface{}
+		wantID  string
+		wantErr string
+	}{
+		{name: "by user ID", args: map[string]interface{}{"user_id": "user_bob"}, wantID: "user_bob"},
+		{name: "by email", args: map[string]interface{}{"email": "Janet@Example.com"}, wantID: "user_janet"},
+		{name: "unknown user ID", args: map[string]interface{}{"user_id": "user_missing"}, wantErr: "user not found: user_missing"},
+		{name: "no arguments", args: map[string]interface{}{}, wantErr: "either user_id or email parameter is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tool.Execute(context.Background(), tt.args)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got: %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var user incidentio.UserDetailed
+			if err := json.Unmarshal([]byte(result), &user); err != nil {
+				t.Fatalf("failed to parse result: %v", err)
+			}
+			if user.ID != tt.wantID {
+				t.Errorf("expected user %s, got %s", tt.wantID, user.ID)
+			}
+		})
+	}
+}
+
+func TestListUsersTool_Pagination(t *testing.T) {
+	var afters []string
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users" {
+			t.Errorf("unexpected request: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		after := r.URL.Query().Get("after")
+		afters = append(afters, after)
+		if after == "" {
+			fmt.Fprint(w, `{"users": [{"id": "user_1", "name": "Jane Doe", "email": "jane@example.com"}], "pagination_meta": {"after": "user_1", "page_size": 1}}`)
+			return
+		}
+		fmt.Fprint(w, `{"users": [{"id": "user_2", "name": "Bob Jones", "email": "bob@example.com"}], "pagination_meta": {"page_size": 1}}`)
+	})
+	tool := NewListUsersTool(client)
+
+	t.Run("returns a single page by default", func(t *testing.T) {
+		afters = nil
+		result, err := tool.Execute(context.Background(), map[string]interface{}{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(afters) != 1 || !strings.Contains(result, "user_1") || strings.Contains(result, "user_2") {
+			t.Errorf("expected only the first page, got requests %v and result:\n%s", afters, result)
+		}
+		if !strings.Contains(result, `after="user_1"`) {
+			t.Errorf("expected result to point to the next page, got:\n%s", result)
+		}
+	})
+
+	t.Run("collects every page with auto_paginate", func(t *testing.T) {
+		afters = nil
+		result, err := tool.Execute(context.Background(), map[string]interface{}{"auto_paginate": true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(afters) != 2 || afters[1] != "user_1" {
+			t.Errorf("expected two page requests, got %v", afters)
+		}
+		if !strings.Contains(result, "Found 2 users") || !strings.Contains(result, "user_1") || !strings.Contains(result, "user_2") {
+			t.Errorf("expected both users in result, got:\n%s", result)
+		}
+	})
+}
+
+func TestAssignIncidentRoleTool_ResolvesRoleName(t *testing.T) {
+	var assigned []string
+	client := newAssignRoleTestClient(t, &assigned)
+	tool := NewAssignIncidentRoleTool(client)
+
+	if _, err := tool.Execute(context.Background(), map[string]interface{}{
+		"id":        "inc_1",
+		"role_name": "Communications lead",
+		"user_id":   "user_bob",
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(assigned) != 1 || assigned[0] != "user_bob" {
+		t.Errorf("expected user_bob to be assigned, got %v", assigned)
+	}
+
+	_, err := tool.Execute(context.Background(), map[string]interface{}{
+		"id":        "inc_1",
+		"role_name": "Scribe",
+		"user_id":   "user_bob",
+	})
+	if err == nil || !strings.Contains(err.Error(), "no incident role named 'Scribe'") {
+		t.Errorf("expected unknown role error, got: %v", err)
+	}
+}
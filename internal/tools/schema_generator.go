@@ -29,6 +29,9 @@ func GenerateFieldsDescription(exampleType interface{}) string {
 	}
 
 	desc.WriteString("\nExamples: \"id,name\" or with nested fields: \"id,name,severity.name,incident_status.category\"\n")
+	desc.WriteString("Paths through arrays apply to each element: \"incident_role_assignments.role.name\". ")
+	desc.WriteString("Prefix fields with \"-\" to drop them instead: \"-incident_role_assignments,-custom_field_entries\". ")
+	desc.WriteString("Use \"*\" to keep every field at a level: \"*,severity.name\". Keeping and dropping fields cannot be mixed.\n")
 	desc.WriteString("Omit to return all fields.")
 
 	return desc.String()
@@ -0,0 +1,231 @@
+package tools
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ValidateArguments checks a tool call's arguments against the tool's input
+// schema before it runs, so tools can rely on the declared shape. It covers
+// the parts of JSON Schema the tools use: type, required, enum, properties,
+// items and additionalProperties. A null value is treated like an omitted
+// one, since clients often send null for optional arguments.
+func ValidateArguments(schema map[string]interface{}, args map[string]interface{}) error {
+	if args == nil {
+		args = map[string]interface{}{}
+	}
+	return validateValue(schema, args, "")
+}
+
+func validateValue(schema map[string]interface{}, value interface{}, path string) error {
+	if types := schemaStrings(schema["type"]); len(types) > 0 && !matchesAnyType(value, types) {
+		return fmt.Errorf("%s must be of type %s, got %s", describePath(path), strings.Join(types, " or "), jsonTypeName(value))
+	}
+
+	if enum := schemaList(schema["enum"]); enum != nil && !enumContains(enum, value) {
+		options := make([]string, len(enum))
+		for i, option := range enum {
+			options[i] = fmt.Sprint(option)
+		}
+		return fmt.Errorf("%s must be one of: %s, got %v", describePath(path), strings.Join(options, ", "), value)
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return validateObject(schema, v, path)
+	case []interface{}:
+		items, ok := schema["items"].(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		for i, item := range v {
+			if item == nil {
+				continue
+			}
+			if err := validateValue(items, item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func validateObject(schema map[string]interface{}, object map[string]interface{}, path string) error {
+	for _, name := range schemaStrings(schema["required"]) {
+		if object[name] == nil {
+			return fmt.Errorf("missing required %s", describePath(joinPath(path, name)))
+		}
+	}
+
+	properties, _ := schema["properties"].(map[string]interface{})
+
+	// Check in a fixed order so the same call always reports the same problem
+	names := make([]string, 0, len(object))
+	for name := range object {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		value := object[name]
+		if value == nil {
+			continue
+		}
+
+		propertySchema, declared := properties[name].(map[string]interface{})
+		if !declared {
+			switch additional := schema["additionalProperties"].(type) {
+			case bool:
+				if !additional {
+					return fmt.Errorf("unknown %s", describePath(joinPath(path, name)))
+				}
+				continue
+			case map[string]interface{}:
+				propertySchema = additional
+			default:
+				continue
+			}
+		}
+
+		if err := validateValue(propertySchema, value, joinPath(path, name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// matchesAnyType reports whether value is one of the JSON Schema types
+func matchesAnyType(value interface{}, types []string) bool {
+	for _, schemaType := range types {
+		if matchesType(value, schemaType) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesType(value interface{}, schemaType string) bool {
+	switch schemaType {
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "number":
+		_, ok := toFloat(value)
+		return ok
+	case "integer":
+		number, ok := toFloat(value)
+		return ok && number == float64(int64(number))
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "null":
+		return value == nil
+	}
+	return true
+}
+
+// jsonTypeName names value's JSON type for error messages
+func jsonTypeName(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	if number, ok := toFloat(value); ok {
+		if number == float64(int64(number)) {
+			return "integer"
+		}
+		return "number"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+// toFloat converts any Go numeric value to float64. Arguments decoded from
+// JSON are always float64, but callers in Go may pass ints.
+func toFloat(value interface{}) (float64, bool) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	}
+	return 0, false
+}
+
+func enumContains(enum []interface{}, value interface{}) bool {
+	for _, option := range enum {
+		if optionNumber, ok := toFloat(option); ok {
+			if number, ok := toFloat(value); ok && number == optionNumber {
+				return true
+			}
+			continue
+		}
+		if reflect.DeepEqual(option, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// schemaList returns a schema keyword holding a list, which tools declare
+// either as []interface{} or as a typed slice such as []string
+func schemaList(value interface{}) []interface{} {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice {
+		return nil
+	}
+	list := make([]interface{}, v.Len())
+	for i := range list {
+		list[i] = v.Index(i).Interface()
+	}
+	return list
+}
+
+// schemaStrings returns a schema keyword that is either a single string or a
+// list of strings, such as "type" or "required"
+func schemaStrings(value interface{}) []string {
+	if s, ok := value.(string); ok {
+		return []string{s}
+	}
+	var values []string
+	for _, item := range schemaList(value) {
+		if s, ok := item.(string); ok {
+			values = append(values, s)
+		}
+	}
+	return values
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// describePath names an argument in an error message: top-level arguments
+// are "argument 'x'", nested ones "field 'x.y'"
+func describePath(path string) string {
+	if !strings.ContainsAny(path, ".[") {
+		return fmt.Sprintf("argument '%s'", path)
+	}
+	return fmt.Sprintf("field '%s'", path)
+}
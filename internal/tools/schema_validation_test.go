@@ -0,0 +1,78 @@
+package tools
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateArguments(t *testing.T) {
+	schema := map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"incident_id": map[string]interface{}{"type": "string"},
+			"page_size":   map[string]interface{}{"type": "integer"},
+			"mode": map[string]interface{}{
+				"type":  []string{"array", "string"},
+				"items": map[string]interface{}{"type": "string", "enum": []string{"standard", "retrospective"}},
+			},
+			"assignments": map[string]interface{}{
+				"type": "array",
+				"items": map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"role_id": map[string]interface{}{"type": "string"},
+					},
+					"required": []interface{}{"role_id"},
+				},
+			},
+			"labels": map[string]interface{}{
+				"type":                 "object",
+				"additionalProperties": map[string]interface{}{"type": "string"},
+			},
+		},
+		"required":             []string{"incident_id"},
+		"additionalProperties": false,
+	}
+
+	tests := []struct {
+		name    string
+		args    map[string]interface{}
+		wantErr string
+	}{
+		{
+			name: "valid arguments",
+			args: map[string]interface{}{
+				"incident_id": "inc_1",
+				"page_size":   float64(25),
+				"mode":        []interface{}{"standard"},
+				"assignments": []interface{}{map[string]interface{}{"role_id": "role_1"}},
+				"labels":      map[string]interface{}{"team": "payments"},
+			},
+		},
+		{name: "string form of a string or array argument", args: map[string]interface{}{"incident_id": "inc_1", "mode": "standard,retrospective"}},
+		{name: "null optional argument", args: map[string]interface{}{"incident_id": "inc_1", "page_size": nil}},
+		{name: "nil arguments", args: nil, wantErr: "missing required argument 'incident_id'"},
+		{name: "missing required argument", args: map[string]interface{}{"page_size": float64(25)}, wantErr: "missing required argument 'incident_id'"},
+		{name: "unknown argument", args: map[string]interface{}{"incident_id": "inc_1", "incidnet_id": "inc_2"}, wantErr: "unknown argument 'incidnet_id'"},
+		{name: "wrong type", args: map[string]interface{}{"incident_id": float64(1)}, wantErr: "argument 'incident_id' must be of type string, got integer"},
+		{name: "fractional integer", args: map[string]interface{}{"incident_id": "inc_1", "page_size": 2.5}, wantErr: "argument 'page_size' must be of type integer, got number"},
+		{name: "value outside enum", args: map[string]interface{}{"incident_id": "inc_1", "mode": []interface{}{"tutorial"}}, wantErr: "field 'mode[0]' must be one of: standard, retrospective, got tutorial"},
+		{name: "nested required field", args: map[string]interface{}{"incident_id": "inc_1", "assignments": []interface{}{map[string]interface{}{}}}, wantErr: "missing required field 'assignments[0].role_id'"},
+		{name: "additional property schema", args: map[string]interface{}{"incident_id": "inc_1", "labels": map[string]interface{}{"team": true}}, wantErr: "field 'labels.team' must be of type string, got boolean"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateArguments(schema, tt.args)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
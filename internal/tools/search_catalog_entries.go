@@ -0,0 +1,241 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+const (
+	// catalogSearchPageSize is the page size used when scanning catalog entries
+	catalogSearchPageSize = 250
+	// maxCatalogSearchScan is the most catalog entries scanned by one search
+	maxCatalogSearchScan = 10000
+)
+
+// catalogEntryMatch is a catalog entry found by search_catalog_entries, with
+// what matched the query
+type catalogEntryMatch struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	Aliases    []string `json:"aliases"`
+	ExternalID string   `json:"external_id,omitempty"`
+	MatchedOn  string   `json:"matched_on"`
+	Exact      bool     `json:"exact"`
+}
+
+// SearchCatalogEntriesTool finds catalog entries of a type by name or alias
+type SearchCatalogEntriesTool struct {
+	client    *incidentio.Client
+	pageSize  int
+	scanLimit int
+}
+
+func NewSearchCatalogEntriesTool(client *incidentio.Client) *SearchCatalogEntriesTool {
+	return &SearchCatalogEntriesTool{
+		client:    client,
+		pageSize:  catalogSearchPageSize,
+		scanLimit: maxCatalogSearchScan,
+	}
+}
+
+func (t *SearchCatalogEntriesTool) Name() string {
+	return "search_catalog_entries"
+}
+
+func (t *SearchCatalogEntriesTool) Description() string {
+	return `Search the entries of a catalog type by name or alias, returning their IDs.
+
+Use this to map a value such as "Payments" or "payments-api" to the catalog entry ID needed for catalog-backed custom fields.
+
+MATCHING: The query is first looked up with the API's identifier filter, which matches an entry's name, alias or external ID exactly. If nothing matches exactly, this tool pages through the type's entries and keeps those whose name or any alias contains the query as a case-insensitive substring. At most 10000 entries are scanned per search.
+
+USAGE WORKFLOW:
+1. Call 'list_catalog_types' to find the catalog type ID
+2. Search with the value you want to map
+3. Use the returned entry ID as a custom field value, or with get_catalog_entry
+
+PARAMETERS:
+- catalog_type_id: Required. The catalog type ID to search
+- query: Required. Name or alias to look for
+- max_results: Optional. Maximum number of entries to return (default 25, max 250)
+
+EXAMPLES:
+- Find a service: {"catalog_type_id": "01HXYZ...", "query": "payments"}
+
+Each match includes "matched_on" (name, alias or external_id) and "exact". The response includes "scanned" (entries examined by the client-side search) and "truncated" (true if more entries could have matched beyond max_results or the scan limit).`
+}
+
+func (t *SearchCatalogEntriesTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"catalog_type_id": map[string]interface{}{
+				"type":        "string",
+				"description": "The catalog type ID to search entries of",
+			},
+			"query": map[string]interface{}{
+				"type":        "string",
+				"description": "Name or alias to search for (case-insensitive)",
+			},
+			"max_results": map[string]interface{}{
+				"type":        "integer",
+				"description": "Maximum number of matching entries to return (default 25, max 250)",
+				"default":     defaultSearchResults,
+			},
+			"fields": fieldsProperty(),
+		},
+		"required":             []interface{}{"catalog_type_id", "query"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *SearchCatalogEntriesTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	catalogTypeID, ok := args["catalog_type_id"].(string)
+	if !ok || catalogTypeID == "" {
+		return "", fmt.Errorf("catalog_type_id parameter is required")
+	}
+
+	query, _ := args["query"].(string)
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return "", fmt.Errorf("query parameter is required and must be a non-empty string")
+	}
+
+	maxResults := defaultSearchResults
+	if value, ok := args["max_results"].(float64); ok {
+		maxResults = int(value)
+	}
+	if maxResults < 1 || maxResults > maxSearchResults {
+		return "", fmt.Errorf("max_results must be between 1 and %d, got %d", maxSearchResults, maxResults)
+	}
+
+	// The identifier filter is an exact match, so try it before scanning
+	exact, err := t.client.ListCatalogEntries(ctx, incidentio.ListCatalogEntriesOptions{
+		CatalogTypeID: catalogTypeID,
+		PageSize:      maxResults,
+		Identifier:    query,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to search catalog entries: %w", err)
+	}
+
+	matches := []catalogEntryMatch{}
+	for _, entry := range exact.CatalogEntries {
+		match, ok := matchCatalogEntry(entry, query)
+		if !ok {
+			// The identifier filter also matches external IDs
+			match = newCatalogEntryMatch(entry, "external_id", true)
+		}
+		matches = append(matches, match)
+	}
+
+	scanned := 0
+	truncated := exact.PaginationMeta.After != ""
+	if len(matches) == 0 {
+		matches, scanned, truncated, err = t.scan(ctx, catalogTypeID, query, maxResults)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return formatJSONResult(map[string]interface{}{
+		"catalog_entries": matches,
+		"query":           query,
+		"scanned":         scanned,
+		"truncated":       truncated,
+	}, args)
+}
+
+// scan pages through the entries of a catalog type and returns up to limit
+// whose name or an alias contains query, exact matches first, along with the
+// number of entries scanned. The returned flag is true when the limit or scan
+// limit stopped the search before every entry had been checked.
+func (t *SearchCatalogEntriesTool) scan(ctx context.Context, catalogTypeID, query string, limit int) ([]catalogEntryMatch, int, bool, error) {
+	opts := incidentio.ListCatalogEntriesOptions{CatalogTypeID: catalogTypeID, PageSize: t.pageSize}
+
+	matches := []catalogEntryMatch{}
+	scanned := 0
+	truncated := false
+	guard := incidentio.NewPaginationGuard("catalog entries", "")
+	for {
+		resp, err := t.client.ListCatalogEntries(ctx, opts)
+		if err != nil {
+			return nil, scanned, false, fmt.Errorf("failed to search catalog entries: %w", err)
+		}
+
+		for _, entry := range resp.CatalogEntries {
+			scanned++
+			if match, ok := matchCatalogEntry(entry, query); ok {
+				matches = append(matches, match)
+			}
+		}
+
+		if resp.PaginationMeta.After == "" || len(resp.CatalogEntries) == 0 {
+			break
+		}
+		if scanned >= t.scanLimit {
+			truncated = true
+			break
+		}
+		if err := guard.Next(resp.PaginationMeta.After, len(resp.CatalogEntries)); err != nil {
+			return nil, scanned, false, err
+		}
+		ReportProgress(ctx, scanned, t.scanLimit, fmt.Sprintf("Searched %d catalog entries, %d matches so far", scanned, len(matches)))
+		opts.After = resp.PaginationMeta.After
+	}
+
+	// Entries are scanned in catalog order, so rank exact matches first
+	// before cutting the list down to limit
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Exact && !matches[j].Exact
+	})
+	if len(matches) > limit {
+		matches = matches[:limit]
+		truncated = true
+	}
+	return matches, scanned, truncated, nil
+}
+
+// matchCatalogEntry reports whether the entry's name or one of its aliases
+// contains query, ignoring case. An exact match on any of them is preferred
+// over a substring match, and a name over an alias.
+func matchCatalogEntry(entry incidentio.CatalogEntry, query string) (catalogEntryMatch, bool) {
+	query = strings.ToLower(query)
+
+	if strings.ToLower(entry.Name) == query {
+		return newCatalogEntryMatch(entry, "name", true), true
+	}
+	for _, alias := range entry.Aliases {
+		if strings.ToLower(alias) == query {
+			return newCatalogEntryMatch(entry, "alias", true), true
+		}
+	}
+	if strings.Contains(strings.ToLower(entry.Name), query) {
+		return newCatalogEntryMatch(entry, "name", false), true
+	}
+	for _, alias := range entry.Aliases {
+		if strings.Contains(strings.ToLower(alias), query) {
+			return newCatalogEntryMatch(entry, "alias", false), true
+		}
+	}
+	return catalogEntryMatch{}, false
+}
+
+func newCatalogEntryMatch(entry incidentio.CatalogEntry, matchedOn string, exact bool) catalogEntryMatch {
+	aliases := entry.Aliases
+	if aliases == nil {
+		aliases = []string{}
+	}
+	return catalogEntryMatch{
+		ID:         entry.ID,
+		Name:       entry.Name,
+		Aliases:    aliases,
+		ExternalID: entry.ExternalID,
+		MatchedOn:  matchedOn,
+		Exact:      exact,
+	}
+}
@@ -0,0 +1,121 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+// newCatalogSearchTool serves two pages of catalog entries. Identifier lookups
+// find "pay", an alias of the payments entry, and nothing else.
+func newCatalogSearchTool(t *testing.T, requests *[]string) *SearchCatalogEntriesTool {
+	t.Helper()
+
+	payments := `{"id": "entry_payments", "name": "Payments API", "aliases": ["pay", "billing"], "external_id": "svc-payments"}`
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/catalog_entries" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		query := r.URL.Query()
+		if got := query.Get("catalog_type_id"); got != "type_services" {
+			t.Errorf("expected catalog_type_id type_services, got %q", got)
+		}
+		*requests = append(*requests, r.URL.RawQuery)
+
+		switch {
+		case query.Get("identifier") == "pay":
+			fmt.Fprintf(w, `{"catalog_entries": [%s], "pagination_meta": {}}`, payments)
+		case query.Get("identifier") != "":
+			fmt.Fprint(w, `{"catalog_entries": [], "pagination_meta": {}}`)
+		case query.Get("after") == "":
+			fmt.Fprintf(w, `{"catalog_entries": [
+				{"id": "entry_checkout", "name": "Checkout", "aliases": ["cart"]},
+				%s
+			], "pagination_meta": {"after": "entry_payments"}}`, payments)
+		default:
+			fmt.Fprint(w, `{"catalog_entries": [
+				{"id": "entry_ledger", "name": "Ledger", "aliases": ["payments-ledger"]}
+			], "pagination_meta": {}}`)
+		}
+	})
+
+	return NewSearchCatalogEntriesTool(client)
+}
+
+type catalogSearchResult struct {
+	CatalogEntries []catalogEntryMatch `json:"catalog_entries"`
+	Scanned        int                 `json:"scanned"`
+	Truncated      bool                `json:"truncated"`
+}
+
+func executeCatalogSearch(t *testing.T, query string) (catalogSearchResult, []string) {
+	t.Helper()
+
+	var requests []string
+	result, err := newCatalogSearchTool(t, &requests).Execute(context.Background(), map[string]interface{}{
+		"catalog_type_id": "type_services",
+		"query":           query,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var parsed catalogSearchResult
+	if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+		t.Fatalf("failed to parse result: %v", err)
+	}
+	return parsed, requests
+}
+
+func TestSearchCatalogEntriesTool_NameMatch(t *testing.T) {
+	result, requests := executeCatalogSearch(t, "PAYMENTS")
+
+	// No exact match, so both pages are scanned. The ledger only matches on
+	// an alias and the payments entry on its name.
+	if len(requests) != 3 {
+		t.Errorf("expected an identifier lookup and two pages, got %v", requests)
+	}
+	expected := []catalogEntryMatch{
+		{ID: "entry_payments", Name: "Payments API", Aliases: []string{"pay", "billing"}, ExternalID: "svc-payments", MatchedOn: "name"},
+		{ID: "entry_ledger", Name: "Ledger", Aliases: []string{"payments-ledger"}, MatchedOn: "alias"},
+	}
+	if !reflect.DeepEqual(result.CatalogEntries, expected) {
+		t.Errorf("expected matches %+v, got %+v", expected, result.CatalogEntries)
+	}
+	if result.Scanned != 3 || result.Truncated {
+		t.Errorf("expected 3 entries scanned and no truncation, got scanned=%d truncated=%v", result.Scanned, result.Truncated)
+	}
+}
+
+func TestSearchCatalogEntriesTool_AliasMatch(t *testing.T) {
+	result, requests := executeCatalogSearch(t, "pay")
+
+	// The identifier filter finds the alias, so nothing is scanned
+	if len(requests) != 1 {
+		t.Errorf("expected only the identifier lookup, got %v", requests)
+	}
+	expected := []catalogEntryMatch{
+		{ID: "entry_payments", Name: "Payments API", Aliases: []string{"pay", "billing"}, ExternalID: "svc-payments", MatchedOn: "alias", Exact: true},
+	}
+	if !reflect.DeepEqual(result.CatalogEntries, expected) {
+		t.Errorf("expected matches %+v, got %+v", expected, result.CatalogEntries)
+	}
+}
+
+func TestSearchCatalogEntriesTool_RequiredParameters(t *testing.T) {
+	tool := &SearchCatalogEntriesTool{}
+
+	for _, args := range []map[string]interface{}{
+		{"query": "payments"},
+		{"catalog_type_id": "type_services", "query": "  "},
+		{"catalog_type_id": "type_services", "query": "payments", "max_results": float64(0)},
+	} {
+		if _, err := tool.Execute(context.Background(), args); err == nil {
+			t.Errorf("expected error for %v", args)
+		}
+	}
+}
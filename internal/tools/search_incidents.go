@@ -0,0 +1,219 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+const (
+	// defaultSearchResults is the default number of matching incidents returned
+	defaultSearchResults = 25
+	// maxSearchResults is the largest max_results accepted by search_incidents
+	maxSearchResults = 250
+	// searchPageSize is the page size used when scanning incidents for matches
+	searchPageSize = 250
+)
+
+// SearchIncidentsTool finds incidents whose name or summary contains some text
+type SearchIncidentsTool struct {
+	client    *incidentio.Client
+	listTool  *ListIncidentsTool
+	pageSize  int
+	scanLimit int
+}
+
+func NewSearchIncidentsTool(client *incidentio.Client) *SearchIncidentsTool {
+	return &SearchIncidentsTool{
+		client:    client,
+		listTool:  newListIncidentsTool(client),
+		pageSize:  searchPageSize,
+		scanLimit: maxAutoPaginateLimit,
+	}
+}
+
+func (t *SearchIncidentsTool) Name() string {
+	return "search_incidents"
+}
+
+func (t *SearchIncidentsTool) Description() string {
+	return `Search incidents by free text in their name or summary.
+
+MATCHING: The incident.io API has no full-text search, so matching is done CLIENT-SIDE. This tool pages through incidents (newest first, applying any status, severity and date filters on the server) and keeps those whose name or summary contains the query as a case-insensitive substring. At most 10000 incidents are scanned per search.
+
+USAGE WORKFLOW:
+1. Search with a distinctive phrase, e.g. a service or error name
+2. Narrow large searches with status, severity or date filters - they are applied by the API and make searches much faster
+3. Use get_incident with a returned ID for full details
+
+PARAMETERS:
+- query: Required. Text to look for in incident names and summaries
+- fields: Comma-separated fields to return for each incident, using the same syntax as list_incidents
+  * Default: "id,reference,name,summary,permalink,created_at"
+- status, severity, mode: Same filters as list_incidents
+- created_at_gte, created_at_lte, created_at_range: Same created_at filters as list_incidents
+- updated_at_gte, updated_at_lte, updated_at_range: Same updated_at filters as list_incidents
+- max_results: Maximum number of matching incidents to return (default 25, max 250)
+
+EXAMPLES:
+- Find database incidents: {"query": "postgres"}
+- Recent closed matches only: {"query": "checkout latency", "status": "closed", "created_at_gte": "2024-12-01"}
+
+The response includes "scanned" (incidents examined) and "truncated" (true if more incidents could have matched beyond max_results or the scan limit).`
+}
+
+func (t *SearchIncidentsTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"query": map[string]interface{}{
+				"type":        "string",
+				"description": "Text to search for in incident names and summaries (case-insensitive substring match)",
+			},
+			"fields": map[string]interface{}{
+				"type":        "string",
+				"description": "Comma-separated fields to return for each incident (e.g. \"id,reference,name\")",
+			},
+			"status": map[string]interface{}{
+				"type":        []string{"array", "string"},
+				"items":       map[string]interface{}{"type": "string"},
+				"description": "Filter by incident status, as an array or a comma-separated string. Accepts the same values and aliases as list_incidents.",
+			},
+			"severity": map[string]interface{}{
+				"type":        []string{"array", "string"},
+				"items":       map[string]interface{}{"type": "string"},
+				"description": "Filter by severity name or ID, as an array or a comma-separated string. Accepts the same values as list_incidents.",
+			},
+			"mode": map[string]interface{}{
+				"type":        []string{"array", "string"},
+				"items":       map[string]interface{}{"type": "string", "enum": incidentModes},
+				"description": "Filter by incident mode (standard, retrospective, tutorial), as an array or a comma-separated string.",
+			},
+			"created_at_gte": map[string]interface{}{
+				"type":        "string",
+				"description": "Only search incidents created on or after this date (ISO 8601 format)",
+			},
+			"created_at_lte": map[string]interface{}{
+				"type":        "string",
+				"description": "Only search incidents created on or before this date (ISO 8601 format)",
+			},
+			"created_at_range": map[string]interface{}{
+				"type":        "string",
+				"description": "Only search incidents created within a tilde-separated date range, e.g. \"2024-12-01~2024-12-31\"",
+			},
+			"updated_at_gte": map[string]interface{}{
+				"type":        "string",
+				"description": "Only search incidents updated on or after this date (ISO 8601 format)",
+			},
+			"updated_at_lte": map[string]interface{}{
+				"type":        "string",
+				"description": "Only search incidents updated on or before this date (ISO 8601 format)",
+			},
+			"updated_at_range": map[string]interface{}{
+				"type":        "string",
+				"description": "Only search incidents updated within a tilde-separated date range, e.g. \"2024-12-01~2024-12-31\"",
+			},
+			"max_results": map[string]interface{}{
+				"type":        "integer",
+				"description": "Maximum number of matching incidents to return (default 25, max 250)",
+				"default":     defaultSearchResults,
+			},
+		},
+		"required":             []interface{}{"query"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *SearchIncidentsTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	query, _ := args["query"].(string)
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return "", fmt.Errorf("query parameter is required and must be a non-empty string")
+	}
+
+	maxResults := defaultSearchResults
+	if value, ok := args["max_results"].(float64); ok {
+		maxResults = int(value)
+	}
+	if maxResults < 1 || maxResults > maxSearchResults {
+		return "", fmt.Errorf("max_results must be between 1 and %d, got %d", maxSearchResults, maxResults)
+	}
+
+	opts := &incidentio.ListIncidentsOptions{SortBy: "created_at_newest_first"}
+	if err := t.listTool.applyFilters(ctx, args, opts); err != nil {
+		return "", err
+	}
+
+	matches, scanned, truncated, err := t.search(ctx, opts, query, maxResults)
+	if err != nil {
+		return "", err
+	}
+
+	fieldsStr, ok := args["fields"].(string)
+	if !ok || fieldsStr == "" {
+		fieldsStr = "id,reference,name,summary,permalink,created_at"
+	}
+
+	return FilterFields(map[string]interface{}{
+		"incidents": matches,
+		"query":     query,
+		"scanned":   scanned,
+		"truncated": truncated,
+	}, fieldsStr)
+}
+
+// search pages through incidents matching opts and returns up to limit whose
+// name or summary contains query, along with the number of incidents scanned.
+// The returned flag is true when the limit or scan limit stopped the search
+// before every incident had been checked.
+func (t *SearchIncidentsTool) search(ctx context.Context, opts *incidentio.ListIncidentsOptions, query string, limit int) ([]incidentio.Incident, int, bool, error) {
+	pageOpts := *opts
+	pageOpts.PageSize = t.pageSize
+
+	matches := []incidentio.Incident{}
+	scanned := 0
+	guard := incidentio.NewPaginationGuard("incidents", pageOpts.After)
+	for page := 1; ; page++ {
+		resp, err := t.listTool.listPage(ctx, &pageOpts)
+		if err != nil {
+			return nil, scanned, false, err
+		}
+
+		for i, incident := range resp.Incidents {
+			scanned++
+			if !incidentMatchesQuery(incident, query) {
+				continue
+			}
+
+			matches = append(matches, incident)
+			if len(matches) >= limit {
+				morePages := resp.PaginationMeta.After != ""
+				return matches, scanned, morePages || i < len(resp.Incidents)-1, nil
+			}
+		}
+
+		// A page can be empty once filtered, so only the cursor says whether
+		// the API has more
+		if resp.PaginationMeta.After == "" {
+			return matches, scanned, false, nil
+		}
+		if scanned >= t.scanLimit || page >= maxAutoPaginatePages {
+			return matches, scanned, true, nil
+		}
+		if err := guard.Next(resp.PaginationMeta.After, len(resp.Incidents)); err != nil {
+			return nil, scanned, false, err
+		}
+		ReportProgress(ctx, scanned, t.scanLimit, fmt.Sprintf("Searched %d incidents, %d matches so far", scanned, len(matches)))
+		pageOpts.After = resp.PaginationMeta.After
+	}
+}
+
+// incidentMatchesQuery reports whether the incident's name or summary contains
+// query, ignoring case
+func incidentMatchesQuery(incident incidentio.Incident, query string) bool {
+	query = strings.ToLower(query)
+	return strings.Contains(strings.ToLower(incident.Name), query) ||
+		strings.Contains(strings.ToLower(incident.Summary), query)
+}
@@ -0,0 +1,122 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+// newSearchIncidentsTestClient serves three incidents over two pages
+func newSearchIncidentsTestClient(t *testing.T, requests *int) *incidentio.Client {
+	t.Helper()
+
+	pages := map[string]string{
+		"": `{"incidents": [
+			{"id": "inc_1", "name": "Postgres primary failover", "summary": "Replica promoted after disk filled"},
+			{"id": "inc_2", "name": "Checkout errors", "summary": "Payments API returned 500s while POSTGRES connections were exhausted"}
+		], "pagination_meta": {"after": "inc_2", "page_size": 2}}`,
+		"inc_2": `{"incidents": [
+			{"id": "inc_3", "name": "Slow dashboard", "summary": "Frontend bundle regression"}
+		], "pagination_meta": {"page_size": 2}}`,
+	}
+
+	return newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		page, ok := pages[r.URL.Query().Get("after")]
+		if r.URL.Path != "/incidents" || !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, page)
+	})
+}
+
+type searchIncidentsResult struct {
+	Incidents []struct {
+		ID string `json:"id"`
+	} `json:"incidents"`
+	Scanned   int  `json:"scanned"`
+	Truncated bool `json:"truncated"`
+}
+
+func runSearchIncidents(t *testing.T, args map[string]interface{}) (searchIncidentsResult, int) {
+	t.Helper()
+
+	requests := 0
+	tool := NewSearchIncidentsTool(newSearchIncidentsTestClient(t, &requests))
+	tool.pageSize = 2
+
+	result, err := tool.Execute(context.Background(), args)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var response searchIncidentsResult
+	if err := json.Unmarshal([]byte(result), &response); err != nil {
+		t.Fatalf("failed to parse result: %v", err)
+	}
+	return response, requests
+}
+
+func TestSearchIncidentsTool_Matching(t *testing.T) {
+	tests := []struct {
+		name        string
+		query       string
+		expectedIDs []string
+	}{
+		{name: "name match", query: "dashboard", expectedIDs: []string{"inc_3"}},
+		{name: "summary match", query: "disk filled", expectedIDs: []string{"inc_1"}},
+		{name: "name and summary match ignoring case", query: "postgres", expectedIDs: []string{"inc_1", "inc_2"}},
+		{name: "no match", query: "kafka", expectedIDs: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response, _ := runSearchIncidents(t, map[string]interface{}{"query": tt.query, "fields": "id"})
+
+			if len(response.Incidents) != len(tt.expectedIDs) {
+				t.Fatalf("expected %d matches, got %+v", len(tt.expectedIDs), response.Incidents)
+			}
+			for i, id := range tt.expectedIDs {
+				if response.Incidents[i].ID != id {
+					t.Errorf("position %d: expected %s, got %s", i, id, response.Incidents[i].ID)
+				}
+			}
+			if response.Scanned != 3 || response.Truncated {
+				t.Errorf("expected all 3 incidents scanned without truncation, got scanned=%d truncated=%t", response.Scanned, response.Truncated)
+			}
+		})
+	}
+}
+
+func TestSearchIncidentsTool_StopsAtMaxResults(t *testing.T) {
+	response, requests := runSearchIncidents(t, map[string]interface{}{
+		"query":       "postgres",
+		"fields":      "id",
+		"max_results": float64(1),
+	})
+
+	if len(response.Incidents) != 1 || response.Incidents[0].ID != "inc_1" {
+		t.Errorf("expected only inc_1, got %+v", response.Incidents)
+	}
+	if !response.Truncated {
+		t.Error("expected truncated to be true")
+	}
+	if requests != 1 {
+		t.Errorf("expected 1 page request, got %d", requests)
+	}
+}
+
+func TestSearchIncidentsTool_RequiresQuery(t *testing.T) {
+	tool := &SearchIncidentsTool{}
+
+	for _, args := range []map[string]interface{}{{}, {"query": "  "}} {
+		if _, err := tool.Execute(context.Background(), args); err == nil {
+			t.Errorf("expected error for args %v", args)
+		}
+	}
+}
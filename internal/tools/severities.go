@@ -1,7 +1,7 @@
 package tools
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 
 	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
@@ -39,14 +39,16 @@ IMPORTANT: Severity IDs from this tool are required for create_incident, update_
 
 func (t *ListSeveritiesTool) InputSchema() map[string]interface{} {
 	return map[string]interface{}{
-		"type":                 "object",
-		"properties":           map[string]interface{}{},
+		"type": "object",
+		"properties": map[string]interface{}{
+			"fields": fieldsProperty(),
+		},
 		"additionalProperties": false,
 	}
 }
 
-func (t *ListSeveritiesTool) Execute(args map[string]interface{}) (string, error) {
-	result, err := t.client.ListSeverities()
+func (t *ListSeveritiesTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	result, err := t.client.ListSeverities(ctx)
 	if err != nil {
 		return "", fmt.Errorf("failed to list severities: %w", err)
 	}
@@ -64,13 +66,7 @@ func (t *ListSeveritiesTool) Execute(args map[string]interface{}) (string, error
 		output += "\n"
 	}
 
-	// Also return the raw JSON
-	jsonOutput, err := json.MarshalIndent(result, "", "  ")
-	if err != nil {
-		return output, nil
-	}
-
-	return output + "\nRaw JSON:\n" + string(jsonOutput), nil
+	return withRawJSON(output, result, args)
 }
 
 // GetSeverityTool gets a specific severity by ID
@@ -109,19 +105,20 @@ func (t *GetSeverityTool) InputSchema() map[string]interface{} {
 				"type":        "string",
 				"description": "The severity ID",
 			},
+			"fields": fieldsProperty(),
 		},
 		"required":             []interface{}{"id"},
 		"additionalProperties": false,
 	}
 }
 
-func (t *GetSeverityTool) Execute(args map[string]interface{}) (string, error) {
+func (t *GetSeverityTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
 	id, ok := args["id"].(string)
 	if !ok || id == "" {
 		return "", fmt.Errorf("id parameter is required")
 	}
 
-	severity, err := t.client.GetSeverity(id)
+	severity, err := t.client.GetSeverity(ctx, id)
 	if err != nil {
 		return "", fmt.Errorf("failed to get severity: %w", err)
 	}
@@ -136,11 +133,5 @@ func (t *GetSeverityTool) Execute(args map[string]interface{}) (string, error) {
 	output += fmt.Sprintf("Created: %s\n", severity.CreatedAt.Format("2006-01-02 15:04:05"))
 	output += fmt.Sprintf("Updated: %s\n", severity.UpdatedAt.Format("2006-01-02 15:04:05"))
 
-	// Also return the raw JSON
-	jsonOutput, err := json.MarshalIndent(severity, "", "  ")
-	if err != nil {
-		return output, nil
-	}
-
-	return output + "\nRaw JSON:\n" + string(jsonOutput), nil
+	return withRawJSON(output, severity, args)
 }
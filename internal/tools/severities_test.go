@@ -0,0 +1,22 @@
+package tools
+
+import (
+	"testing"
+)
+
+func TestListSeveritiesTool_Schema(t *testing.T) {
+	tool := &ListSeveritiesTool{}
+
+	if tool.Name() != "list_severities" {
+		t.Errorf("expected name 'list_severities', got %s", tool.Name())
+	}
+
+	schema := tool.InputSchema()
+	if schema["type"] != "object" {
+		t.Error("schema type should be 'object'")
+	}
+	properties := schema["properties"].(map[string]interface{})
+	if _, ok := properties["fields"]; !ok || len(properties) != 1 {
+		t.Errorf("expected only the fields parameter, got %v", properties)
+	}
+}
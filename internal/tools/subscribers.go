@@ -0,0 +1,195 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+// ListIncidentSubscribersTool lists the users subscribed to an incident
+type ListIncidentSubscribersTool struct {
+	client *incidentio.Client
+}
+
+func NewListIncidentSubscribersTool(client *incidentio.Client) *ListIncidentSubscribersTool {
+	return &ListIncidentSubscribersTool{client: client}
+}
+
+func (t *ListIncidentSubscribersTool) Name() string {
+	return "list_incident_subscribers"
+}
+
+func (t *ListIncidentSubscribersTool) Description() string {
+	return `List the users subscribed to an incident. Subscribers are notified of incident updates without holding a role.
+
+USAGE WORKFLOW:
+1. Get the incident ID or reference from list_incidents or get_incident
+2. Call this tool to see who is already following the incident
+3. Use add_incident_subscriber to bring in someone else
+
+PARAMETERS:
+- incident_id: Required. The incident ID or reference (e.g. "INC-123")
+
+EXAMPLES:
+- List subscribers: {"incident_id": "01HXYZ..."}
+- By reference: {"incident_id": "INC-123"}
+- Names only: {"incident_id": "INC-123", "fields": "user.name,user.email"}`
+}
+
+func (t *ListIncidentSubscribersTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"incident_id": map[string]interface{}{
+				"type":        "string",
+				"description": "The incident ID or reference",
+			},
+			"fields": fieldsProperty(),
+		},
+		"required":             []interface{}{"incident_id"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *ListIncidentSubscribersTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	identifier, _ := args["incident_id"].(string)
+	if strings.TrimSpace(identifier) == "" {
+		return "", fmt.Errorf("incident_id parameter is required")
+	}
+
+	incidentID, err := resolveIncidentID(ctx, t.client, identifier)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := t.client.ListIncidentSubscribers(ctx, incidentID)
+	if err != nil {
+		return "", err
+	}
+
+	return formatJSONResult(resp, args)
+}
+
+// AddIncidentSubscriberTool subscribes a user to an incident
+type AddIncidentSubscriberTool struct {
+	client *incidentio.Client
+}
+
+func NewAddIncidentSubscriberTool(client *incidentio.Client) *AddIncidentSubscriberTool {
+	return &AddIncidentSubscriberTool{client: client}
+}
+
+func (t *AddIncidentSubscriberTool) Name() string {
+	return "add_incident_subscriber"
+}
+
+func (t *AddIncidentSubscriberTool) Description() string {
+	return `Subscribe a user to an incident so they are notified of its updates.
+
+USAGE WORKFLOW:
+1. Get the incident ID or reference from list_incidents or get_incident
+2. Identify the user by user_id (from list_users) or by email
+3. Call this tool. Users who are already subscribed are left as they are
+
+PARAMETERS:
+- incident_id: Required. The incident ID or reference (e.g. "INC-123")
+- user_id: The user to subscribe. Takes precedence over email
+- email: Email address of the user to subscribe
+
+EXAMPLES:
+- By user ID: {"incident_id": "01HXYZ...", "user_id": "01HABC..."}
+- By email: {"incident_id": "INC-123", "email": "jane@example.com"}
+
+IMPORTANT: If no user has the email address, the error lists users with similar addresses.`
+}
+
+func (t *AddIncidentSubscriberTool) InputSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"incident_id": map[string]interface{}{
+				"type":        "string",
+				"description": "The incident ID or reference",
+			},
+			"user_id": map[string]interface{}{
+				"type":        "string",
+				"description": "The user ID to subscribe. Takes precedence over email",
+			},
+			"email": map[string]interface{}{
+				"type":        "string",
+				"description": "Email address of the user to subscribe",
+			},
+		},
+		"required":             []interface{}{"incident_id"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *AddIncidentSubscriberTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	identifier, _ := args["incident_id"].(string)
+	if strings.TrimSpace(identifier) == "" {
+		return "", fmt.Errorf("incident_id parameter is required")
+	}
+
+	userID, _ := args["user_id"].(string)
+	email, _ := args["email"].(string)
+	if userID == "" {
+		if strings.TrimSpace(email) == "" {
+			return "", fmt.Errorf("either user_id or email parameter is required")
+		}
+		user, err := t.client.FindUserByEmail(ctx, email)
+		if err != nil {
+			return "", err
+		}
+		userID = user.ID
+	}
+
+	incidentID, err := resolveIncidentID(ctx, t.client, identifier)
+	if err != nil {
+		return "", err
+	}
+
+	existing, err := t.client.ListIncidentSubscribers(ctx, incidentID)
+	if err != nil {
+		return "", fmt.Errorf("failed to list incident subscribers: %w", err)
+	}
+	for _, subscriber := range existing.IncidentSubscribers {
+		if subscriber.User.ID == userID {
+			return formatSubscriberResult(fmt.Sprintf("User %s is already subscribed to incident %s", subscriberName(subscriber.User), identifier), subscriber)
+		}
+	}
+
+	subscriber, err := t.client.AddIncidentSubscriber(ctx, incidentID, &incidentio.AddIncidentSubscriberRequest{UserID: userID})
+	if err != nil {
+		return "", fmt.Errorf("failed to add subscriber: %w", err)
+	}
+
+	return formatSubscriberResult(fmt.Sprintf("Subscribed %s to incident %s", subscriberName(subscriber.User), identifier), *subscriber)
+}
+
+// subscriberName describes a subscribed user by name, falling back to their
+// email address or ID
+func subscriberName(user incidentio.User) string {
+	switch {
+	case user.Name != "":
+		return user.Name
+	case user.Email != "":
+		return user.Email
+	default:
+		return user.ID
+	}
+}
+
+func formatSubscriberResult(message string, subscriber incidentio.IncidentSubscriber) (string, error) {
+	result, err := json.MarshalIndent(map[string]interface{}{
+		"message":    message,
+		"subscriber": subscriber,
+	}, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to format response: %w", err)
+	}
+	return string(result), nil
+}
@@ -0,0 +1,127 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const subscribersIncidentID = "01JDB6XK3Q2N5ZP8R7C4W9HM1A"
+
+func TestListIncidentSubscribersTool(t *testing.T) {
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/incidents/42":
+			fmt.Fprintf(w, `{"incident": {"id": %q, "reference": "INC-42"}}`, subscribersIncidentID)
+		case "/incidents/" + subscribersIncidentID + "/subscribers":
+			fmt.Fprint(w, `{"incident_subscribers": [
+				{"id": "sub_1", "incident_id": "01JDB6XK3Q2N5ZP8R7C4W9HM1A", "user": {"id": "user_1", "name": "Ada Lovelace", "email": "ada@example.com"}},
+				{"id": "sub_2", "incident_id": "01JDB6XK3Q2N5ZP8R7C4W9HM1A", "user": {"id": "user_2", "name": "Grace Hopper", "email": "grace@example.com"}}
+			]}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	result, err := NewListIncidentSubscribersTool(client).Execute(context.Background(), map[string]interface{}{
+		"incident_id": "INC-42",
+		"fields":      "user.email",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var response struct {
+		IncidentSubscribers []map[string]map[string]string `json:"incident_subscribers"`
+	}
+	if err := json.Unmarshal([]byte(result), &response); err != nil {
+		t.Fatalf("failed to parse result: %v\n%s", err, result)
+	}
+	if len(response.IncidentSubscribers) != 2 {
+		t.Fatalf("expected 2 subscribers, got: %s", result)
+	}
+	if email := response.IncidentSubscribers[1]["user"]["email"]; email != "grace@example.com" {
+		t.Errorf("expected grace@example.com, got %q", email)
+	}
+	if strings.Contains(result, "Ada Lovelace") {
+		t.Errorf("expected fields to drop user names, got: %s", result)
+	}
+}
+
+func TestAddIncidentSubscriberTool_ByEmail(t *testing.T) {
+	var added []string
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/users":
+			if got := r.URL.Query().Get("email"); got != "jane@example.com" {
+				t.Errorf("expected an email lookup for jane@example.com, got %q", got)
+			}
+			fmt.Fprint(w, `{"users": [{"id": "user_jane", "name": "Jane Doe", "email": "Jane@example.com"}], "pagination_meta": {}}`)
+		case r.URL.Path == "/incidents/"+subscribersIncidentID+"/subscribers" && r.Method == "GET":
+			fmt.Fprint(w, `{"incident_subscribers": [
+				{"id": "sub_1", "user": {"id": "user_1", "name": "Ada Lovelace"}}
+			]}`)
+		case r.URL.Path == "/incidents/"+subscribersIncidentID+"/subscribers" && r.Method == "POST":
+			var body map[string]string
+			json.NewDecoder(r.Body).Decode(&body)
+			added = append(added, body["user_id"])
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"incident_subscriber": {"id": "sub_2", "incident_id": %q, "user": {"id": "user_jane", "name": "Jane Doe"}}}`, subscribersIncidentID)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	result, err := NewAddIncidentSubscriberTool(client).Execute(context.Background(), map[string]interface{}{
+		"incident_id": subscribersIncidentID,
+		"email":       "jane@example.com",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(added) != 1 || added[0] != "user_jane" {
+		t.Errorf("expected user_jane to be subscribed once, got %v", added)
+	}
+	if !strings.Contains(result, "Subscribed Jane Doe to incident") || !strings.Contains(result, `"id": "sub_2"`) {
+		t.Errorf("unexpected result: %s", result)
+	}
+}
+
+func TestAddIncidentSubscriberTool_AlreadySubscribed(t *testing.T) {
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/incidents/"+subscribersIncidentID+"/subscribers" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `{"incident_subscribers": [
+			{"id": "sub_1", "user": {"id": "user_1", "name": "Ada Lovelace"}}
+		]}`)
+	})
+
+	result, err := NewAddIncidentSubscriberTool(client).Execute(context.Background(), map[string]interface{}{
+		"incident_id": subscribersIncidentID,
+		"user_id":     "user_1",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(result, "Ada Lovelace is already subscribed") {
+		t.Errorf("expected an already subscribed message, got: %s", result)
+	}
+}
+
+func TestAddIncidentSubscriberTool_RequiresUser(t *testing.T) {
+	_, err := NewAddIncidentSubscriberTool(nil).Execute(context.Background(), map[string]interface{}{
+		"incident_id": subscribersIncidentID,
+	})
+	if err == nil || !strings.Contains(err.Error(), "either user_id or email") {
+		t.Errorf("expected a missing user error, got: %v", err)
+	}
+}
@@ -1,8 +1,10 @@
 package tools
 
+import "context"
+
 type Tool interface {
 	Name() string
 	Description() string
 	InputSchema() map[string]interface{}
-	Execute(args map[string]interface{}) (string, error)
+	Execute(ctx context.Context, args map[string]interface{}) (string, error)
 }
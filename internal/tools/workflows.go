@@ -1,6 +1,7 @@
 package tools
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -31,9 +32,13 @@ USAGE WORKFLOW:
 PARAMETERS:
 - page_size: Number of results per page (1-250)
 - after: Pagination cursor for next page
+- enabled: Only return workflows with this enabled state (true for active workflows, false for disabled ones)
+  * The API cannot filter by enabled state, so pages are fetched until at least page_size (default 25) matching workflows are found
+  * Every match on the pages read is returned, so there can be more than page_size; pass the returned after to continue
 
 EXAMPLES:
 - List all workflows: {}
+- List enabled workflows only: {"enabled": true}
 - List with pagination: {"page_size": 50, "after": "cursor_abc"}`
 }
 
@@ -51,12 +56,17 @@ func (t *ListWorkflowsTool) InputSchema() map[string]interface{} {
 				"type":        "string",
 				"description": "Pagination cursor for next page",
 			},
+			"enabled": map[string]interface{}{
+				"type":        "boolean",
+				"description": "Only return workflows with this enabled state",
+			},
+			"fields": fieldsProperty(),
 		},
 		"additionalProperties": false,
 	}
 }
 
-func (t *ListWorkflowsTool) Execute(args map[string]interface{}) (string, error) {
+func (t *ListWorkflowsTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
 	params := &incidentio.ListWorkflowsParams{}
 
 	if pageSize, ok := args["page_size"].(float64); ok {
@@ -66,17 +76,58 @@ func (t *ListWorkflowsTool) Execute(args map[string]interface{}) (string, error)
 		params.After = after
 	}
 
-	result, err := t.client.ListWorkflows(params)
-	if err != nil {
-		return "", fmt.Errorf("failed to list workflows: %w", err)
+	enabled, filter := args["enabled"].(bool)
+	if !filter {
+		result, err := t.client.ListWorkflows(ctx, params)
+		if err != nil {
+			return "", fmt.Errorf("failed to list workflows: %w", err)
+		}
+		return formatJSONResult(result, args)
 	}
 
-	output, err := json.MarshalIndent(result, "", "  ")
+	result, err := t.listWorkflowsByEnabled(ctx, params, enabled)
 	if err != nil {
-		return "", fmt.Errorf("failed to marshal result: %w", err)
+		return "", err
 	}
+	return formatJSONResult(result, args)
+}
 
-	return string(output), nil
+// listWorkflowsByEnabled fetches pages until at least a page of workflows
+// with the given enabled state has been collected, since the API has no
+// enabled filter. Whole pages are kept so the returned cursor never skips a
+// workflow that was fetched but not returned.
+func (t *ListWorkflowsTool) listWorkflowsByEnabled(ctx context.Context, params *incidentio.ListWorkflowsParams, enabled bool) (*incidentio.ListWorkflowsResponse, error) {
+	want := params.PageSize
+	if want == 0 {
+		want = 25
+	}
+
+	var matched []incidentio.Workflow
+	guard := incidentio.NewPaginationGuard("workflows", params.After)
+	for page := 1; ; page++ {
+		resp, err := t.client.ListWorkflows(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list workflows: %w", err)
+		}
+		for _, workflow := range resp.Workflows {
+			if workflow.Enabled == enabled {
+				matched = append(matched, workflow)
+			}
+		}
+
+		after := resp.Pagination.After
+		if after == "" || len(resp.Workflows) == 0 || len(matched) >= want || page >= maxAutoPaginatePages {
+			resp.Workflows = matched
+			if resp.Workflows == nil {
+				resp.Workflows = []incidentio.Workflow{}
+			}
+			return resp, nil
+		}
+		if err := guard.Next(after, len(resp.Workflows)); err != nil {
+			return nil, err
+		}
+		params.After = after
+	}
 }
 
 // GetWorkflowTool gets details of a specific workflow
@@ -103,6 +154,8 @@ USAGE WORKFLOW:
 PARAMETERS:
 - id: Required. The workflow ID to retrieve
 
+Returns the full workflow including its enabled state, recent runs and state data.
+
 EXAMPLES:
 - Get workflow: {"id": "wf_123"}`
 }
@@ -116,29 +169,25 @@ func (t *GetWorkflowTool) InputSchema() map[string]interface{} {
 				"description": "The workflow ID",
 				"minLength":   1,
 			},
+			"fields": fieldsProperty(),
 		},
 		"required":             []string{"id"},
 		"additionalProperties": false,
 	}
 }
 
-func (t *GetWorkflowTool) Execute(args map[string]interface{}) (string, error) {
+func (t *GetWorkflowTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
 	id, ok := args["id"].(string)
 	if !ok || id == "" {
 		return "", fmt.Errorf("workflow ID is required")
 	}
 
-	workflow, err := t.client.GetWorkflow(id)
+	workflow, err := t.client.GetWorkflow(ctx, id)
 	if err != nil {
 		return "", fmt.Errorf("failed to get workflow: %w", err)
 	}
 
-	output, err := json.MarshalIndent(workflow, "", "  ")
-	if err != nil {
-		return "", fmt.Errorf("failed to marshal result: %w", err)
-	}
-
-	return string(output), nil
+	return formatJSONResult(workflow, args)
 }
 
 // UpdateWorkflowTool updates a workflow
@@ -201,7 +250,7 @@ func (t *UpdateWorkflowTool) InputSchema() map[string]interface{} {
 	}
 }
 
-func (t *UpdateWorkflowTool) Execute(args map[string]interface{}) (string, error) {
+func (t *UpdateWorkflowTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
 	id, ok := args["id"].(string)
 	if !ok || id == "" {
 		return "", fmt.Errorf("workflow ID is required")
@@ -221,7 +270,7 @@ func (t *UpdateWorkflowTool) Execute(args map[string]interface{}) (string, error
 		req.State = state
 	}
 
-	workflow, err := t.client.UpdateWorkflow(id, req)
+	workflow, err := t.client.UpdateWorkflow(ctx, id, req)
 	if err != nil {
 		return "", fmt.Errorf("failed to update workflow: %w", err)
 	}
@@ -233,3 +282,144 @@ func (t *UpdateWorkflowTool) Execute(args map[string]interface{}) (string, error
 
 	return string(output), nil
 }
+
+// EnableWorkflowTool turns a workflow on
+type EnableWorkflowTool struct {
+	client *incidentio.Client
+}
+
+func NewEnableWorkflowTool(client *incidentio.Client) *EnableWorkflowTool {
+	return &EnableWorkflowTool{client: client}
+}
+
+func (t *EnableWorkflowTool) Name() string {
+	return "enable_workflow"
+}
+
+func (t *EnableWorkflowTool) Description() string {
+	return `Enable a workflow so its automation runs again.
+
+USAGE WORKFLOW:
+1. Find the workflow ID with list_workflows (use {"enabled": false} to see paused workflows)
+2. Call this tool with the workflow_id
+3. The response shows the workflow with enabled=true
+
+PARAMETERS:
+- workflow_id: Required. The workflow ID to enable
+
+EXAMPLES:
+- Re-enable a paused workflow: {"workflow_id": "wf_123"}
+
+NOTE: If the workflow is already enabled, nothing is changed and the response says so.`
+}
+
+func (t *EnableWorkflowTool) InputSchema() map[string]interface{} {
+	return workflowToggleSchema("The workflow ID to enable")
+}
+
+func (t *EnableWorkflowTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	return setWorkflowEnabled(ctx, t.client, args, true)
+}
+
+// DisableWorkflowTool pauses a workflow
+type DisableWorkflowTool struct {
+	client *incidentio.Client
+}
+
+func NewDisableWorkflowTool(client *incidentio.Client) *DisableWorkflowTool {
+	return &DisableWorkflowTool{client: client}
+}
+
+func (t *DisableWorkflowTool) Name() string {
+	return "disable_workflow"
+}
+
+func (t *DisableWorkflowTool) Description() string {
+	return `Disable a workflow to pause noisy automation, for example during a major incident.
+
+USAGE WORKFLOW:
+1. Find the workflow ID with list_workflows (use {"enabled": true} to see active workflows)
+2. Call this tool with the workflow_id
+3. The response shows the workflow with enabled=false
+4. Call enable_workflow once the automation should run again
+
+PARAMETERS:
+- workflow_id: Required. The workflow ID to disable
+
+EXAMPLES:
+- Pause a workflow: {"workflow_id": "wf_123"}
+
+NOTE: If the workflow is already disabled, nothing is changed and the response says so.`
+}
+
+func (t *DisableWorkflowTool) InputSchema() map[string]interface{} {
+	return workflowToggleSchema("The workflow ID to disable")
+}
+
+func (t *DisableWorkflowTool) Execute(ctx context.Context, args map[string]interface{}) (string, error) {
+	return setWorkflowEnabled(ctx, t.client, args, false)
+}
+
+func workflowToggleSchema(description string) map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"workflow_id": map[string]interface{}{
+				"type":        "string",
+				"description": description,
+				"minLength":   1,
+			},
+		},
+		"required":             []string{"workflow_id"},
+		"additionalProperties": false,
+	}
+}
+
+// setWorkflowEnabled moves a workflow into the requested enabled state,
+// skipping the update if it is already there
+func setWorkflowEnabled(ctx context.Context, client *incidentio.Client, args map[string]interface{}, enabled bool) (string, error) {
+	id, ok := args["workflow_id"].(string)
+	if !ok || id == "" {
+		return "", fmt.Errorf("workflow_id parameter is required and must be a non-empty string")
+	}
+
+	state := "disabled"
+	if enabled {
+		state = "enabled"
+	}
+
+	current, err := client.GetWorkflow(ctx, id)
+	if err != nil {
+		if incidentio.IsNotFound(err) {
+			return "", fmt.Errorf("workflow %s not found. Call list_workflows to see available workflow IDs", id)
+		}
+		return "", fmt.Errorf("failed to get workflow: %w", err)
+	}
+
+	workflow := current
+	changed := current.Enabled != enabled
+	message := fmt.Sprintf("Workflow '%s' is already %s; no change was made", current.Name, state)
+
+	if changed {
+		if enabled {
+			workflow, err = client.EnableWorkflow(ctx, id)
+		} else {
+			workflow, err = client.DisableWorkflow(ctx, id)
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to update workflow: %w", err)
+		}
+		message = fmt.Sprintf("Workflow '%s' is now %s", workflow.Name, state)
+	}
+
+	output, err := json.MarshalIndent(map[string]interface{}{
+		"workflow": workflow,
+		"changed":  changed,
+		"message":  message,
+	}, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(output), nil
+}
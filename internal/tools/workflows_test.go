@@ -0,0 +1,270 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/incident-io/incidentio-mcp-golang/internal/incidentio"
+)
+
+const workflowsListResponse = `{
+	"workflows": [
+		{"id": "wf_page", "name": "Page on-call", "trigger": "incident.created", "enabled": true},
+		{"id": "wf_legacy", "name": "Legacy escalation", "trigger": "incident.updated", "enabled": false},
+		{"id": "wf_notify", "name": "Notify stakeholders", "trigger": "incident.updated", "enabled": true}
+	],
+	"pagination_info": {"page_size": 25}
+}`
+
+func newWorkflowsTestClient(t *testing.T) *incidentio.Client {
+	t.Helper()
+
+	return newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/workflows":
+			fmt.Fprint(w, workflowsListResponse)
+		case "/workflows/wf_page":
+			fmt.Fprint(w, `{"workflow": {
+				"id": "wf_page",
+				"name": "Page on-call",
+				"trigger": "incident.created",
+				"enabled": true,
+				"runs": [{"id": "run_1", "workflow_id": "wf_page", "state": "completed"}],
+				"state": {"delay_seconds": 30}
+			}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"type": "not_found", "status": 404, "errors": [{"code": "not_found", "message": "Workflow not found"}]}`)
+		}
+	})
+}
+
+func TestListWorkflowsTool_EnabledFilter(t *testing.T) {
+	tool := NewListWorkflowsTool(newWorkflowsTestClient(t))
+
+	tests := []struct {
+		name        string
+		args        map[string]interface{}
+		expectedIDs []string
+	}{
+		{
+			name:        "no filter",
+			args:        map[string]interface{}{},
+			expectedIDs: []string{"wf_page", "wf_legacy", "wf_notify"},
+		},
+		{
+			name:        "enabled only",
+			args:        map[string]interface{}{"enabled": true},
+			expectedIDs: []string{"wf_page", "wf_notify"},
+		},
+		{
+			name:        "disabled only",
+			args:        map[string]interface{}{"enabled": false},
+			expectedIDs: []string{"wf_legacy"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tool.Execute(context.Background(), tt.args)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var response incidentio.ListWorkflowsResponse
+			if err := json.Unmarshal([]byte(result), &response); err != nil {
+				t.Fatalf("failed to parse result: %v", err)
+			}
+
+			if len(response.Workflows) != len(tt.expectedIDs) {
+				t.Fatalf("expected %d workflows, got %d", len(tt.expectedIDs), len(response.Workflows))
+			}
+			for i, id := range tt.expectedIDs {
+				if response.Workflows[i].ID != id {
+					t.Errorf("position %d: expected %s, got %s", i, id, response.Workflows[i].ID)
+				}
+			}
+		})
+	}
+}
+
+func TestListWorkflowsTool_EnabledFilterFollowsPages(t *testing.T) {
+	var cursors []string
+	client := newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		after := r.URL.Query().Get("after")
+		cursors = append(cursors, after)
+		switch after {
+		case "":
+			fmt.Fprint(w, `{"workflows": [{"id": "wf_legacy", "enabled": false}], "pagination_info": {"after": "wf_legacy", "page_size": 1}}`)
+		case "wf_legacy":
+			fmt.Fprint(w, `{"workflows": [{"id": "wf_page", "enabled": true}], "pagination_info": {"after": "wf_page", "page_size": 1}}`)
+		default:
+			t.Errorf("unexpected request for the page after %s", after)
+			fmt.Fprint(w, `{"workflows": [], "pagination_info": {"page_size": 1}}`)
+		}
+	})
+
+	result, err := NewListWorkflowsTool(client).Execute(context.Background(), map[string]interface{}{
+		"enabled":   true,
+		"page_size": float64(1),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var response incidentio.ListWorkflowsResponse
+	if err := json.Unmarshal([]byte(result), &response); err != nil {
+		t.Fatalf("failed to parse result: %v", err)
+	}
+	if len(response.Workflows) != 1 || response.Workflows[0].ID != "wf_page" {
+		t.Fatalf("expected the enabled workflow from the second page, got %+v", response.Workflows)
+	}
+	if response.Pagination.After != "wf_page" {
+		t.Errorf("expected the cursor after the last page read, got %q", response.Pagination.After)
+	}
+	if len(cursors) != 2 {
+		t.Errorf("expected 2 page requests, got %d: %v", len(cursors), cursors)
+	}
+}
+
+func TestGetWorkflowTool_Execute(t *testing.T) {
+	tool := NewGetWorkflowTool(newWorkflowsTestClient(t))
+
+	t.Run("returns runs and state", func(t *testing.T) {
+		result, err := tool.Execute(context.Background(), map[string]interface{}{"id": "wf_page"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		var workflow incidentio.Workflow
+		if err := json.Unmarshal([]byte(result), &workflow); err != nil {
+			t.Fatalf("failed to parse result: %v", err)
+		}
+		if len(workflow.Runs) != 1 || workflow.Runs[0].ID != "run_1" {
+			t.Errorf("expected run_1 in runs, got %+v", workflow.Runs)
+		}
+		if workflow.State["delay_seconds"] != float64(30) {
+			t.Errorf("expected state delay_seconds 30, got %v", workflow.State["delay_seconds"])
+		}
+	})
+
+	t.Run("workflow not found", func(t *testing.T) {
+		_, err := tool.Execute(context.Background(), map[string]interface{}{"id": "wf_missing"})
+		if err == nil {
+			t.Fatal("expected error for missing workflow")
+		}
+		if !strings.Contains(err.Error(), "404") {
+			t.Errorf("expected 404 in error, got: %v", err)
+		}
+	})
+
+	t.Run("missing id", func(t *testing.T) {
+		if _, err := tool.Execute(context.Background(), map[string]interface{}{}); err == nil {
+			t.Fatal("expected error for missing id")
+		}
+	})
+}
+
+// newWorkflowToggleTestClient serves a single workflow with the given enabled
+// state and records the body of every PATCH request
+func newWorkflowToggleTestClient(t *testing.T, enabled bool, patchBodies *[]map[string]interface{}) *incidentio.Client {
+	t.Helper()
+
+	return newMockServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/workflows/wf_page" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error": {"message": "Workflow not found"}}`)
+			return
+		}
+
+		if r.Method == http.MethodPatch {
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode request body: %v", err)
+			}
+			*patchBodies = append(*patchBodies, body)
+			enabled, _ = body["enabled"].(bool)
+		}
+
+		fmt.Fprintf(w, `{"workflow": {"id": "wf_page", "name": "Page on-call", "enabled": %t}}`, enabled)
+	})
+}
+
+func TestWorkflowToggleTools(t *testing.T) {
+	tests := []struct {
+		name            string
+		initiallyOn     bool
+		enable          bool
+		expectedPatches int
+		expectedChanged bool
+	}{
+		{name: "disable enabled workflow", initiallyOn: true, enable: false, expectedPatches: 1, expectedChanged: true},
+		{name: "enable disabled workflow", initiallyOn: false, enable: true, expectedPatches: 1, expectedChanged: true},
+		{name: "enable already enabled workflow", initiallyOn: true, enable: true, expectedPatches: 0, expectedChanged: false},
+		{name: "disable already disabled workflow", initiallyOn: false, enable: false, expectedPatches: 0, expectedChanged: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var patchBodies []map[string]interface{}
+			client := newWorkflowToggleTestClient(t, tt.initiallyOn, &patchBodies)
+
+			var tool Tool = NewDisableWorkflowTool(client)
+			if tt.enable {
+				tool = NewEnableWorkflowTool(client)
+			}
+
+			result, err := tool.Execute(context.Background(), map[string]interface{}{"workflow_id": "wf_page"})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(patchBodies) != tt.expectedPatches {
+				t.Fatalf("expected %d PATCH requests, got %d", tt.expectedPatches, len(patchBodies))
+			}
+			for _, body := range patchBodies {
+				if body["enabled"] != tt.enable {
+					t.Errorf("expected request body enabled=%t, got %v", tt.enable, body["enabled"])
+				}
+			}
+
+			var response struct {
+				Workflow incidentio.Workflow `json:"workflow"`
+				Changed  bool                `json:"changed"`
+				Message  string              `json:"message"`
+			}
+			if err := json.Unmarshal([]byte(result), &response); err != nil {
+				t.Fatalf("failed to parse result: %v", err)
+			}
+			if response.Workflow.Enabled != tt.enable {
+				t.Errorf("expected workflow enabled=%t, got %t", tt.enable, response.Workflow.Enabled)
+			}
+			if response.Changed != tt.expectedChanged {
+				t.Errorf("expected changed=%t, got %t", tt.expectedChanged, response.Changed)
+			}
+			if !tt.expectedChanged && !strings.Contains(response.Message, "already") {
+				t.Errorf("expected an already-in-state note, got %q", response.Message)
+			}
+		})
+	}
+}
+
+func TestDisableWorkflowTool_NotFound(t *testing.T) {
+	var patchBodies []map[string]interface{}
+	tool := NewDisableWorkflowTool(newWorkflowToggleTestClient(t, true, &patchBodies))
+
+	_, err := tool.Execute(context.Background(), map[string]interface{}{"workflow_id": "wf_missing"})
+	if err == nil {
+		t.Fatal("expected error for missing workflow")
+	}
+	if !strings.Contains(err.Error(), "wf_missing not found") || !strings.Contains(err.Error(), "list_workflows") {
+		t.Errorf("expected helpful not found error, got: %v", err)
+	}
+	if len(patchBodies) != 0 {
+		t.Errorf("expected no PATCH requests, got %d", len(patchBodies))
+	}
+}